@@ -17,10 +17,23 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/settings/tenantsettings"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asacontext"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcdeployment"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcroute"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd/cloudftdonboarding"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/credentials"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/genericssh"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/tenant"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/user"
@@ -69,14 +82,30 @@ func (c *Client) ReadAsa(ctx context.Context, inp asa.ReadInput) (*asa.ReadOutpu
 	return asa.Read(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadAllDevicesByType(ctx context.Context, inp device.ReadAllByTypeInput) (*device.ReadAllByTypeOutput, error) {
+	return device.ReadAllByType(ctx, c.Client, inp)
+}
+
 func (c *Client) ReadDeviceByName(ctx context.Context, inp device.ReadByNameAndTypeInput) (*device.ReadOutput, error) {
 	return device.ReadByNameAndType(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadDeviceByUid(ctx context.Context, inp device.ReadByUidInput) (*device.ReadOutput, error) {
+	return device.ReadByUid(ctx, c.Client, inp)
+}
+
 func (c *Client) CreateAsa(ctx context.Context, inp asa.CreateInput) (*asa.ReadOutput, *asa.ReadSpecificOutput, *asa.CreateError) {
 	return asa.Create(ctx, c.Client, inp)
 }
 
+func (c *Client) CreateAsaWithRegistrationKey(ctx context.Context, inp asa.CreateWithRegistrationKeyInput) (*asa.ReadOutput, *asa.ReadSpecificOutput, *asa.CreateError) {
+	return asa.CreateWithRegistrationKey(ctx, c.Client, inp)
+}
+
+func (c *Client) WaitForAsaRegistration(ctx context.Context, inp asa.WaitForRegistrationInput) (*asa.WaitForRegistrationOutput, error) {
+	return asa.WaitForRegistration(ctx, c.Client, inp)
+}
+
 func (c *Client) UpdateAsa(ctx context.Context, inp asa.UpdateInput) (*asa.UpdateOutput, error) {
 	return asa.Update(ctx, c.Client, inp)
 }
@@ -89,7 +118,11 @@ func (c *Client) ReadIos(ctx context.Context, inp ios.ReadInput) (*ios.ReadOutpu
 	return ios.Read(ctx, c.Client, inp)
 }
 
-func (c *Client) CreateIos(ctx context.Context, inp ios.CreateInput) (*ios.CreateOutput, error) {
+func (c *Client) ReadSpecificIos(ctx context.Context, inp ios.ReadSpecificInput) (*ios.ReadSpecificOutput, error) {
+	return ios.ReadSpecific(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateIos(ctx context.Context, inp ios.CreateInput) (*ios.CreateOutput, *ios.CreateError) {
 	return ios.Create(ctx, c.Client, inp)
 }
 
@@ -97,10 +130,34 @@ func (c *Client) UpdateIos(ctx context.Context, inp ios.UpdateInput) (*ios.Updat
 	return ios.Update(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadIosImages(ctx context.Context, inp ios.ReadImagesInput) (*ios.ReadImagesOutput, error) {
+	return ios.ReadImages(ctx, c.Client, inp)
+}
+
+func (c *Client) ValidateIosSoftwareVersion(ctx context.Context, deviceUid string, softwareVersion string) (*ios.Image, error) {
+	return ios.ValidateSoftwareVersion(ctx, c.Client, deviceUid, softwareVersion)
+}
+
+func (c *Client) StageIosImage(ctx context.Context, inp ios.StageImageInput) (*ios.StageImageOutput, error) {
+	return ios.StageImage(ctx, c.Client, inp)
+}
+
+func (c *Client) UpgradeIos(ctx context.Context, inp ios.UpgradeInput) (*ios.UpgradeOutput, error) {
+	return ios.Upgrade(ctx, c.Client, inp)
+}
+
 func (c *Client) DeleteIos(ctx context.Context, inp ios.DeleteInput) (*ios.DeleteOutput, error) {
 	return ios.Delete(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadIosRunningConfig(ctx context.Context, inp ios.ReadRunningConfigInput) (*ios.ReadRunningConfigOutput, error) {
+	return ios.ReadRunningConfig(ctx, c.Client, inp)
+}
+
+func (c *Client) ExecuteIosCli(ctx context.Context, inp ios.ExecuteCliInput) (*ios.ExecuteCliOutput, error) {
+	return ios.ExecuteCli(ctx, c.Client, inp)
+}
+
 func (c *Client) ReadAsaConfig(ctx context.Context, inp asaconfig.ReadInput) (*asaconfig.ReadOutput, error) {
 	return asaconfig.Read(ctx, c.Client, inp)
 }
@@ -109,6 +166,30 @@ func (c *Client) ReadSpecificAsa(ctx context.Context, inp asa.ReadSpecificInput)
 	return asa.ReadSpecific(ctx, c.Client, inp)
 }
 
+func (c *Client) FailoverAsa(ctx context.Context, inp asa.FailoverInput) (*asa.FailoverOutput, error) {
+	return asa.Failover(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllAsaContexts(ctx context.Context, inp asacontext.ReadAllInput) (*asacontext.ReadAllOutput, error) {
+	return asacontext.ReadAll(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAsaContext(ctx context.Context, inp asacontext.ReadInput) (*asacontext.ReadOutput, error) {
+	return asacontext.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) OnboardAsaContext(ctx context.Context, inp asacontext.OnboardInput) (*asacontext.OnboardOutput, error) {
+	return asacontext.Onboard(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAsaRunningConfig(ctx context.Context, inp asa.ReadRunningConfigInput) (*asa.ReadRunningConfigOutput, error) {
+	return asa.ReadRunningConfig(ctx, c.Client, inp)
+}
+
+func (c *Client) ExecuteAsaCli(ctx context.Context, inp asa.ExecuteCliInput) (*asa.ExecuteCliOutput, error) {
+	return asa.ExecuteCli(ctx, c.Client, inp)
+}
+
 func (c *Client) CreateConnector(ctx context.Context, inp connector.CreateInput) (*connector.CreateOutput, error) {
 	return connector.Create(ctx, c.Client, inp)
 }
@@ -157,6 +238,14 @@ func (c *Client) DeleteCloudFtd(ctx context.Context, inp cloudftd.DeleteInput) (
 	return cloudftd.Delete(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadCloudFtdDeviceRecord(ctx context.Context, inp cloudftd.ReadDeviceRecordInput) (*cloudftd.ReadDeviceRecordOutput, error) {
+	return cloudftd.ReadDeviceRecord(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllUsers(ctx context.Context, inp user.ReadAllInput) (*user.ReadAllOutput, error) {
+	return user.ReadAll(ctx, c.Client, inp)
+}
+
 func (c *Client) ReadUserByUsername(ctx context.Context, inp user.ReadByUsernameInput) (*user.ReadUserOutput, error) {
 	return user.ReadByUsername(ctx, c.Client, inp)
 }
@@ -189,6 +278,10 @@ func (c *Client) CreateFtdOnboarding(ctx context.Context, inp cloudftdonboarding
 	return cloudftdonboarding.Create(ctx, c.Client, inp)
 }
 
+func (c *Client) ConfigureFtdManager(ctx context.Context, inp cloudftdonboarding.ConfigureManagerInput) (*cloudftdonboarding.ConfigureManagerOutput, error) {
+	return cloudftdonboarding.ConfigureManager(ctx, c.Client, inp)
+}
+
 func (c *Client) UpdateFtdOnboarding(ctx context.Context, inp cloudftdonboarding.UpdateInput) (*cloudftdonboarding.UpdateOutput, error) {
 	return cloudftdonboarding.Update(ctx, c.Client, inp)
 }
@@ -217,6 +310,30 @@ func (c *Client) ReadCloudFmcSpecificDevice(ctx context.Context, inp cloudfmc.Re
 	return cloudfmc.ReadSpecific(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadCloudFmcDomainUid(ctx context.Context, inp cloudfmc.ReadDomainUidInput) (*cloudfmc.ReadDomainUidOutput, error) {
+	return cloudfmc.ReadDomainUid(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadCloudFmcSmartLicense(ctx context.Context, inp cloudfmc.ReadSmartLicenseInput) (*cloudfmc.ReadSmartLicenseOutput, error) {
+	return cloudfmc.ReadSmartLicense(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcDeviceLicenses(ctx context.Context, inp fmcplatform.ReadDeviceLicensesInput) (*fmcplatform.ReadDeviceLicensesOutput, error) {
+	return fmcplatform.ReadDeviceLicenses(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcDomainInfo(ctx context.Context, inp fmcplatform.ReadDomainInfoInput) (*fmcplatform.ReadDomainInfoOutput, error) {
+	return fmcplatform.ReadFmcDomainInfo(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcDomain(ctx context.Context, inp fmcplatform.ReadDomainInput) (*fmcplatform.ReadDomainOutput, error) {
+	return fmcplatform.ReadDomain(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcDeviceLicenses(ctx context.Context, inp fmcplatform.UpdateDeviceLicensesInput) (*fmcplatform.UpdateDeviceLicensesOutput, error) {
+	return fmcplatform.UpdateDeviceLicenses(ctx, c.Client, inp)
+}
+
 func (c *Client) CreateConnectorOnboarding(ctx context.Context, inp connectoronboarding.CreateInput) (*connectoronboarding.CreateOutput, error) {
 	return connectoronboarding.Create(ctx, c.Client, inp)
 }
@@ -249,6 +366,14 @@ func (c *Client) ReadSec(ctx context.Context, inp sec.ReadInput) (*sec.ReadOutpu
 	return sec.Read(ctx, c.Client, inp)
 }
 
+func (c *Client) ReadAllSecs(ctx context.Context, inp sec.ReadAllInput) (*sec.ReadAllOutput, error) {
+	return sec.ReadAll(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadSecByName(ctx context.Context, inp sec.ReadByNameInput) (*sec.ReadByNameOutput, error) {
+	return sec.ReadByName(ctx, c.Client, inp)
+}
+
 func (c *Client) CreateSecOnboarding(ctx context.Context, inp seconboarding.CreateInput) (*seconboarding.CreateOutput, error) {
 	return seconboarding.Create(ctx, c.Client, inp)
 }
@@ -293,6 +418,10 @@ func (c *Client) DeleteMspManagedTenantByUid(ctx context.Context, deleteByUidInp
 	return tenants.DeleteByUid(ctx, c.Client, deleteByUidInput)
 }
 
+func (c *Client) ReadAllMspManagedTenants(ctx context.Context) (*[]tenants.MspTenantOutput, error) {
+	return tenants.ReadAll(ctx, c.Client)
+}
+
 func (c *Client) FindMspManagedTenantByName(ctx context.Context, readByNameInput tenants.ReadByNameInput) (*tenants.MspTenantsOutput, error) {
 	return tenants.ReadByName(ctx, c.Client, readByNameInput)
 }
@@ -305,6 +434,10 @@ func (c *Client) ReadUsersInMspManagedTenant(ctx context.Context, readInput user
 	return users.ReadCreatedUsersInTenant(ctx, c.Client, readInput)
 }
 
+func (c *Client) ReadAllUsersInMspManagedTenant(ctx context.Context, readInput users.MspReadAllUsersInput) (*[]users.UserDetails, error) {
+	return users.ReadAllUsersInTenant(ctx, c.Client, readInput)
+}
+
 func (c *Client) DeleteUsersInMspManagedTenant(ctx context.Context, deleteInput users.MspDeleteUsersInput) (interface{}, error) {
 	return users.Delete(ctx, c.Client, deleteInput)
 }
@@ -325,6 +458,254 @@ func (c *Client) ReadUserGroupsInMspManagedTenant(ctx context.Context, tenantUid
 	return usergroups.ReadCreatedUserGroupsInTenant(ctx, c.Client, tenantUid, userGroups)
 }
 
+func (c *Client) ReadAllUserGroupsInMspManagedTenant(ctx context.Context, tenantUid string) (*[]usergroups.MspManagedUserGroup, error) {
+	return usergroups.ReadAllUserGroupsInTenant(ctx, c.Client, tenantUid)
+}
+
 func (c *Client) DeleteUserGroupsInMspManagedTenant(ctx context.Context, tenantUid string, deleteInput *usergroups.MspManagedUserGroupDeleteInput) (interface{}, error) {
 	return usergroups.Delete(ctx, c.Client, tenantUid, deleteInput)
 }
+
+func (c *Client) ReadDeviceChanges(ctx context.Context, inp changes.ReadInput) (*changes.ReadOutput, error) {
+	return changes.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadDeviceConflict(ctx context.Context, inp changes.ReadConflictInput) (*changes.ReadConflictOutput, error) {
+	return changes.ReadConflict(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllDeviceConflicts(ctx context.Context, inp changes.ReadAllConflictsInput) (*changes.ReadAllConflictsOutput, error) {
+	return changes.ReadAllConflicts(ctx, c.Client, inp)
+}
+
+func (c *Client) AcceptDeviceConflict(ctx context.Context, inp changes.AcceptConflictInput) (*changes.AcceptConflictOutput, error) {
+	return changes.AcceptConflict(ctx, c.Client, inp)
+}
+
+func (c *Client) RejectDeviceConflict(ctx context.Context, inp changes.RejectConflictInput) (*changes.RejectConflictOutput, error) {
+	return changes.RejectConflict(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateDeviceCredentials(ctx context.Context, inp credentials.UpdateInput) (*credentials.UpdateOutput, error) {
+	return credentials.Update(ctx, c.Client, inp)
+}
+
+func (c *Client) TriggerDeviceBackup(ctx context.Context, inp backup.TriggerInput) (*backup.TriggerOutput, error) {
+	return backup.Trigger(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllDeviceBackups(ctx context.Context, inp backup.ReadAllInput) (*backup.ReadAllOutput, error) {
+	return backup.ReadAll(ctx, c.Client, inp)
+}
+
+func (c *Client) DownloadDeviceBackup(ctx context.Context, inp backup.DownloadInput) (*backup.DownloadOutput, error) {
+	return backup.Download(ctx, c.Client, inp)
+}
+
+func (c *Client) RestoreDeviceBackup(ctx context.Context, inp backup.RestoreInput) (*backup.RestoreOutput, error) {
+	return backup.Restore(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateDeviceBackupSchedule(ctx context.Context, inp backup.CreateScheduleInput) (*backup.CreateScheduleOutput, error) {
+	return backup.CreateSchedule(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadDeviceBackupSchedule(ctx context.Context, inp backup.ReadScheduleInput) (*backup.ReadScheduleOutput, error) {
+	return backup.ReadSchedule(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateDeviceBackupSchedule(ctx context.Context, inp backup.UpdateScheduleInput) (*backup.UpdateScheduleOutput, error) {
+	return backup.UpdateSchedule(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteDeviceBackupSchedule(ctx context.Context, inp backup.DeleteScheduleInput) (*backup.DeleteScheduleOutput, error) {
+	return backup.DeleteSchedule(ctx, c.Client, inp)
+}
+
+func (c *Client) DeployDeviceChanges(ctx context.Context, inp changes.DeployInput) (*changes.DeployOutput, error) {
+	return changes.Deploy(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadConfigFromDevice(ctx context.Context, inp changes.ReadFromDeviceInput) (*changes.ReadFromDeviceOutput, error) {
+	return changes.ReadFromDevice(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcAccessPolicy(ctx context.Context, inp fmcaccesspolicy.CreateInput) (*fmcaccesspolicy.CreateOutput, error) {
+	return fmcaccesspolicy.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcAccessPolicy(ctx context.Context, inp fmcaccesspolicy.ReadInput) (*fmcaccesspolicy.ReadOutput, error) {
+	return fmcaccesspolicy.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcAccessPolicy(ctx context.Context, inp fmcaccesspolicy.UpdateInput) (*fmcaccesspolicy.UpdateOutput, error) {
+	return fmcaccesspolicy.Update(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteFmcAccessPolicy(ctx context.Context, inp fmcaccesspolicy.DeleteInput) (*fmcaccesspolicy.DeleteOutput, error) {
+	return fmcaccesspolicy.Delete(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcAccessRule(ctx context.Context, inp fmcaccesspolicy.CreateRuleInput) (*fmcaccesspolicy.CreateRuleOutput, error) {
+	return fmcaccesspolicy.CreateRule(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcAccessRule(ctx context.Context, inp fmcaccesspolicy.ReadRuleInput) (*fmcaccesspolicy.ReadRuleOutput, error) {
+	return fmcaccesspolicy.ReadRule(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcAccessRule(ctx context.Context, inp fmcaccesspolicy.UpdateRuleInput) (*fmcaccesspolicy.UpdateRuleOutput, error) {
+	return fmcaccesspolicy.UpdateRule(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteFmcAccessRule(ctx context.Context, inp fmcaccesspolicy.DeleteRuleInput) (*fmcaccesspolicy.DeleteRuleOutput, error) {
+	return fmcaccesspolicy.DeleteRule(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcObject(ctx context.Context, inp fmcobjects.CreateInput) (*fmcobjects.CreateOutput, error) {
+	return fmcobjects.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcObject(ctx context.Context, inp fmcobjects.ReadInput) (*fmcobjects.ReadOutput, error) {
+	return fmcobjects.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcObjectByName(ctx context.Context, inp fmcobjects.ReadByNameInput) (*fmcobjects.ReadByNameOutput, error) {
+	return fmcobjects.ReadByName(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllFmcObjects(ctx context.Context, inp fmcobjects.ReadAllInput) (*fmcobjects.ReadAllOutput, error) {
+	return fmcobjects.ReadAll(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcObject(ctx context.Context, inp fmcobjects.UpdateInput) (*fmcobjects.UpdateOutput, error) {
+	return fmcobjects.Update(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteFmcObject(ctx context.Context, inp fmcobjects.DeleteInput) (*fmcobjects.DeleteOutput, error) {
+	return fmcobjects.Delete(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcDeployableDevices(ctx context.Context, inp fmcdeployment.ReadDeployableDevicesInput) (*fmcdeployment.ReadDeployableDevicesOutput, error) {
+	return fmcdeployment.ReadDeployableDevices(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcDeploymentRequest(ctx context.Context, inp fmcdeployment.CreateInput) (*fmcdeployment.CreateOutput, error) {
+	return fmcdeployment.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) DeployFmcChanges(ctx context.Context, inp fmcdeployment.DeployInput) (*fmcdeployment.DeployOutput, error) {
+	return fmcdeployment.Deploy(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllFmcPhysicalInterfaces(ctx context.Context, inp fmcinterface.ReadAllPhysicalInput) (*fmcinterface.ReadAllPhysicalOutput, error) {
+	return fmcinterface.ReadAllPhysical(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcPhysicalInterface(ctx context.Context, inp fmcinterface.ReadPhysicalInput) (*fmcinterface.ReadPhysicalOutput, error) {
+	return fmcinterface.ReadPhysical(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcPhysicalInterface(ctx context.Context, inp fmcinterface.UpdatePhysicalInput) (*fmcinterface.UpdatePhysicalOutput, error) {
+	return fmcinterface.UpdatePhysical(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcSubInterface(ctx context.Context, inp fmcinterface.CreateSubInterfaceInput) (*fmcinterface.CreateSubInterfaceOutput, error) {
+	return fmcinterface.CreateSubInterface(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcSubInterface(ctx context.Context, inp fmcinterface.ReadSubInterfaceInput) (*fmcinterface.ReadSubInterfaceOutput, error) {
+	return fmcinterface.ReadSubInterface(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcSubInterface(ctx context.Context, inp fmcinterface.UpdateSubInterfaceInput) (*fmcinterface.UpdateSubInterfaceOutput, error) {
+	return fmcinterface.UpdateSubInterface(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteFmcSubInterface(ctx context.Context, inp fmcinterface.DeleteSubInterfaceInput) (*fmcinterface.DeleteSubInterfaceOutput, error) {
+	return fmcinterface.DeleteSubInterface(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcIpv4StaticRoute(ctx context.Context, inp fmcroute.CreateInput) (*fmcroute.CreateOutput, error) {
+	return fmcroute.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcIpv4StaticRoute(ctx context.Context, inp fmcroute.ReadInput) (*fmcroute.ReadOutput, error) {
+	return fmcroute.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateFmcIpv4StaticRoute(ctx context.Context, inp fmcroute.UpdateInput) (*fmcroute.UpdateOutput, error) {
+	return fmcroute.Update(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteFmcIpv4StaticRoute(ctx context.Context, inp fmcroute.DeleteInput) (*fmcroute.DeleteOutput, error) {
+	return fmcroute.Delete(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateFmcFtdHaPair(ctx context.Context, inp fmchapair.CreateInput) (*fmchapair.CreateOutput, error) {
+	return fmchapair.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadFmcFtdHaPair(ctx context.Context, inp fmchapair.ReadInput) (*fmchapair.ReadOutput, error) {
+	return fmchapair.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAllFmcFtdHaPairs(ctx context.Context, inp fmchapair.ReadAllInput) (*fmchapair.ReadAllOutput, error) {
+	return fmchapair.ReadAll(ctx, c.Client, inp)
+}
+
+func (c *Client) BreakFmcFtdHaPair(ctx context.Context, inp fmchapair.BreakInput) (*fmchapair.BreakOutput, error) {
+	return fmchapair.Break(ctx, c.Client, inp)
+}
+
+func (c *Client) SwitchFmcFtdHaPairActive(ctx context.Context, inp fmchapair.SwitchActiveInput) (*fmchapair.SwitchActiveOutput, error) {
+	return fmchapair.SwitchActive(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateAsaObject(ctx context.Context, inp asaobjects.CreateInput) (*asaobjects.CreateOutput, error) {
+	return asaobjects.Create(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAsaObject(ctx context.Context, inp asaobjects.ReadInput) (*asaobjects.ReadOutput, error) {
+	return asaobjects.Read(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateAsaObject(ctx context.Context, inp asaobjects.UpdateInput) (*asaobjects.UpdateOutput, error) {
+	return asaobjects.Update(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteAsaObject(ctx context.Context, inp asaobjects.DeleteInput) (*asaobjects.DeleteOutput, error) {
+	return asaobjects.Delete(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateAsaAccessList(ctx context.Context, inp asaaccess.CreateAccessListInput) (*asaaccess.CreateAccessListOutput, error) {
+	return asaaccess.CreateAccessList(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAsaAccessList(ctx context.Context, inp asaaccess.ReadAccessListInput) (*asaaccess.ReadAccessListOutput, error) {
+	return asaaccess.ReadAccessList(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateAsaAccessList(ctx context.Context, inp asaaccess.UpdateAccessListInput) (*asaaccess.UpdateAccessListOutput, error) {
+	return asaaccess.UpdateAccessList(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteAsaAccessList(ctx context.Context, inp asaaccess.DeleteAccessListInput) (*asaaccess.DeleteAccessListOutput, error) {
+	return asaaccess.DeleteAccessList(ctx, c.Client, inp)
+}
+
+func (c *Client) CreateAsaAccessGroup(ctx context.Context, inp asaaccess.CreateAccessGroupInput) (*asaaccess.CreateAccessGroupOutput, error) {
+	return asaaccess.CreateAccessGroup(ctx, c.Client, inp)
+}
+
+func (c *Client) ReadAsaAccessGroup(ctx context.Context, inp asaaccess.ReadAccessGroupInput) (*asaaccess.ReadAccessGroupOutput, error) {
+	return asaaccess.ReadAccessGroup(ctx, c.Client, inp)
+}
+
+func (c *Client) UpdateAsaAccessGroup(ctx context.Context, inp asaaccess.UpdateAccessGroupInput) (*asaaccess.UpdateAccessGroupOutput, error) {
+	return asaaccess.UpdateAccessGroup(ctx, c.Client, inp)
+}
+
+func (c *Client) DeleteAsaAccessGroup(ctx context.Context, inp asaaccess.DeleteAccessGroupInput) (*asaaccess.DeleteAccessGroupOutput, error) {
+	return asaaccess.DeleteAccessGroup(ctx, c.Client, inp)
+}
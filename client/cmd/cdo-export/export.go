@@ -0,0 +1,374 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector/sec"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/user"
+)
+
+const (
+	connectorsFile = "connectors.tf"
+	devicesFile    = "devices.tf"
+	usersFile      = "users.tf"
+	tenantFile     = "tenant.tf"
+	mspFile        = "msp.tf"
+	variablesFile  = "variables.tf"
+	importsFile    = "imports.tf"
+)
+
+// exporter walks a tenant and collects the Terraform configuration and import blocks of its objects.
+type exporter struct {
+	client *cdoClient.Client
+	warn   func(format string, args ...any)
+
+	files     map[string][]*block
+	variables []*block
+	imports   []*block
+
+	// used identifiers per resource type, to keep resource addresses unique
+	identifiers map[string]map[string]bool
+	// names of the connectors by uid, to resolve the connector of a device
+	connectorNames map[string]string
+}
+
+func newExporter(client *cdoClient.Client, warn func(format string, args ...any)) *exporter {
+	return &exporter{
+		client:         client,
+		warn:           warn,
+		files:          map[string][]*block{},
+		identifiers:    map[string]map[string]bool{},
+		connectorNames: map[string]string{},
+	}
+}
+
+// Export reads all supported objects of the tenant.
+func (e *exporter) Export(ctx context.Context) error {
+	steps := []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{"connectors", e.exportConnectors},
+		{"SECs", e.exportSecs},
+		{"ASA devices", e.exportAsaDevices},
+		{"IOS devices", e.exportIosDevices},
+		{"FTD devices", e.exportFtdDevices},
+		{"Duo Admin Panels", e.exportDuoAdminPanels},
+		{"users", e.exportUsers},
+		{"tenant settings", e.exportTenantSettings},
+		{"cdFMC", e.exportCdFmc},
+		{"MSP-managed tenants", e.exportMspManagedTenants},
+	}
+	for _, step := range steps {
+		if err := step.run(ctx); err != nil {
+			return fmt.Errorf("failed to export %s: %w", step.name, err)
+		}
+	}
+	return nil
+}
+
+// Write writes the collected configuration into .tf files in the given directory.
+func (e *exporter) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	files := map[string][]*block{}
+	for name, blocks := range e.files {
+		files[name] = blocks
+	}
+	files[variablesFile] = e.variables
+	files[importsFile] = e.imports
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if len(files[name]) == 0 {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(renderBlocks(files[name])), 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addResource adds a resource block along with the import block that imports it by id,
+// and returns the resource block so that its attributes can be set.
+func (e *exporter) addResource(file string, resourceType string, name string, id string) *block {
+	resourceName := e.uniqueIdentifier(resourceType, name)
+
+	resource := newBlock("resource", resourceType, resourceName)
+	e.files[file] = append(e.files[file], resource)
+	e.imports = append(e.imports, newBlock("import").
+		Add("to", resourceType+"."+resourceName).
+		Add("id", hclString(id)),
+	)
+
+	return resource
+}
+
+// addCredentialVariable adds a sensitive variable for a credential of a resource, and returns a reference to it.
+func (e *exporter) addCredentialVariable(resource *block, credential string) string {
+	name := strings.TrimPrefix(resource.Labels[0], "cdo_") + "_" + resource.Labels[1] + "_" + credential
+	e.variables = append(e.variables, newBlock("variable", name).
+		Add("type", "string").
+		Add("description", hclString(fmt.Sprintf("The %s of %s.%s.", strings.ReplaceAll(credential, "_", " "), resource.Labels[0], resource.Labels[1]))).
+		Add("sensitive", hclBool(true)),
+	)
+	return "var." + name
+}
+
+func (e *exporter) uniqueIdentifier(resourceType string, name string) string {
+	used, ok := e.identifiers[resourceType]
+	if !ok {
+		used = map[string]bool{}
+		e.identifiers[resourceType] = used
+	}
+
+	id := identifier(name)
+	unique := id
+	for i := 2; used[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", id, i)
+	}
+	used[unique] = true
+
+	return unique
+}
+
+func addLabels(resource *block, deviceTags tags.Type) {
+	resource.Add("labels", hclStringList(deviceTags.UngroupedTags()))
+	resource.Add("grouped_labels", hclStringListMap(deviceTags.GroupedTags()))
+}
+
+func (e *exporter) exportConnectors(ctx context.Context) error {
+	connectors, err := e.client.ReadAllConnectors(ctx, *connector.NewReadAllInput())
+	if err != nil {
+		return err
+	}
+
+	for _, c := range *connectors {
+		e.connectorNames[c.Uid] = c.Name
+		if c.Cdg {
+			// the cloud connector is managed by CDO
+			continue
+		}
+		e.addResource(connectorsFile, "cdo_sdc", c.Name, c.Uid).
+			Add("name", hclString(c.Name))
+	}
+	return nil
+}
+
+func (e *exporter) exportSecs(ctx context.Context) error {
+	secs, err := e.client.ReadAllSecs(ctx, sec.ReadAllInput{})
+	if err != nil {
+		return err
+	}
+
+	for _, s := range *secs {
+		e.addResource(connectorsFile, "cdo_sec", s.Name, s.Uid)
+	}
+	return nil
+}
+
+func (e *exporter) readAllDevicesByType(ctx context.Context, deviceType devicetype.Type) ([]device.ReadOutput, error) {
+	devices, err := e.client.ReadAllDevicesByType(ctx, device.NewReadAllByTypeInput(deviceType))
+	if err != nil {
+		return nil, err
+	}
+	return *devices, nil
+}
+
+func (e *exporter) exportAsaDevices(ctx context.Context) error {
+	devices, err := e.readAllDevicesByType(ctx, devicetype.Asa)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		resource := e.addResource(devicesFile, "cdo_asa_device", d.Name, d.Uid).
+			Add("name", hclString(d.Name)).
+			Add("connector_type", hclString(d.ConnectorType))
+		if d.ConnectorType == "SDC" {
+			resource.Add("connector_name", hclString(e.connectorName(d)))
+		}
+		resource.
+			Add("socket_address", hclString(d.SocketAddress)).
+			Add("username", e.addCredentialVariable(resource, "username")).
+			Add("password", e.addCredentialVariable(resource, "password")).
+			Add("ignore_certificate", hclBool(d.IgnoreCertificate))
+		addLabels(resource, d.Tags)
+	}
+	return nil
+}
+
+func (e *exporter) exportIosDevices(ctx context.Context) error {
+	devices, err := e.readAllDevicesByType(ctx, devicetype.Ios)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		resource := e.addResource(devicesFile, "cdo_ios_device", d.Name, d.Uid).
+			Add("name", hclString(d.Name)).
+			Add("connector_name", hclString(e.connectorName(d))).
+			Add("socket_address", hclString(d.SocketAddress))
+		resource.
+			Add("username", e.addCredentialVariable(resource, "username")).
+			Add("password", e.addCredentialVariable(resource, "password")).
+			Add("ignore_certificate", hclBool(d.IgnoreCertificate))
+		addLabels(resource, d.Tags)
+	}
+	return nil
+}
+
+func (e *exporter) exportFtdDevices(ctx context.Context) error {
+	devices, err := e.readAllDevicesByType(ctx, devicetype.CloudFtd)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		// the list of devices does not include the FTD metadata
+		ftd, err := e.client.ReadCloudFtdByUid(ctx, cloudftd.NewReadByUidInput(d.Uid))
+		if err != nil {
+			return err
+		}
+		licenses, err := license.StringToCdoStrings(ftd.Metadata.LicenseCaps)
+		if err != nil {
+			return err
+		}
+
+		resource := e.addResource(devicesFile, "cdo_ftd_device", ftd.Name, ftd.Uid).
+			Add("name", hclString(ftd.Name)).
+			Add("access_policy_name", hclString(ftd.Metadata.AccessPolicyName)).
+			Add("virtual", hclBool(ftd.Metadata.PerformanceTier != nil))
+		if ftd.Metadata.PerformanceTier != nil { // nil means physical ftd
+			resource.Add("performance_tier", hclString(string(*ftd.Metadata.PerformanceTier)))
+		}
+		resource.Add("licenses", hclStringList(licenses))
+		addLabels(resource, ftd.Tags)
+	}
+	return nil
+}
+
+func (e *exporter) exportDuoAdminPanels(ctx context.Context) error {
+	devices, err := e.readAllDevicesByType(ctx, devicetype.DuoAdminPanel)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range devices {
+		resource := e.addResource(devicesFile, "cdo_duo_admin_panel", d.Name, d.Uid).
+			Add("name", hclString(d.Name)).
+			Add("host", hclString(d.Host))
+		resource.
+			Add("integration_key", e.addCredentialVariable(resource, "integration_key")).
+			Add("secret_key", e.addCredentialVariable(resource, "secret_key"))
+		addLabels(resource, d.Tags)
+	}
+	return nil
+}
+
+func (e *exporter) exportUsers(ctx context.Context) error {
+	users, err := e.client.ReadAllUsers(ctx, user.NewReadAllInput())
+	if err != nil {
+		return err
+	}
+
+	for _, u := range *users {
+		if len(u.UserRoles) == 0 {
+			e.warn("skipping user %s, it has no role", u.Name)
+			continue
+		}
+		name := u.Name
+		if u.ApiOnlyUser {
+			// CDO appends @CDO_<tenant> to the name of API-only users
+			if i := strings.LastIndex(name, "@"); i >= 0 {
+				name = name[:i]
+			}
+		}
+		e.addResource(usersFile, "cdo_user", name, u.Uid).
+			Add("name", hclString(name)).
+			Add("is_api_only_user", hclBool(u.ApiOnlyUser)).
+			Add("role", hclString(u.UserRoles[0])) // while our API technically allows multiple roles, our UI does not support multiple roles
+	}
+	return nil
+}
+
+func (e *exporter) exportTenantSettings(ctx context.Context) error {
+	settings, err := e.client.ReadTenantSettings(ctx)
+	if err != nil {
+		return err
+	}
+
+	e.addResource(tenantFile, "cdo_tenant_settings", "tenant_settings", settings.Uid.String()).
+		Add("change_request_support_enabled", hclBool(settings.ChangeRequestSupportEnabled)).
+		Add("auto_accept_device_changes_enabled", hclBool(settings.AutoAcceptDeviceChangesEnabled)).
+		Add("web_analytics_enabled", hclBool(settings.WebAnalyticsEnabled)).
+		Add("scheduled_deployments_enabled", hclBool(settings.ScheduledDeploymentsEnabled)).
+		Add("deny_cisco_support_access_to_tenant_enabled", hclBool(settings.DenyCiscoSupportAccessToTenantEnabled)).
+		Add("multi_cloud_defense_enabled", hclBool(settings.MultiCloudDefenseEnabled)).
+		Add("auto_discover_on_prem_fmcs_enabled", hclBool(settings.AutoDiscoverOnPremFmcsEnabled)).
+		Add("conflict_detection_interval", hclString(settings.ConflictDetectionInterval.String()))
+	return nil
+}
+
+func (e *exporter) exportCdFmc(ctx context.Context) error {
+	cdFmc, err := e.client.ReadCloudFmcDevice(ctx)
+	if err != nil {
+		if errors.Is(err, http.NotFoundError) {
+			// the tenant does not have a cdFMC
+			return nil
+		}
+		return err
+	}
+
+	e.addResource(tenantFile, "cdo_cdfmc", "cdfmc", cdFmc.Uid)
+	return nil
+}
+
+func (e *exporter) exportMspManagedTenants(ctx context.Context) error {
+	tenants, err := e.client.ReadAllMspManagedTenants(ctx)
+	if err != nil {
+		if errors.Is(err, http.ForbiddenError) || errors.Is(err, http.NotFoundError) {
+			// only MSP portals have managed tenants
+			e.warn("skipping MSP-managed tenants, the tenant is not an MSP portal: %s", err)
+			return nil
+		}
+		return err
+	}
+
+	for _, t := range *tenants {
+		e.addResource(mspFile, "cdo_msp_managed_tenant", t.Name, t.Uid).
+			Add("name", hclString(t.Name)).
+			Add("display_name", hclString(t.DisplayName))
+	}
+	return nil
+}
+
+func (e *exporter) connectorName(d device.ReadOutput) string {
+	name, ok := e.connectorNames[d.ConnectorUid]
+	if !ok {
+		e.warn("connector %s of device %s not found", d.ConnectorUid, d.Name)
+	}
+	return name
+}
@@ -0,0 +1,257 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	netHttp "net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector/sec"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const baseUrl = "https://unittest.cdo.cisco.com"
+
+func TestExport(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	sdc := connector.ReadOutput{Uid: "sdc-uid", Name: "my-sdc"}
+	cdg := connector.ReadOutput{Uid: "cdg-uid", Name: "CDG", Cdg: true}
+	asaDevice := device.ReadOutput{
+		Uid:           "asa-uid",
+		Name:          "my-asa",
+		ConnectorUid:  sdc.Uid,
+		ConnectorType: "SDC",
+		SocketAddress: "10.0.0.1:443",
+		Tags:          tags.New([]string{"prod"}, map[string][]string{"site": {"sjc"}}),
+	}
+	iosDevice := device.ReadOutput{
+		Uid:               "ios-uid",
+		Name:              "my-ios",
+		ConnectorUid:      sdc.Uid,
+		ConnectorType:     "SDC",
+		SocketAddress:     "10.0.0.2:22",
+		IgnoreCertificate: true,
+		Tags:              tags.New([]string{}, map[string][]string{}),
+	}
+	ftdDevice := device.ReadOutput{Uid: "ftd-uid", Name: "my-ftd"}
+	performanceTier := tier.FTDv10
+	ftd := cloudftd.ReadOutput{
+		Uid:  ftdDevice.Uid,
+		Name: ftdDevice.Name,
+		Metadata: cloudftd.Metadata{
+			AccessPolicyName: "Default Access Control Policy",
+			LicenseCaps:      "BASE,THREAT",
+			PerformanceTier:  &performanceTier,
+		},
+		Tags: tags.New([]string{}, map[string][]string{}),
+	}
+
+	httpmock.RegisterResponder(netHttp.MethodGet, "/aegis/rest/v1/services/targets/proxies",
+		httpmock.NewJsonResponderOrPanic(200, []connector.ReadOutput{sdc, cdg}))
+	httpmock.RegisterResponder(netHttp.MethodGet, "/aegis/rest/v1/services/targets/estreamers",
+		httpmock.NewJsonResponderOrPanic(200, []sec.ReadOutput{{Uid: "sec-uid", Name: "SEC-1"}}))
+	for deviceType, devices := range map[string][]device.ReadOutput{
+		"ASA":             {asaDevice},
+		"IOS":             {iosDevice},
+		"FTDC":            {ftdDevice},
+		"DUO_ADMIN_PANEL": {},
+		"FMCE":            {},
+	} {
+		httpmock.RegisterResponderWithQuery(netHttp.MethodGet, "/aegis/rest/v1/services/targets/devices", "q=deviceType:"+deviceType,
+			httpmock.NewJsonResponderOrPanic(200, devices))
+	}
+	httpmock.RegisterResponder(netHttp.MethodGet, "/aegis/rest/v1/services/targets/devices/"+ftd.Uid,
+		httpmock.NewJsonResponderOrPanic(200, ftd))
+	httpmock.RegisterResponder(netHttp.MethodGet, "/anubis/rest/v1/users",
+		httpmock.NewJsonResponderOrPanic(200, []model.UserDetails{
+			{Uid: "user-uid", Name: "barack@example.com", UserRoles: []string{"ROLE_ADMIN"}},
+			{Uid: "api-user-uid", Name: "api-user@CDO_test-tenant", UserRoles: []string{"ROLE_SUPER_ADMIN"}, ApiOnlyUser: true},
+		}))
+	httpmock.RegisterResponder(netHttp.MethodGet, "/api/rest/v1/settings/tenant",
+		httpmock.NewJsonResponderOrPanic(200, map[string]any{
+			"uid":                            "11111111-1111-1111-1111-111111111111",
+			"changeRequestSupport":           true,
+			"autoAcceptDeviceChanges":        false,
+			"webAnalytics":                   true,
+			"scheduledDeployments":           false,
+			"denyCiscoSupportAccessToTenant": false,
+			"multicloudDefense":              true,
+			"autoDiscoverOnPremFmcs":         false,
+			"conflictDetectionInterval":      "EVERY_HOUR",
+		}))
+	httpmock.RegisterResponder(netHttp.MethodGet, "/api/rest/v1/msp/tenants",
+		httpmock.NewJsonResponderOrPanic(403, "Forbidden"))
+
+	client, err := cdoClient.New(baseUrl, "a_valid_token")
+	assert.NoError(t, err)
+
+	var warnings []string
+	e := newExporter(client, func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	})
+	assert.NoError(t, e.Export(context.Background()))
+
+	dir := t.TempDir()
+	assert.NoError(t, e.Write(dir))
+
+	assert.Len(t, warnings, 1)
+	assert.Contains(t, warnings[0], "skipping MSP-managed tenants")
+
+	assert.Equal(t, `resource "cdo_sdc" "my_sdc" {
+  name = "my-sdc"
+}
+
+resource "cdo_sec" "sec_1" {}
+`, readFile(t, dir, connectorsFile))
+
+	assert.Equal(t, `resource "cdo_asa_device" "my_asa" {
+  name               = "my-asa"
+  connector_type     = "SDC"
+  connector_name     = "my-sdc"
+  socket_address     = "10.0.0.1:443"
+  username           = var.asa_device_my_asa_username
+  password           = var.asa_device_my_asa_password
+  ignore_certificate = false
+  labels             = ["prod"]
+  grouped_labels     = { "site" = ["sjc"] }
+}
+
+resource "cdo_ios_device" "my_ios" {
+  name               = "my-ios"
+  connector_name     = "my-sdc"
+  socket_address     = "10.0.0.2:22"
+  username           = var.ios_device_my_ios_username
+  password           = var.ios_device_my_ios_password
+  ignore_certificate = true
+  labels             = []
+  grouped_labels     = {}
+}
+
+resource "cdo_ftd_device" "my_ftd" {
+  name               = "my-ftd"
+  access_policy_name = "Default Access Control Policy"
+  virtual            = true
+  performance_tier   = "FTDv10"
+  licenses           = ["BASE", "THREAT"]
+  labels             = []
+  grouped_labels     = {}
+}
+`, readFile(t, dir, devicesFile))
+
+	assert.Equal(t, `resource "cdo_user" "barack_example_com" {
+  name             = "barack@example.com"
+  is_api_only_user = false
+  role             = "ROLE_ADMIN"
+}
+
+resource "cdo_user" "api_user" {
+  name             = "api-user"
+  is_api_only_user = true
+  role             = "ROLE_SUPER_ADMIN"
+}
+`, readFile(t, dir, usersFile))
+
+	assert.Contains(t, readFile(t, dir, tenantFile), `  conflict_detection_interval                 = "EVERY_HOUR"`)
+	assert.NotContains(t, readFile(t, dir, tenantFile), "cdo_cdfmc")
+
+	assert.Contains(t, readFile(t, dir, variablesFile), `variable "asa_device_my_asa_password" {
+  type        = string
+  description = "The password of cdo_asa_device.my_asa."
+  sensitive   = true
+}`)
+
+	imports := readFile(t, dir, importsFile)
+	assert.Contains(t, imports, `import {
+  to = cdo_ftd_device.my_ftd
+  id = "ftd-uid"
+}`)
+	assert.Contains(t, imports, `import {
+  to = cdo_tenant_settings.tenant_settings
+  id = "11111111-1111-1111-1111-111111111111"
+}`)
+
+	_, err = os.Stat(filepath.Join(dir, mspFile))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestExportMspManagedTenants(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	client, err := cdoClient.New(baseUrl, "a_valid_token")
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		testName   string
+		statusCode int
+		assertFunc func(err error, warnings []string, t *testing.T)
+	}{
+		{
+			testName:   "skips MSP-managed tenants if the tenant is not an MSP portal",
+			statusCode: 403,
+			assertFunc: func(err error, warnings []string, t *testing.T) {
+				assert.NoError(t, err)
+				assert.Len(t, warnings, 1)
+				assert.Contains(t, warnings[0], "skipping MSP-managed tenants")
+			},
+		},
+		{
+			testName:   "skips MSP-managed tenants if they are not found",
+			statusCode: 404,
+			assertFunc: func(err error, warnings []string, t *testing.T) {
+				assert.NoError(t, err)
+				assert.Len(t, warnings, 1)
+			},
+		},
+		{
+			testName:   "fails if MSP-managed tenants cannot be read",
+			statusCode: 500,
+			assertFunc: func(err error, warnings []string, t *testing.T) {
+				assert.Error(t, err)
+				assert.Empty(t, warnings)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			httpmock.RegisterResponder(netHttp.MethodGet, "/api/rest/v1/msp/tenants",
+				httpmock.NewJsonResponderOrPanic(testCase.statusCode, "error"))
+
+			var warnings []string
+			e := newExporter(client, func(format string, args ...any) {
+				warnings = append(warnings, fmt.Sprintf(format, args...))
+			})
+
+			testCase.assertFunc(e.exportMspManagedTenants(context.Background()), warnings, t)
+		})
+	}
+}
+
+func TestUniqueIdentifier(t *testing.T) {
+	e := newExporter(nil, func(string, ...any) {})
+
+	assert.Equal(t, "fw", e.uniqueIdentifier("cdo_asa_device", "fw"))
+	assert.Equal(t, "fw_2", e.uniqueIdentifier("cdo_asa_device", "FW"))
+	assert.Equal(t, "fw", e.uniqueIdentifier("cdo_ios_device", "fw"))
+}
+
+func readFile(t *testing.T, dir string, name string) string {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	assert.NoError(t, err)
+	return string(content)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// block is a top-level HCL block, such as a resource, an import or a variable.
+type block struct {
+	Type   string
+	Labels []string
+	Attrs  []attribute
+}
+
+// attribute is an HCL attribute, Value is an already rendered HCL expression.
+type attribute struct {
+	Name  string
+	Value string
+}
+
+func newBlock(blockType string, labels ...string) *block {
+	return &block{
+		Type:   blockType,
+		Labels: labels,
+	}
+}
+
+func (b *block) Add(name string, value string) *block {
+	b.Attrs = append(b.Attrs, attribute{Name: name, Value: value})
+	return b
+}
+
+// Render writes the block in the same layout as `terraform fmt`.
+func (b *block) Render(sb *strings.Builder) {
+	sb.WriteString(b.Type)
+	for _, label := range b.Labels {
+		sb.WriteString(" ")
+		sb.WriteString(hclString(label))
+	}
+	if len(b.Attrs) == 0 {
+		sb.WriteString(" {}\n")
+		return
+	}
+	sb.WriteString(" {\n")
+
+	width := 0
+	for _, attr := range b.Attrs {
+		if len(attr.Name) > width {
+			width = len(attr.Name)
+		}
+	}
+	for _, attr := range b.Attrs {
+		sb.WriteString(fmt.Sprintf("  %-*s = %s\n", width, attr.Name, attr.Value))
+	}
+	sb.WriteString("}\n")
+}
+
+func renderBlocks(blocks []*block) string {
+	var sb strings.Builder
+	for i, b := range blocks {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		b.Render(&sb)
+	}
+	return sb.String()
+}
+
+var hclStringEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	`"`, `\"`,
+	"\n", `\n`,
+	"\r", `\r`,
+	"\t", `\t`,
+	"${", "$${",
+	"%{", "%%{",
+)
+
+// hclString renders a quoted HCL string, escaping template sequences.
+func hclString(s string) string {
+	return `"` + hclStringEscaper.Replace(s) + `"`
+}
+
+func hclBool(b bool) string {
+	return fmt.Sprintf("%t", b)
+}
+
+func hclStringList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = hclString(value)
+	}
+	return "[" + strings.Join(quoted, ", ") + "]"
+}
+
+// hclStringListMap renders a map of string lists, with its keys sorted so that the output is stable.
+func hclStringListMap(values map[string][]string) string {
+	if len(values) == 0 {
+		return "{}"
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]string, len(keys))
+	for i, key := range keys {
+		entries[i] = hclString(key) + " = " + hclStringList(values[key])
+	}
+	return "{ " + strings.Join(entries, ", ") + " }"
+}
+
+var nonIdentifierChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// identifier turns a CDO name into a valid Terraform identifier.
+func identifier(name string) string {
+	id := strings.Trim(nonIdentifierChars.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if id == "" {
+		return "unnamed"
+	}
+	if id[0] >= '0' && id[0] <= '9' {
+		return "_" + id
+	}
+	return id
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIdentifier(t *testing.T) {
+	testCases := map[string]string{
+		"my-asa":         "my_asa",
+		"My ASA (prod)":  "my_asa_prod",
+		"1st-firewall":   "_1st_firewall",
+		"--":             "unnamed",
+		"already_valid1": "already_valid1",
+	}
+
+	for name, expected := range testCases {
+		assert.Equal(t, expected, identifier(name), name)
+	}
+}
+
+func TestHclString(t *testing.T) {
+	assert.Equal(t, `"plain"`, hclString("plain"))
+	assert.Equal(t, `"say \"hi\"\\n"`, hclString(`say "hi"\n`))
+	assert.Equal(t, `"$${not_interpolated} %%{not_a_directive}"`, hclString("${not_interpolated} %{not_a_directive}"))
+}
+
+func TestRenderBlock(t *testing.T) {
+	var sb strings.Builder
+	newBlock("resource", "cdo_ios_device", "my_ios").
+		Add("name", hclString("my-ios")).
+		Add("ignore_certificate", hclBool(true)).
+		Add("labels", hclStringList([]string{"a", "b"})).
+		Add("grouped_labels", hclStringListMap(map[string][]string{"env": {"prod"}, "app": {"x", "y"}})).
+		Render(&sb)
+
+	assert.Equal(t, `resource "cdo_ios_device" "my_ios" {
+  name               = "my-ios"
+  ignore_certificate = true
+  labels             = ["a", "b"]
+  grouped_labels     = { "app" = ["x", "y"], "env" = ["prod"] }
+}
+`, sb.String())
+}
+
+func TestRenderEmptyBlock(t *testing.T) {
+	var sb strings.Builder
+	newBlock("resource", "cdo_cdfmc", "cdfmc").Render(&sb)
+
+	assert.Equal(t, "resource \"cdo_cdfmc\" \"cdfmc\" {}\n", sb.String())
+}
@@ -0,0 +1,53 @@
+// Command cdo-export exports the objects of an existing CDO tenant to Terraform configuration.
+//
+// It writes one .tf file per kind of object, together with the import blocks that bring the objects
+// under Terraform management on the next `terraform apply`, and variables for the credentials that
+// cannot be read back from CDO. Import blocks require Terraform 1.5 or later.
+//
+// Usage:
+//
+//	CISCO_CDO_API_TOKEN=<api token> cdo-export -base-url https://www.defenseorchestrator.com -out ./exported
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+)
+
+func main() {
+	baseUrl := flag.String("base-url", os.Getenv("CISCO_CDO_BASE_URL"), "the base URL of the CDO region of the tenant, defaults to the CISCO_CDO_BASE_URL environment variable")
+	outDir := flag.String("out", ".", "the directory to write the .tf files to")
+	flag.Parse()
+
+	apiToken := os.Getenv("CISCO_CDO_API_TOKEN")
+	if apiToken == "" {
+		exitWithError(fmt.Errorf("the CISCO_CDO_API_TOKEN environment variable must be set"))
+	}
+	if *baseUrl == "" {
+		exitWithError(fmt.Errorf("the base URL must be set with -base-url or the CISCO_CDO_BASE_URL environment variable"))
+	}
+
+	client, err := cdoClient.New(*baseUrl, apiToken)
+	if err != nil {
+		exitWithError(err)
+	}
+
+	e := newExporter(client, func(format string, args ...any) {
+		fmt.Fprintf(os.Stderr, "warning: "+format+"\n", args...)
+	})
+	if err := e.Export(context.Background()); err != nil {
+		exitWithError(err)
+	}
+	if err := e.Write(*outDir); err != nil {
+		exitWithError(err)
+	}
+}
+
+func exitWithError(err error) {
+	fmt.Fprintf(os.Stderr, "error: %s\n", err)
+	os.Exit(1)
+}
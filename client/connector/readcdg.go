@@ -0,0 +1,27 @@
+package connector
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+)
+
+// ReadCdg reads the Cloud Connector (CDG) of the tenant.
+func ReadCdg(ctx context.Context, client http.Client) (*ReadOutput, error) {
+
+	client.Logger.Println("reading cdg")
+
+	connectors, err := ReadAll(ctx, client, *NewReadAllInput())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, connector := range *connectors {
+		if connector.Cdg {
+			return &connector, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: cloud connector not found", http.NotFoundError)
+}
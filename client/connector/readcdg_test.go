@@ -0,0 +1,103 @@
+package connector_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestReadCdg(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	validCdg := connector.NewConnectorOutputBuilder().
+		AsDefaultCloudConnector().
+		WithUid(cdgUid).
+		WithName(cdgName).
+		WithTenantUid(tenantUid).
+		Build()
+
+	validConnector := connector.NewConnectorOutputBuilder().
+		AsOnPremConnector().
+		WithUid(connectorUid).
+		WithName(connectorName).
+		WithTenantUid(tenantUid).
+		Build()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *connector.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully fetches the cloud connector",
+
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					"GET",
+					"/aegis/rest/v1/services/targets/proxies",
+					httpmock.NewJsonResponderOrPanic(200, connector.ReadAllOutput{validConnector, validCdg}),
+				)
+			},
+
+			assertFunc: func(output *connector.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validCdg, *output)
+			},
+		},
+		{
+			testName: "returns not found error when the tenant has no cloud connector",
+
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					"GET",
+					"/aegis/rest/v1/services/targets/proxies",
+					httpmock.NewJsonResponderOrPanic(200, connector.ReadAllOutput{validConnector}),
+				)
+			},
+
+			assertFunc: func(output *connector.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorIs(t, err, http.NotFoundError)
+			},
+		},
+		{
+			testName: "returns error when reading connectors fails",
+
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					"GET",
+					"/aegis/rest/v1/services/targets/proxies",
+					httpmock.NewStringResponder(500, ""),
+				)
+			},
+
+			assertFunc: func(output *connector.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := connector.ReadCdg(
+				context.Background(),
+				*http.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
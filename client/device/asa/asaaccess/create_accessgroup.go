@@ -0,0 +1,36 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type CreateAccessGroupInput struct {
+	AccessGroup asaaccess.AccessGroup
+}
+
+func NewCreateAccessGroupInput(accessGroup asaaccess.AccessGroup) CreateAccessGroupInput {
+	return CreateAccessGroupInput{
+		AccessGroup: accessGroup,
+	}
+}
+
+type CreateAccessGroupOutput = asaaccess.AccessGroup
+
+// CreateAccessGroup stages the access group on CDO, it is only written to the ASA when its changes are deployed.
+func CreateAccessGroup(ctx context.Context, client http.Client, createInp CreateAccessGroupInput) (*CreateAccessGroupOutput, error) {
+
+	client.Logger.Println("creating ASA access group")
+
+	req := client.NewPost(ctx, url.CreateAsaAccessGroup(client.BaseUrl()), createInp.AccessGroup)
+
+	var outp CreateAccessGroupOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
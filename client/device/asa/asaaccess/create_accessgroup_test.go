@@ -0,0 +1,79 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccessGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaaccess.CreateAccessGroupOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates access group",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaAccessGroup(baseUrl),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[modelAccess.AccessGroup](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, accessGroupToCreate, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validAccessGroup)
+					},
+				)
+			},
+			assertFunc: func(output *asaaccess.CreateAccessGroupOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessGroup, *output)
+			},
+		},
+		{
+			testName: "returns error when create fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaAccessGroup(baseUrl),
+					httpmock.NewStringResponder(http.StatusBadRequest, "interface already has an access group in this direction"),
+				)
+			},
+			assertFunc: func(output *asaaccess.CreateAccessGroupOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaaccess.CreateAccessGroup(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewCreateAccessGroupInput(accessGroupToCreate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type CreateAccessListInput struct {
+	AccessList asaaccess.AccessList
+}
+
+func NewCreateAccessListInput(accessList asaaccess.AccessList) CreateAccessListInput {
+	return CreateAccessListInput{
+		AccessList: accessList,
+	}
+}
+
+type CreateAccessListOutput = asaaccess.AccessList
+
+// CreateAccessList stages the access list on CDO, it is only written to the ASA when its changes are deployed.
+func CreateAccessList(ctx context.Context, client http.Client, createInp CreateAccessListInput) (*CreateAccessListOutput, error) {
+
+	client.Logger.Println("creating ASA access list")
+
+	req := client.NewPost(ctx, url.CreateAsaAccessList(client.BaseUrl()), createInp.AccessList)
+
+	var outp CreateAccessListOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,79 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateAccessList(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaaccess.CreateAccessListOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates access list with ordered entries",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaAccessList(baseUrl),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[modelAccess.AccessList](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, accessListToCreate, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validAccessList)
+					},
+				)
+			},
+			assertFunc: func(output *asaaccess.CreateAccessListOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessList, *output)
+			},
+		},
+		{
+			testName: "returns error when create fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaAccessList(baseUrl),
+					httpmock.NewStringResponder(http.StatusBadRequest, "access list already exists"),
+				)
+			},
+			assertFunc: func(output *asaaccess.CreateAccessListOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaaccess.CreateAccessList(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewCreateAccessListInput(accessListToCreate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
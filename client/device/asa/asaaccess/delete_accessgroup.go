@@ -0,0 +1,36 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteAccessGroupInput struct {
+	Uid string
+}
+
+func NewDeleteAccessGroupInput(uid string) DeleteAccessGroupInput {
+	return DeleteAccessGroupInput{
+		Uid: uid,
+	}
+}
+
+type DeleteAccessGroupOutput struct {
+}
+
+// DeleteAccessGroup stages the removal of the access group on CDO, it is only removed from the ASA when its changes are deployed.
+func DeleteAccessGroup(ctx context.Context, client http.Client, deleteInp DeleteAccessGroupInput) (*DeleteAccessGroupOutput, error) {
+
+	client.Logger.Println("deleting ASA access group")
+
+	req := client.NewDelete(ctx, url.AsaAccessGroupByUid(client.BaseUrl(), deleteInp.Uid))
+
+	var outp DeleteAccessGroupOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,70 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteAccessGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asaaccess.DeleteAccessGroupOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes access group",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					httpmock.NewStringResponder(http.StatusOK, ""),
+				)
+			},
+			assertFunc: func(output *asaaccess.DeleteAccessGroupOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when delete fails",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *asaaccess.DeleteAccessGroupOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asaaccess.DeleteAccessGroup(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewDeleteAccessGroupInput(accessGroupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
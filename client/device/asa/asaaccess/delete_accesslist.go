@@ -0,0 +1,36 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteAccessListInput struct {
+	Uid string
+}
+
+func NewDeleteAccessListInput(uid string) DeleteAccessListInput {
+	return DeleteAccessListInput{
+		Uid: uid,
+	}
+}
+
+type DeleteAccessListOutput struct {
+}
+
+// DeleteAccessList stages the removal of the access list on CDO, it is only removed from the ASA when its changes are deployed.
+func DeleteAccessList(ctx context.Context, client http.Client, deleteInp DeleteAccessListInput) (*DeleteAccessListOutput, error) {
+
+	client.Logger.Println("deleting ASA access list")
+
+	req := client.NewDelete(ctx, url.AsaAccessListByUid(client.BaseUrl(), deleteInp.Uid))
+
+	var outp DeleteAccessListOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
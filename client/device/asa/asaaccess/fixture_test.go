@@ -0,0 +1,66 @@
+package asaaccess_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	deviceUid      = "unit-test-device-uid"
+	accessListUid  = "unit-test-access-list-uid"
+	accessListName = "unit-test-access-list-name"
+	accessGroupUid = "unit-test-access-group-uid"
+
+	networkObjectUid = "unit-test-network-object-uid"
+	serviceObjectUid = "unit-test-service-object-uid"
+)
+
+var (
+	accessListToCreate = asaaccess.AccessList{
+		DeviceUid: deviceUid,
+		Name:      accessListName,
+		Entries: []asaaccess.Entry{
+			{
+				Position:    1,
+				Action:      asaaccess.Permit,
+				Source:      asaaccess.NewReference(networkObjectUid),
+				Service:     asaaccess.NewReference(serviceObjectUid),
+				Logging:     &asaaccess.Logging{Level: "informational", Interval: 300},
+				Remark:      "allow the internal network to the web",
+				Destination: asaaccess.NewReference(""),
+			},
+			{
+				Position: 2,
+				Action:   asaaccess.Deny,
+			},
+		},
+	}
+	validAccessList = withHitCounts(accessListToCreate, 42, 7)
+
+	accessGroupToCreate = asaaccess.AccessGroup{
+		DeviceUid:     deviceUid,
+		AccessListUid: accessListUid,
+		InterfaceName: "inside",
+		Direction:     asaaccess.In,
+	}
+	validAccessGroup = asaaccess.AccessGroup{
+		Uid:           accessGroupUid,
+		DeviceUid:     deviceUid,
+		AccessListUid: accessListUid,
+		InterfaceName: "inside",
+		Direction:     asaaccess.In,
+	}
+)
+
+// withHitCounts returns the access list as read from CDO, with the given hit counts of its entries.
+func withHitCounts(accessList asaaccess.AccessList, hitCounts ...int64) asaaccess.AccessList {
+	accessList.Uid = accessListUid
+	entries := make([]asaaccess.Entry, len(accessList.Entries))
+	copy(entries, accessList.Entries)
+	for i := range entries {
+		entries[i].HitCount = hitCounts[i]
+	}
+	accessList.Entries = entries
+	return accessList
+}
@@ -0,0 +1,35 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type ReadAccessGroupInput struct {
+	Uid string
+}
+
+func NewReadAccessGroupInput(uid string) ReadAccessGroupInput {
+	return ReadAccessGroupInput{
+		Uid: uid,
+	}
+}
+
+type ReadAccessGroupOutput = asaaccess.AccessGroup
+
+func ReadAccessGroup(ctx context.Context, client http.Client, readInp ReadAccessGroupInput) (*ReadAccessGroupOutput, error) {
+
+	client.Logger.Println("reading ASA access group")
+
+	req := client.NewGet(ctx, url.AsaAccessGroupByUid(client.BaseUrl(), readInp.Uid))
+
+	var outp ReadAccessGroupOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
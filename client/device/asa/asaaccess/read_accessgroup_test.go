@@ -0,0 +1,72 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAccessGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asaaccess.ReadAccessGroupOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads access group",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessGroup),
+				)
+			},
+			assertFunc: func(output *asaaccess.ReadAccessGroupOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessGroup, *output)
+			},
+		},
+		{
+			testName: "returns not found error when access group does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *asaaccess.ReadAccessGroupOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asaaccess.ReadAccessGroup(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewReadAccessGroupInput(accessGroupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type ReadAccessListInput struct {
+	Uid string
+}
+
+func NewReadAccessListInput(uid string) ReadAccessListInput {
+	return ReadAccessListInput{
+		Uid: uid,
+	}
+}
+
+type ReadAccessListOutput = asaaccess.AccessList
+
+func ReadAccessList(ctx context.Context, client http.Client, readInp ReadAccessListInput) (*ReadAccessListOutput, error) {
+
+	client.Logger.Println("reading ASA access list")
+
+	req := client.NewGet(ctx, url.AsaAccessListByUid(client.BaseUrl(), readInp.Uid))
+
+	var outp ReadAccessListOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
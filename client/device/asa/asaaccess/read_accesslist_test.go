@@ -0,0 +1,73 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAccessList(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asaaccess.ReadAccessListOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads access list with hit counts",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaAccessListByUid(baseUrl, accessListUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessList),
+				)
+			},
+			assertFunc: func(output *asaaccess.ReadAccessListOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessList, *output)
+				assert.Equal(t, int64(42), output.Entries[0].HitCount)
+			},
+		},
+		{
+			testName: "returns not found error when access list does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaAccessListByUid(baseUrl, accessListUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *asaaccess.ReadAccessListOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asaaccess.ReadAccessList(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewReadAccessListInput(accessListUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
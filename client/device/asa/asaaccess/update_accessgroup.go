@@ -0,0 +1,41 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type UpdateAccessGroupInput struct {
+	Uid         string
+	AccessGroup asaaccess.AccessGroup
+}
+
+func NewUpdateAccessGroupInput(uid string, accessGroup asaaccess.AccessGroup) UpdateAccessGroupInput {
+	return UpdateAccessGroupInput{
+		Uid:         uid,
+		AccessGroup: accessGroup,
+	}
+}
+
+type UpdateAccessGroupOutput = asaaccess.AccessGroup
+
+// UpdateAccessGroup stages the changes to the access group on CDO, they are only written to the ASA when its changes are deployed.
+func UpdateAccessGroup(ctx context.Context, client http.Client, updateInp UpdateAccessGroupInput) (*UpdateAccessGroupOutput, error) {
+
+	client.Logger.Println("updating ASA access group")
+
+	updateBody := updateInp.AccessGroup
+	updateBody.Uid = updateInp.Uid
+
+	req := client.NewPut(ctx, url.AsaAccessGroupByUid(client.BaseUrl(), updateInp.Uid), updateBody)
+
+	var outp UpdateAccessGroupOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,85 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateAccessGroup(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	globalAccessGroup := accessGroupToCreate
+	globalAccessGroup.InterfaceName = ""
+	globalAccessGroup.Direction = modelAccess.Global
+	validGlobalAccessGroup := globalAccessGroup
+	validGlobalAccessGroup.Uid = accessGroupUid
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaaccess.UpdateAccessGroupOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates access group to global",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[modelAccess.AccessGroup](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, validGlobalAccessGroup, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validGlobalAccessGroup)
+					},
+				)
+			},
+			assertFunc: func(output *asaaccess.UpdateAccessGroupOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validGlobalAccessGroup, *output)
+			},
+		},
+		{
+			testName: "returns error when update fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaAccessGroupByUid(baseUrl, accessGroupUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *asaaccess.UpdateAccessGroupOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaaccess.UpdateAccessGroup(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewUpdateAccessGroupInput(accessGroupUid, globalAccessGroup),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
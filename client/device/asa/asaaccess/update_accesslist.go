@@ -0,0 +1,41 @@
+package asaaccess
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+)
+
+type UpdateAccessListInput struct {
+	Uid        string
+	AccessList asaaccess.AccessList
+}
+
+func NewUpdateAccessListInput(uid string, accessList asaaccess.AccessList) UpdateAccessListInput {
+	return UpdateAccessListInput{
+		Uid:        uid,
+		AccessList: accessList,
+	}
+}
+
+type UpdateAccessListOutput = asaaccess.AccessList
+
+// UpdateAccessList stages the changes to the access list on CDO, they are only written to the ASA when its changes are deployed.
+func UpdateAccessList(ctx context.Context, client http.Client, updateInp UpdateAccessListInput) (*UpdateAccessListOutput, error) {
+
+	client.Logger.Println("updating ASA access list")
+
+	updateBody := updateInp.AccessList
+	updateBody.Uid = updateInp.Uid
+
+	req := client.NewPut(ctx, url.AsaAccessListByUid(client.BaseUrl(), updateInp.Uid), updateBody)
+
+	var outp UpdateAccessListOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,87 @@
+package asaaccess_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateAccessList(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// the entries are reordered, the deny entry comes first
+	reorderedAccessList := accessListToCreate
+	reorderedAccessList.Entries = []modelAccess.Entry{accessListToCreate.Entries[1], accessListToCreate.Entries[0]}
+	reorderedAccessList.Entries[0].Position = 1
+	reorderedAccessList.Entries[1].Position = 2
+	validReorderedAccessList := withHitCounts(reorderedAccessList, 0, 0)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaaccess.UpdateAccessListOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates order of access list entries",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaAccessListByUid(baseUrl, accessListUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[modelAccess.AccessList](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, accessListUid, body.Uid)
+						assert.Equal(t, reorderedAccessList.Entries, body.Entries)
+						return httpmock.NewJsonResponse(http.StatusOK, validReorderedAccessList)
+					},
+				)
+			},
+			assertFunc: func(output *asaaccess.UpdateAccessListOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validReorderedAccessList, *output)
+			},
+		},
+		{
+			testName: "returns error when update fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaAccessListByUid(baseUrl, accessListUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *asaaccess.UpdateAccessListOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaaccess.UpdateAccessList(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaaccess.NewUpdateAccessListInput(accessListUid, reorderedAccessList),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
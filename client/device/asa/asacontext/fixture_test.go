@@ -0,0 +1,25 @@
+package asacontext_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asacontext"
+)
+
+const (
+	systemDeviceUid  = "unit-test-system-device-uid"
+	contextName      = "unit-test-context"
+	contextDeviceUid = "unit-test-context-device-uid"
+)
+
+var (
+	onboardedContext = asacontext.Context{
+		Name:           contextName,
+		FirewallMode:   asacontext.Routed,
+		IsAdminContext: false,
+		DeviceUid:      contextDeviceUid,
+	}
+	adminContext = asacontext.Context{
+		Name:           "admin",
+		FirewallMode:   asacontext.Routed,
+		IsAdminContext: true,
+	}
+)
@@ -0,0 +1,56 @@
+package asacontext
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type OnboardInput struct {
+	SystemDeviceUid string `json:"-"`
+	ContextName     string `json:"contextName"`
+	Name            string `json:"name"`
+}
+
+type OnboardOutput = asa.ReadOutput
+
+func NewOnboardInput(systemDeviceUid string, contextName string, name string) OnboardInput {
+	return OnboardInput{
+		SystemDeviceUid: systemDeviceUid,
+		ContextName:     contextName,
+		Name:            name,
+	}
+}
+
+// Onboard onboards a security context of an ASA as a device of its own, linked to the device of the system context.
+// The context is onboarded with the credentials and connector of the system context, and can be updated like any other ASA afterwards.
+func Onboard(ctx context.Context, client http.Client, onboardInp OnboardInput) (*OnboardOutput, error) {
+
+	client.Logger.Println("onboarding ASA security context")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.OnboardAsaContext(client.BaseUrl(), onboardInp.SystemDeviceUid), onboardInp)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(fmt.Sprintf("Waiting for ASA security context %s to be onboarded...", onboardInp.ContextName)).
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	// the entity of the transaction is the device the context is onboarded as
+	return asa.Read(ctx, client, *asa.NewReadInput(transaction.EntityUid))
+}
@@ -0,0 +1,105 @@
+package asacontext_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asacontext"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOnboard(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	contextDevice := asa.ReadOutput{
+		Uid:  contextDeviceUid,
+		Name: "unit-test-context-device",
+	}
+	doneTransaction := testModel.CreateDoneTransaction(contextDeviceUid, transactiontype.ONBOARD_ASA_CONTEXT)
+	errorTransaction := testModel.CreateErrorTransaction(contextDeviceUid, transactiontype.ONBOARD_ASA_CONTEXT)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asacontext.OnboardOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully onboards the security context",
+
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.OnboardAsaContext(testModel.BaseUrl, systemDeviceUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[asacontext.OnboardInput](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, contextName, body.ContextName)
+						assert.Equal(t, contextDevice.Name, body.Name)
+						return httpmock.NewJsonResponse(http.StatusAccepted, doneTransaction)
+					},
+				)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, contextDeviceUid), contextDevice)
+			},
+
+			assertFunc: func(output *asacontext.OnboardOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, contextDevice, *output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostAccepted(url.OnboardAsaContext(testModel.BaseUrl, systemDeviceUid), errorTransaction)
+			},
+
+			assertFunc: func(output *asacontext.OnboardOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostError(url.OnboardAsaContext(testModel.BaseUrl, systemDeviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *asacontext.OnboardOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asacontext.Onboard(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asacontext.NewOnboardInput(systemDeviceUid, contextName, contextDevice.Name),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
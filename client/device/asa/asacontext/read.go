@@ -0,0 +1,37 @@
+package asacontext
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asacontext"
+)
+
+type ReadInput struct {
+	SystemDeviceUid string
+	Name            string
+}
+
+type ReadOutput = asacontext.Context
+
+func NewReadInput(systemDeviceUid string, name string) ReadInput {
+	return ReadInput{
+		SystemDeviceUid: systemDeviceUid,
+		Name:            name,
+	}
+}
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading ASA security context")
+
+	req := client.NewGet(ctx, url.ReadAsaContext(client.BaseUrl(), readInp.SystemDeviceUid, readInp.Name))
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,74 @@
+package asacontext_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asacontext"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asacontext.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the security context",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadAsaContext(testModel.BaseUrl, systemDeviceUid, contextName), onboardedContext)
+			},
+
+			assertFunc: func(output *asacontext.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, onboardedContext, *output)
+			},
+		},
+		{
+			testName: "returns not found error when the security context does not exist",
+
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAsaContext(testModel.BaseUrl, systemDeviceUid, contextName),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+
+			assertFunc: func(output *asacontext.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asacontext.Read(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asacontext.NewReadInput(systemDeviceUid, contextName),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
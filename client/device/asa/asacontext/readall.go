@@ -0,0 +1,37 @@
+package asacontext
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asacontext"
+)
+
+type ReadAllInput struct {
+	SystemDeviceUid string
+}
+
+type ReadAllOutput = model.CdoListResponse[asacontext.Context]
+
+func NewReadAllInput(systemDeviceUid string) ReadAllInput {
+	return ReadAllInput{
+		SystemDeviceUid: systemDeviceUid,
+	}
+}
+
+// ReadAll reads the security contexts discovered on an ASA that is onboarded through its system context.
+func ReadAll(ctx context.Context, client http.Client, readInp ReadAllInput) (*ReadAllOutput, error) {
+
+	client.Logger.Println("reading all ASA security contexts")
+
+	req := client.NewGet(ctx, url.ReadAsaContexts(client.BaseUrl(), readInp.SystemDeviceUid))
+
+	var outp ReadAllOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
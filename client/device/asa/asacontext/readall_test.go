@@ -0,0 +1,76 @@
+package asacontext_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asacontext"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	modelAsaContext "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asacontext"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	contexts := model.CdoListResponse[modelAsaContext.Context]{
+		Items: []modelAsaContext.Context{adminContext, onboardedContext},
+		Count: 2,
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asacontext.ReadAllOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the security contexts of the ASA",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadAsaContexts(testModel.BaseUrl, systemDeviceUid), contexts)
+			},
+
+			assertFunc: func(output *asacontext.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, contexts, *output)
+			},
+		},
+		{
+			testName: "returns error when reading the security contexts fails",
+
+			setupFunc: func() {
+				internalTesting.MockGetError(url.ReadAsaContexts(testModel.BaseUrl, systemDeviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *asacontext.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asacontext.ReadAll(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asacontext.NewReadAllInput(systemDeviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package asaobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+type CreateInput struct {
+	Object asaobject.Object
+}
+
+func NewCreateInput(object asaobject.Object) CreateInput {
+	return CreateInput{
+		Object: object,
+	}
+}
+
+type CreateOutput = asaobject.Object
+
+// Create creates the object on CDO, it is only written to its ASAs when their changes are deployed.
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating ASA object")
+
+	createBody := createInp.Object
+	createBody.DeviceType = devicetype.Asa
+
+	req := client.NewPost(ctx, url.CreateAsaObject(client.BaseUrl()), createBody)
+
+	var outp CreateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,109 @@
+package asaobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		input      asaobjects.CreateInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaobjects.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates network object",
+			input:    asaobjects.NewCreateInput(networkObjectToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaObject(baseUrl),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[asaobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						expectedBody := networkObjectToCreate
+						expectedBody.DeviceType = devicetype.Asa
+						assert.Equal(t, expectedBody, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validNetworkObject)
+					},
+				)
+			},
+			assertFunc: func(output *asaobjects.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validNetworkObject, *output)
+			},
+		},
+		{
+			testName: "successfully creates service object shared across devices",
+			input:    asaobjects.NewCreateInput(sharedServiceObjectToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaObject(baseUrl),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[asaobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, sharedServiceObjectToCreate.Targets, body.Targets)
+						return httpmock.NewJsonResponse(http.StatusOK, validSharedServiceObject)
+					},
+				)
+			},
+			assertFunc: func(output *asaobjects.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validSharedServiceObject, *output)
+				assert.True(t, output.IsShared())
+			},
+		},
+		{
+			testName: "returns error when create fails",
+			input:    asaobjects.NewCreateInput(networkObjectToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateAsaObject(baseUrl),
+					httpmock.NewStringResponder(http.StatusBadRequest, "object with this name already exists"),
+				)
+			},
+			assertFunc: func(output *asaobjects.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaobjects.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
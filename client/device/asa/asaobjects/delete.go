@@ -0,0 +1,35 @@
+package asaobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteInput struct {
+	Uid string
+}
+
+func NewDeleteInput(uid string) DeleteInput {
+	return DeleteInput{
+		Uid: uid,
+	}
+}
+
+type DeleteOutput struct {
+}
+
+func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*DeleteOutput, error) {
+
+	client.Logger.Println("deleting ASA object")
+
+	req := client.NewDelete(ctx, url.AsaObjectByUid(client.BaseUrl(), deleteInp.Uid))
+
+	var outp DeleteOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,70 @@
+package asaobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asaobjects.DeleteOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes object",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.AsaObjectByUid(baseUrl, serviceObjectUid),
+					httpmock.NewStringResponder(http.StatusOK, ""),
+				)
+			},
+			assertFunc: func(output *asaobjects.DeleteOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when object is still referenced",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.AsaObjectByUid(baseUrl, serviceObjectUid),
+					httpmock.NewStringResponder(http.StatusConflict, "object is referenced by an access list"),
+				)
+			},
+			assertFunc: func(output *asaobjects.DeleteOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asaobjects.Delete(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaobjects.NewDeleteInput(serviceObjectUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
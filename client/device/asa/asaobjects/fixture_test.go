@@ -0,0 +1,56 @@
+package asaobjects_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	asaUid1 = "unit-test-asa-uid-1"
+	asaUid2 = "unit-test-asa-uid-2"
+
+	networkObjectUid   = "unit-test-network-object-uid"
+	networkObjectName  = "unit-test-network-object-name"
+	networkObjectValue = "10.10.0.0/16"
+
+	serviceObjectUid  = "unit-test-service-object-uid"
+	serviceObjectName = "unit-test-service-object-name"
+
+	networkGroupUid  = "unit-test-network-group-uid"
+	networkGroupName = "unit-test-network-group-name"
+)
+
+var (
+	networkObjectToCreate = asaobject.Object{
+		Name:        networkObjectName,
+		Description: "unit-test-network-object-description",
+		ObjectType:  asaobject.NetworkObject,
+		Contents:    []asaobject.Content{asaobject.NewNetworkContent(asaobject.SubnetNetwork, networkObjectValue)},
+		Targets:     []asaobject.Target{asaobject.NewTarget(asaUid1)},
+	}
+	validNetworkObject = withUid(networkObjectToCreate, networkObjectUid)
+
+	sharedServiceObjectToCreate = asaobject.Object{
+		Name:       serviceObjectName,
+		ObjectType: asaobject.ServiceObject,
+		Contents:   []asaobject.Content{asaobject.NewServiceContent("tcp", "", "443")},
+		Targets:    []asaobject.Target{asaobject.NewTarget(asaUid1), asaobject.NewTarget(asaUid2)},
+	}
+	validSharedServiceObject = withUid(sharedServiceObjectToCreate, serviceObjectUid)
+
+	validNetworkGroup = withUid(asaobject.Object{
+		Name:       networkGroupName,
+		ObjectType: asaobject.NetworkGroup,
+		Contents:   []asaobject.Content{asaobject.NewNetworkContent(asaobject.HostNetwork, "10.20.0.1")},
+		References: []asaobject.Reference{asaobject.NewReference(networkObjectUid)},
+		Targets:    []asaobject.Target{asaobject.NewTarget(asaUid1)},
+	}, networkGroupUid)
+)
+
+func withUid(object asaobject.Object, uid string) asaobject.Object {
+	object.Uid = uid
+	object.DeviceType = devicetype.Asa
+	return object
+}
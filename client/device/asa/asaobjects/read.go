@@ -0,0 +1,35 @@
+package asaobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+)
+
+type ReadInput struct {
+	Uid string
+}
+
+func NewReadInput(uid string) ReadInput {
+	return ReadInput{
+		Uid: uid,
+	}
+}
+
+type ReadOutput = asaobject.Object
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading ASA object")
+
+	req := client.NewGet(ctx, url.AsaObjectByUid(client.BaseUrl(), readInp.Uid))
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
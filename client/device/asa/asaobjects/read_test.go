@@ -0,0 +1,73 @@
+package asaobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asaobjects.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads network group",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaObjectByUid(baseUrl, networkGroupUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validNetworkGroup),
+				)
+			},
+			assertFunc: func(output *asaobjects.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validNetworkGroup, *output)
+				assert.False(t, output.IsShared())
+			},
+		},
+		{
+			testName: "returns not found error when object does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.AsaObjectByUid(baseUrl, networkGroupUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *asaobjects.ReadOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asaobjects.Read(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaobjects.NewReadInput(networkGroupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
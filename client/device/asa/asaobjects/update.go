@@ -0,0 +1,43 @@
+package asaobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+type UpdateInput struct {
+	Uid    string
+	Object asaobject.Object
+}
+
+func NewUpdateInput(uid string, object asaobject.Object) UpdateInput {
+	return UpdateInput{
+		Uid:    uid,
+		Object: object,
+	}
+}
+
+type UpdateOutput = asaobject.Object
+
+// Update updates the object on CDO, a change to a shared object is written to all of its ASAs when their changes are deployed.
+func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating ASA object")
+
+	updateBody := updateInp.Object
+	updateBody.Uid = updateInp.Uid
+	updateBody.DeviceType = devicetype.Asa
+
+	req := client.NewPut(ctx, url.AsaObjectByUid(client.BaseUrl(), updateInp.Uid), updateBody)
+
+	var outp UpdateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
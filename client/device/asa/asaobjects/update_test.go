@@ -0,0 +1,84 @@
+package asaobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	updatedNetworkObject := networkObjectToCreate
+	updatedNetworkObject.Contents = []asaobject.Content{asaobject.NewNetworkContent(asaobject.RangeNetwork, "10.10.10.1-10.10.10.9")}
+	updatedNetworkObject.Targets = []asaobject.Target{asaobject.NewTarget(asaUid1), asaobject.NewTarget(asaUid2)}
+	validUpdatedNetworkObject := withUid(updatedNetworkObject, networkObjectUid)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asaobjects.UpdateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates network object",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaObjectByUid(baseUrl, networkObjectUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[asaobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, validUpdatedNetworkObject, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validUpdatedNetworkObject)
+					},
+				)
+			},
+			assertFunc: func(output *asaobjects.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validUpdatedNetworkObject, *output)
+			},
+		},
+		{
+			testName: "returns error when update fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.AsaObjectByUid(baseUrl, networkObjectUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *asaobjects.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asaobjects.Update(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				asaobjects.NewUpdateInput(networkObjectUid, updatedNetworkObject),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
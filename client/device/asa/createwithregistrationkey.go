@@ -0,0 +1,120 @@
+package asa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/publicapilabels"
+)
+
+type CreateWithRegistrationKeyInput struct {
+	Name          string
+	ConnectorUid  string
+	ConnectorType string
+	SocketAddress string
+	Labels        publicapilabels.Type
+
+	IgnoreCertificate bool
+}
+
+type createWithRegistrationKeyBody struct {
+	Name              string               `json:"name"`
+	DeviceAddress     string               `json:"deviceAddress"`
+	ConnectorType     string               `json:"connectorType"`
+	IgnoreCertificate bool                 `json:"ignoreCertificate"`
+	ConnectorName     string               `json:"connectorName"`
+	Labels            publicapilabels.Type `json:"labels"`
+}
+
+func NewCreateWithRegistrationKeyInput(name, connectorUid, connectorType, socketAddress string, ignoreCertificate bool, labels publicapilabels.Type) *CreateWithRegistrationKeyInput {
+	return &CreateWithRegistrationKeyInput{
+		Name:              name,
+		ConnectorUid:      connectorUid,
+		ConnectorType:     connectorType,
+		SocketAddress:     socketAddress,
+		IgnoreCertificate: ignoreCertificate,
+		Labels:            labels,
+	}
+}
+
+// CreateWithRegistrationKey creates an ASA device that is pending registration, without sending any credentials to CDO.
+// The command to run on the ASA is returned in the registration command of the specific device metadata,
+// use WaitForRegistration to wait for the ASA to register after the command has been run.
+func CreateWithRegistrationKey(ctx context.Context, client http.Client, createInp CreateWithRegistrationKeyInput) (*ReadOutput, *ReadSpecificOutput, *CreateError) {
+
+	client.Logger.Println("creating asa device with registration key")
+
+	createUrl := url.CreateAsaWithRegistrationKey(client.BaseUrl())
+
+	var connectorName string
+	if createInp.ConnectorType == "SDC" {
+		conn, err := connector.ReadByUid(ctx, client, connector.ReadByUidInput{ConnectorUid: createInp.ConnectorUid})
+		if err != nil {
+			return nil, nil, &CreateError{
+				Err:               err,
+				CreatedResourceId: nil,
+			}
+		}
+		connectorName = conn.Name
+	}
+
+	transaction, err := publicapi.TriggerTransaction(
+		ctx,
+		client,
+		createUrl,
+		createWithRegistrationKeyBody{
+			Name:              createInp.Name,
+			DeviceAddress:     createInp.SocketAddress,
+			ConnectorType:     createInp.ConnectorType,
+			IgnoreCertificate: createInp.IgnoreCertificate,
+			ConnectorName:     connectorName,
+			Labels:            createInp.Labels,
+		},
+	)
+	if err != nil {
+		return nil, nil, &CreateError{
+			Err:               err,
+			CreatedResourceId: &transaction.EntityUid,
+		}
+	}
+	transaction, err = publicapi.WaitForTransactionToFinishWithDefaults(
+		ctx,
+		client,
+		transaction,
+		"Waiting for Asa registration key to be generated...",
+	)
+	if err != nil {
+		return nil, nil, &CreateError{
+			Err:               err,
+			CreatedResourceId: &transaction.EntityUid,
+		}
+	}
+
+	readOut, err := Read(ctx, client, ReadInput{Uid: transaction.EntityUid})
+	if err != nil {
+		return nil, nil, &CreateError{
+			Err:               err,
+			CreatedResourceId: &transaction.EntityUid,
+		}
+	}
+
+	readSpecificOut, err := ReadSpecific(ctx, client, ReadSpecificInput{Uid: transaction.EntityUid})
+	if err != nil {
+		return nil, nil, &CreateError{
+			Err:               err,
+			CreatedResourceId: &transaction.EntityUid,
+		}
+	}
+	if readSpecificOut.Metadata.RegistrationCommand == "" {
+		return nil, nil, &CreateError{
+			Err:               fmt.Errorf("no registration command was generated for ASA %s", readOut.Name),
+			CreatedResourceId: &transaction.EntityUid,
+		}
+	}
+
+	return readOut, readSpecificOut, nil
+}
@@ -0,0 +1,123 @@
+package asa_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAsaCreateWithRegistrationKey(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	createInput := testModel.AsaCreateWithRegistrationKeyInput()
+	readOutput := testModel.AsaReadOutput()
+	readSpecificOutput := testModel.AsaReadSpecificDeviceOutput()
+	readSpecificOutput.Metadata.RegistrationCommand = "cdo-register unit-test-registration-key"
+	readSpecificOutputWithoutCommand := testModel.AsaReadSpecificDeviceOutput()
+	doneTransaction := testModel.CreateDoneTransaction(readOutput.Uid, transactiontype.ONBOARD_ASA_WITH_REGISTRATION_KEY)
+	errorTransaction := testModel.CreateErrorTransaction(readOutput.Uid, transactiontype.ONBOARD_ASA_WITH_REGISTRATION_KEY)
+
+	testCases := []struct {
+		testName   string
+		input      asa.CreateWithRegistrationKeyInput
+		setupFunc  func()
+		assertFunc func(output *asa.ReadOutput, specificDeviceOutput *asa.ReadSpecificOutput, err *asa.CreateError, t *testing.T)
+	}{
+		{
+			testName: "successfully creates ASA pending registration",
+			input:    createInput,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.CreateAsaWithRegistrationKey(testModel.BaseUrl), doneTransaction)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, readOutput.Uid), readSpecificOutput)
+			},
+
+			assertFunc: func(actualOutput *asa.ReadOutput, actualSpecificDeviceOutput *asa.ReadSpecificOutput, err *asa.CreateError, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, actualOutput)
+				assert.Equal(t, readOutput, *actualOutput)
+				assert.NotNil(t, actualSpecificDeviceOutput)
+				assert.Equal(t, readSpecificOutput.Metadata.RegistrationCommand, actualSpecificDeviceOutput.Metadata.RegistrationCommand)
+			},
+		},
+		{
+			testName: "fails to create ASA if no registration command is generated",
+			input:    createInput,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.CreateAsaWithRegistrationKey(testModel.BaseUrl), doneTransaction)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, readOutput.Uid), readSpecificOutputWithoutCommand)
+			},
+
+			assertFunc: func(actualOutput *asa.ReadOutput, actualSpecificDeviceOutput *asa.ReadSpecificOutput, err *asa.CreateError, t *testing.T) {
+				assert.Nil(t, actualOutput)
+				assert.Nil(t, actualSpecificDeviceOutput)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, "no registration command")
+				assert.Equal(t, readOutput.Uid, *err.CreatedResourceId)
+			},
+		},
+		{
+			testName: "fails to create ASA if transaction fails",
+			input:    createInput,
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.CreateAsaWithRegistrationKey(testModel.BaseUrl), errorTransaction)
+			},
+
+			assertFunc: func(actualOutput *asa.ReadOutput, actualSpecificDeviceOutput *asa.ReadSpecificOutput, err *asa.CreateError, t *testing.T) {
+				assert.Nil(t, actualOutput)
+				assert.Nil(t, actualSpecificDeviceOutput)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "fails to create ASA if reading the specific device fails",
+			input:    createInput,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.CreateAsaWithRegistrationKey(testModel.BaseUrl), doneTransaction)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+				internalTesting.MockGetError(url.ReadSpecificDevice(testModel.BaseUrl, readOutput.Uid), "intentional error")
+			},
+
+			assertFunc: func(actualOutput *asa.ReadOutput, actualSpecificDeviceOutput *asa.ReadSpecificOutput, err *asa.CreateError, t *testing.T) {
+				assert.Nil(t, actualOutput)
+				assert.Nil(t, actualSpecificDeviceOutput)
+				assert.NotNil(t, err)
+				assert.Equal(t, readOutput.Uid, *err.CreatedResourceId)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, specificDeviceOuput, err := asa.CreateWithRegistrationKey(
+				context.Background(),
+				*http.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, specificDeviceOuput, err, t)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package asa
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/devicecli"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cli"
+)
+
+type ExecuteCliInput struct {
+	DeviceUid string
+	Commands  []string
+}
+
+type ExecuteCliOutput = cli.Result
+
+func NewExecuteCliInput(deviceUid string, commands []string) ExecuteCliInput {
+	return ExecuteCliInput{
+		DeviceUid: deviceUid,
+		Commands:  commands,
+	}
+}
+
+// ExecuteCli executes the commands on the ASA through its connector, and waits for them to finish.
+func ExecuteCli(ctx context.Context, client http.Client, executeInp ExecuteCliInput) (*ExecuteCliOutput, error) {
+
+	client.Logger.Println("executing cli commands on asa")
+
+	return devicecli.Execute(ctx, client, url.ExecuteAsaCli(client.BaseUrl(), executeInp.DeviceUid), executeInp.Commands)
+}
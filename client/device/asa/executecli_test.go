@@ -0,0 +1,109 @@
+package asa_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteCli(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.AsaUid.String()
+	commands := []string{"show version", "show running-config | include hostname"}
+
+	result := asa.ExecuteCliOutput{
+		Uid:       uuid.New().String(),
+		DeviceUid: deviceUid,
+		Commands:  commands,
+		Output:    "Cisco Software, Version 1.0\nhostname unit-test\n",
+	}
+	doneTransaction := testModel.CreateDoneTransaction(result.Uid, transactiontype.EXECUTE_CLI)
+	errorTransaction := testModel.CreateErrorTransaction(result.Uid, transactiontype.EXECUTE_CLI)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *asa.ExecuteCliOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully executes the commands on the device",
+
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.ExecuteAsaCli(testModel.BaseUrl, deviceUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[map[string][]string](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, commands, (*body)["commands"])
+						return httpmock.NewJsonResponse(http.StatusAccepted, doneTransaction)
+					},
+				)
+				internalTesting.MockGetOk(url.CliResult(testModel.BaseUrl, result.Uid), result)
+			},
+
+			assertFunc: func(output *asa.ExecuteCliOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, result, *output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostAccepted(url.ExecuteAsaCli(testModel.BaseUrl, deviceUid), errorTransaction)
+			},
+
+			assertFunc: func(output *asa.ExecuteCliOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the result cannot be read",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostAccepted(url.ExecuteAsaCli(testModel.BaseUrl, deviceUid), doneTransaction)
+				internalTesting.MockGetError(url.CliResult(testModel.BaseUrl, result.Uid), "internal server error")
+			},
+
+			assertFunc: func(output *asa.ExecuteCliOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := asa.ExecuteCli(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asa.NewExecuteCliInput(deviceUid, commands),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package asa
+
+import (
+	"context"
+	"fmt"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"time"
+)
+
+type FailoverMode string
+
+const (
+	FailoverModeStandalone    FailoverMode = "STANDALONE"
+	FailoverModeActiveStandby FailoverMode = "ACTIVE_STANDBY"
+	FailoverModeActiveActive  FailoverMode = "ACTIVE_ACTIVE"
+)
+
+type FailoverRole string
+
+const (
+	FailoverRoleActive  FailoverRole = "ACTIVE"
+	FailoverRoleStandby FailoverRole = "STANDBY"
+)
+
+type FailoverHealth string
+
+const (
+	FailoverHealthHealthy  FailoverHealth = "HEALTHY"
+	FailoverHealthDegraded FailoverHealth = "DEGRADED"
+	FailoverHealthFailed   FailoverHealth = "FAILED"
+)
+
+// FailoverMetadata describes the failover pair an ASA belongs to. It is empty for devices
+// on which CDO has not (yet) detected a failover configuration, which are standalone.
+type FailoverMetadata struct {
+	Mode     FailoverMode   `json:"mode,omitempty"`
+	Role     FailoverRole   `json:"role,omitempty"`
+	PeerUnit string         `json:"peerUnit,omitempty"`
+	Health   FailoverHealth `json:"health,omitempty"`
+}
+
+func (metadata FailoverMetadata) IsPair() bool {
+	return metadata.Mode == FailoverModeActiveStandby || metadata.Mode == FailoverModeActiveActive
+}
+
+type FailoverInput struct {
+	DeviceUid string
+}
+
+type FailoverOutput = ReadSpecificOutput
+
+func NewFailoverInput(deviceUid string) FailoverInput {
+	return FailoverInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// Failover makes the standby unit of an ASA failover pair the active one, and returns the
+// failover state of the pair once the switch has completed.
+func Failover(ctx context.Context, client http.Client, failoverInp FailoverInput) (*FailoverOutput, error) {
+
+	client.Logger.Println("triggering failover on asa device")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.FailoverAsa(client.BaseUrl(), failoverInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// poll every 10 seconds for up to 10 minutes
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(10*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(fmt.Sprintf("Waiting for failover on ASA device %s to complete", failoverInp.DeviceUid)).
+		Delay(10*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadSpecific(ctx, client, *NewReadSpecificInput(failoverInp.DeviceUid))
+}
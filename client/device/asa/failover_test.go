@@ -0,0 +1,99 @@
+package asa_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailover(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.AsaUid.String()
+
+	specificDevice := asa.NewReadSpecificOutputBuilder().
+		WithSpecificUid("11111111-1111-1111-1111-111111111111").
+		InDoneState().
+		WithFailover(asa.FailoverMetadata{
+			Mode:     asa.FailoverModeActiveStandby,
+			Role:     asa.FailoverRoleStandby,
+			PeerUnit: "unit-test-asa-secondary",
+			Health:   asa.FailoverHealthHealthy,
+		}).
+		Build()
+	doneTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.FAILOVER_ASA)
+	errorTransaction := testModel.CreateErrorTransaction(deviceUid, transactiontype.FAILOVER_ASA)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asa.FailoverOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully fails over the ASA failover pair",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.FailoverAsa(testModel.BaseUrl, deviceUid), doneTransaction)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, deviceUid), specificDevice)
+			},
+
+			assertFunc: func(output *asa.FailoverOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, specificDevice, *output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.FailoverAsa(testModel.BaseUrl, deviceUid), errorTransaction)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, deviceUid), specificDevice)
+			},
+
+			assertFunc: func(output *asa.FailoverOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.FailoverAsa(testModel.BaseUrl, deviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *asa.FailoverOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asa.Failover(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asa.NewFailoverInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
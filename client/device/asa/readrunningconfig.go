@@ -0,0 +1,35 @@
+package asa
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/devicecli"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cli"
+)
+
+type ReadRunningConfigInput struct {
+	DeviceUid string
+}
+
+type ReadRunningConfigOutput = cli.RunningConfig
+
+func NewReadRunningConfigInput(deviceUid string) ReadRunningConfigInput {
+	return ReadRunningConfigInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// ReadRunningConfig reads the running config from the ASA through its connector, and waits for the read to finish.
+func ReadRunningConfig(ctx context.Context, client http.Client, readInp ReadRunningConfigInput) (*ReadRunningConfigOutput, error) {
+
+	client.Logger.Println("reading running config of asa")
+
+	return devicecli.ReadRunningConfig(
+		ctx,
+		client,
+		url.ReadRunningConfigFromAsa(client.BaseUrl(), readInp.DeviceUid),
+		url.AsaRunningConfig(client.BaseUrl(), readInp.DeviceUid),
+	)
+}
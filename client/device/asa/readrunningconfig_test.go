@@ -0,0 +1,93 @@
+package asa_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRunningConfig(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.AsaUid.String()
+
+	runningConfig := asa.ReadRunningConfigOutput{
+		DeviceUid: deviceUid,
+		Config:    "hostname unit-test\nssh version 2\n",
+	}
+	doneTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.READ_RUNNING_CONFIG)
+	errorTransaction := testModel.CreateErrorTransaction(deviceUid, transactiontype.READ_RUNNING_CONFIG)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *asa.ReadRunningConfigOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the running config from the device",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.ReadRunningConfigFromAsa(testModel.BaseUrl, deviceUid), doneTransaction)
+				internalTesting.MockGetOk(url.AsaRunningConfig(testModel.BaseUrl, deviceUid), runningConfig)
+			},
+
+			assertFunc: func(output *asa.ReadRunningConfigOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, runningConfig, *output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.ReadRunningConfigFromAsa(testModel.BaseUrl, deviceUid), errorTransaction)
+				internalTesting.MockGetOk(url.AsaRunningConfig(testModel.BaseUrl, deviceUid), runningConfig)
+			},
+
+			assertFunc: func(output *asa.ReadRunningConfigOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.ReadRunningConfigFromAsa(testModel.BaseUrl, deviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *asa.ReadRunningConfigOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asa.ReadRunningConfig(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				asa.NewReadRunningConfigInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
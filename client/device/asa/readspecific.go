@@ -21,7 +21,9 @@ type ReadSpecificOutput struct {
 }
 
 type SpecificDeviceMetadata struct {
-	AsdmVersion string `json:"deviceManager"`
+	AsdmVersion         string           `json:"deviceManager"`
+	Failover            FailoverMetadata `json:"failover"`
+	RegistrationCommand string           `json:"registrationCommand,omitempty"`
 }
 
 func NewReadSpecificInput(uid string) *ReadSpecificInput {
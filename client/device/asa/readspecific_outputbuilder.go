@@ -30,3 +30,9 @@ func (builder *ReadSpecificOutputBuilder) InDoneState() *ReadSpecificOutputBuild
 
 	return builder
 }
+
+func (builder *ReadSpecificOutputBuilder) WithFailover(failover FailoverMetadata) *ReadSpecificOutputBuilder {
+	builder.output.Metadata.Failover = failover
+
+	return builder
+}
@@ -38,3 +38,19 @@ func UntilStateDoneAndConnectivityOk(ctx context.Context, client http.Client, ui
 		return false, nil
 	}
 }
+
+// UntilFailoverPairHealthy waits for both units of the ASA failover pair to be ready again, e.g. for a unit that was
+// reloaded to rejoin the pair as a ready standby unit.
+func UntilFailoverPairHealthy(ctx context.Context, client http.Client, uid string) retry.Func {
+
+	return func() (bool, error) {
+		readSpecificOutp, err := ReadSpecific(ctx, client, *NewReadSpecificInput(uid))
+		if err != nil {
+			return false, err
+		}
+
+		client.Logger.Printf("failover health=%s\n", readSpecificOutp.Metadata.Failover.Health)
+
+		return readSpecificOutp.Metadata.Failover.Health == FailoverHealthHealthy, nil
+	}
+}
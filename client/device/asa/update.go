@@ -17,14 +17,20 @@ import (
 )
 
 type UpdateInput struct {
-	Uid             string    `json:"-"`
-	Name            string    `json:"name"`
-	Location        string    `json:"-"`
-	Username        string    `json:"-"`
-	Password        string    `json:"-"`
-	SoftwareVersion string    `json:"-"`
-	AsdmVersion     string    `json:"-"`
-	Tags            tags.Type `json:"tags"`
+	Uid             string `json:"-"`
+	Name            string `json:"name"`
+	Location        string `json:"-"`
+	Username        string `json:"-"`
+	Password        string `json:"-"`
+	SoftwareVersion string `json:"-"`
+	AsdmVersion     string `json:"-"`
+	HaAwareUpgrade  bool   `json:"-"`
+	ConnectorUid    string `json:"-"`
+	ConnectorType   string `json:"-"`
+	// PendingRegistration is set for an ASA created with CreateWithRegistrationKey that has not registered yet,
+	// it cannot reach connectivity state ONLINE until it registers, so the update does not wait for it.
+	PendingRegistration bool      `json:"-"`
+	Tags                tags.Type `json:"tags"`
 }
 
 type UpdateOutput = device.UpdateOutput
@@ -49,7 +55,11 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 			return nil, err
 		}
 
-		err = UpgradeAsa(ctx, client, updateInp.Uid, updateInp.SoftwareVersion, updateInp.AsdmVersion)
+		if updateInp.HaAwareUpgrade {
+			err = UpgradeAsaHaPair(ctx, client, updateInp.Uid, updateInp.SoftwareVersion, updateInp.AsdmVersion)
+		} else {
+			err = UpgradeAsa(ctx, client, updateInp.Uid, updateInp.SoftwareVersion, updateInp.AsdmVersion)
+		}
 		if err != nil {
 			client.Logger.Println("Failed to upgrade ASA")
 			return nil, err
@@ -58,6 +68,28 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 
 	client.Logger.Println("updating asa device (not upgrade")
 
+	if isConnectorUpdated(updateInp) {
+		if updateInp.Username == "" || updateInp.Password == "" {
+			return nil, fmt.Errorf("username and password are required to move the ASA device to another connector, as the credentials are encrypted with the public key of the connector")
+		}
+
+		connectorUid := updateInp.ConnectorUid
+		if strings.EqualFold(updateInp.ConnectorType, "CDG") {
+			cdg, err := connector.ReadCdg(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+			connectorUid = cdg.Uid
+		}
+
+		client.Logger.Printf("moving asa device to %s connector %s\n", updateInp.ConnectorType, connectorUid)
+		_, err := device.UpdateConnector(ctx, client, *device.NewUpdateConnectorInput(updateInp.Uid, connectorUid, updateInp.ConnectorType))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// the credentials are updated after moving the device to another connector, so that they are encrypted with its public key
 	if isSpecificDeviceIsRequired(updateInp) {
 
 		asaReadSpecOutp, err := device.ReadSpecific(ctx, client, *device.NewReadSpecificInput(
@@ -152,6 +184,11 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 		return nil, err
 	}
 
+	if updateInp.PendingRegistration {
+		client.Logger.Println("asa device is pending registration, not waiting for it to come online")
+		return &outp, nil
+	}
+
 	if err := retry.Do(
 		ctx,
 		UntilStateDoneAndConnectivityOk(ctx, client, outp.Uid),
@@ -169,6 +206,10 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 	return &outp, nil
 }
 
+func isConnectorUpdated(updateInput UpdateInput) bool {
+	return updateInput.ConnectorType != ""
+}
+
 func isSpecificDeviceIsRequired(updateInput UpdateInput) bool {
 	return updateInput.Username != "" || updateInput.Password != "" || updateInput.Location != ""
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	netHttp "net/http"
 	"testing"
 	"time"
 
@@ -84,6 +85,28 @@ func TestAsaUpdate(t *testing.T) {
 				assert.Equal(t, expectedUpdateOutput, *output)
 			},
 		},
+		{
+			testName: "successfully updates name of ASA pending registration without waiting for it to come online",
+			input: asa.UpdateInput{
+				Uid:                 asaDevice.Uid,
+				Name:                "new-name",
+				PendingRegistration: true,
+			},
+
+			setupFunc: func(input asa.UpdateInput) {
+				updatedDevice := asaDevice
+				updatedDevice.Name = input.Name
+				configureDeviceUpdateToRespondSuccessfully(input.Uid, updatedDevice)
+				configureDeviceReadToRespondSuccessfully(device.ReadOutput{Uid: input.Uid, State: "PENDING_REGISTRATION", ConnectivityState: -2})
+			},
+
+			assertFunc: func(input asa.UpdateInput, output *asa.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, input.Name, output.Name)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()["GET "+buildDevicePath(input.Uid)])
+			},
+		},
 		{
 			testName: "fail to upgrade an ASA device if version not compatible",
 			input: asa.UpdateInput{
@@ -273,6 +296,109 @@ func TestAsaUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			testName: "successfully moves ASA to an OnPrem Connector",
+			input: asa.UpdateInput{
+				Uid:           asaDevice.Uid,
+				ConnectorUid:  onPremConnector.Uid,
+				ConnectorType: "SDC",
+				Username:      "lockhart",
+				Password:      "not a valid password",
+			},
+
+			setupFunc: func(input asa.UpdateInput) {
+				movedDevice := asaDevice
+				movedDevice.ConnectorUid = onPremConnector.Uid
+				movedDevice.ConnectorType = "SDC"
+				movedDevice.State = state.DONE
+				movedDevice.Status = "IDLE"
+				movedDevice.ConnectivityState = 1
+
+				configureDeviceUpdateToRespondSuccessfully(input.Uid, movedDevice)
+				configureDeviceReadSpecificToRespondSuccessfully(input.Uid, asaConfig)
+				configureDeviceReadToRespondSuccessfully(movedDevice)
+				configureConnectorReadToRespondSuccessfully(onPremConnector)
+				configureAsaConfigUpdateToRespondSuccessfully(asaConfig.SpecificUid, asaconfig.UpdateOutput{Uid: asaConfig.SpecificUid})
+				configureAsaConfigReadToRespondSuccessfully(asaConfig.SpecificUid, asaconfig.ReadOutput{Uid: asaConfig.SpecificUid, State: state.DONE})
+			},
+
+			assertFunc: func(input asa.UpdateInput, output *asa.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, onPremConnector.Uid, output.ConnectorUid)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(input.Uid), 2, t)
+				assertConnectorReadByUidWasCalledOnce(onPremConnector.Uid, t)
+				assertAsaConfigUpdateWasCalledOnce(asaConfig.SpecificUid, t)
+			},
+		},
+
+		{
+			testName: "successfully moves ASA to the Cloud Connector",
+			input: asa.UpdateInput{
+				Uid:           asaDeviceOnboardedByOnPremConnector.Uid,
+				ConnectorType: "CDG",
+				Username:      "lockhart",
+				Password:      "not a valid password",
+			},
+
+			setupFunc: func(input asa.UpdateInput) {
+				cloudConnector := connector.NewConnectorOutputBuilder().
+					AsDefaultCloudConnector().
+					WithUid("88888888-8888-8888-8888-888888888888").
+					WithName("CDG").
+					WithTenantUid("66666666-6666-6666-6666-6666666666666").
+					Build()
+
+				movedDevice := asaDeviceOnboardedByOnPremConnector
+				movedDevice.ConnectorUid = cloudConnector.Uid
+				movedDevice.ConnectorType = "CDG"
+				movedDevice.State = state.DONE
+				movedDevice.Status = "IDLE"
+				movedDevice.ConnectivityState = 1
+
+				httpmock.RegisterResponder(
+					netHttp.MethodGet,
+					"/aegis/rest/v1/services/targets/proxies",
+					httpmock.NewJsonResponderOrPanic(200, connector.ReadAllOutput{onPremConnector, cloudConnector}),
+				)
+				configureDeviceUpdateToRespondSuccessfully(input.Uid, movedDevice)
+				configureDeviceReadSpecificToRespondSuccessfully(input.Uid, asaConfig)
+				configureDeviceReadToRespondSuccessfully(movedDevice)
+				configureAsaConfigUpdateToRespondSuccessfully(asaConfig.SpecificUid, asaconfig.UpdateOutput{Uid: asaConfig.SpecificUid})
+				configureAsaConfigReadToRespondSuccessfully(asaConfig.SpecificUid, asaconfig.ReadOutput{Uid: asaConfig.SpecificUid, State: state.DONE})
+			},
+
+			assertFunc: func(input asa.UpdateInput, output *asa.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, "CDG", output.ConnectorType)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(input.Uid), 2, t)
+				assertAsaConfigUpdateWasCalledOnce(asaConfig.SpecificUid, t)
+			},
+		},
+
+		{
+			testName: "returns error when moving ASA to another connector without credentials",
+			input: asa.UpdateInput{
+				Uid:           asaDevice.Uid,
+				ConnectorUid:  onPremConnector.Uid,
+				ConnectorType: "SDC",
+			},
+
+			setupFunc: func(input asa.UpdateInput) {
+				configureDeviceUpdateToRespondSuccessfully(input.Uid, asaDevice)
+			},
+
+			assertFunc: func(input asa.UpdateInput, output *asa.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(input.Uid), 0, t)
+			},
+		},
+
 		{
 			testName: "returns error when device read specific call encounters an issue",
 			input: asa.UpdateInput{
@@ -43,10 +43,73 @@ func ValidateVersionCompatibility(ctx context.Context, client http.Client, devic
 }
 
 func UpgradeAsa(ctx context.Context, client http.Client, deviceUid string, softwareVersion string, asdmVersion string) error {
+	if err := upgradeUnit(ctx, client, deviceUid, softwareVersion, asdmVersion, ""); err != nil {
+		return err
+	}
+
+	client.Logger.Println("ASA upgrade successful!")
+	return nil
+}
+
+// UpgradeAsaHaPair upgrades both units of an active/standby failover pair without taking the pair out of service:
+// the standby unit is upgraded first, the pair fails over to it, the other unit (now standby) is upgraded, and the
+// pair finally fails back so that the originally active unit is active again.
+func UpgradeAsaHaPair(ctx context.Context, client http.Client, deviceUid string, softwareVersion string, asdmVersion string) error {
+	readSpecificOutp, err := ReadSpecific(ctx, client, *NewReadSpecificInput(deviceUid))
+	if err != nil {
+		return err
+	}
+	failover := readSpecificOutp.Metadata.Failover
+	if failover.Mode != FailoverModeActiveStandby {
+		return fmt.Errorf("HA-aware upgrade requires an active/standby failover pair, but the failover mode of ASA device %s is %s", deviceUid, failoverModeOrStandalone(failover.Mode))
+	}
+	if failover.Health != FailoverHealthHealthy {
+		return fmt.Errorf("HA-aware upgrade requires a healthy failover pair, but the failover health of ASA device %s is %s", deviceUid, failover.Health)
+	}
+
+	client.Logger.Println("upgrading standby unit of ASA failover pair")
+	if err := upgradeUnit(ctx, client, deviceUid, softwareVersion, asdmVersion, FailoverRoleStandby); err != nil {
+		return err
+	}
+
+	if err := waitForFailoverPairHealthy(ctx, client, deviceUid); err != nil {
+		return err
+	}
+
+	client.Logger.Println("failing over to upgraded unit of ASA failover pair")
+	if _, err := Failover(ctx, client, NewFailoverInput(deviceUid)); err != nil {
+		return err
+	}
+
+	client.Logger.Println("upgrading new standby unit of ASA failover pair")
+	if err := upgradeUnit(ctx, client, deviceUid, softwareVersion, asdmVersion, FailoverRoleStandby); err != nil {
+		return err
+	}
+
+	if err := waitForFailoverPairHealthy(ctx, client, deviceUid); err != nil {
+		return err
+	}
+
+	client.Logger.Println("failing back to original active unit of ASA failover pair")
+	if _, err := Failover(ctx, client, NewFailoverInput(deviceUid)); err != nil {
+		return err
+	}
+
+	// the upgrade is only done once the pair is back in service with a ready standby unit
+	if err := waitForFailoverPairHealthy(ctx, client, deviceUid); err != nil {
+		return err
+	}
+
+	client.Logger.Println("ASA failover pair upgrade successful!")
+	return nil
+}
+
+func upgradeUnit(ctx context.Context, client http.Client, deviceUid string, softwareVersion string, asdmVersion string, unit FailoverRole) error {
 	upgradeUrl := url.GetUpgradeAsaUrl(client.BaseUrl(), deviceUid)
 	transaction, err := publicapi.TriggerTransaction(ctx, client, upgradeUrl, upgradeAsaInput{
 		SoftwareVersion: softwareVersion,
 		AsdmVersion:     asdmVersion,
+		Unit:            unit,
 	})
 	if err != nil {
 		return err
@@ -61,17 +124,33 @@ func UpgradeAsa(ctx context.Context, client http.Client, deviceUid string, softw
 		Message(fmt.Sprintf("Upgrading ASA device to %s (ASDM version: %s)", softwareVersion, asdmVersion)).
 		Delay(30*time.Second).
 		Build())
-	if err != nil {
-		return err
-	}
+	return err
+}
 
-	client.Logger.Println("ASA upgrade successful!")
-	return nil
+// waitForFailoverPairHealthy waits for the standby unit to be ready after it reloads or the pair fails over, failing over before that would take the pair out of service.
+func waitForFailoverPairHealthy(ctx context.Context, client http.Client, deviceUid string) error {
+	// poll every 30 seconds for up to 30 minutes
+	return retry.Do(ctx, UntilFailoverPairHealthy(ctx, client, deviceUid), retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(30*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(fmt.Sprintf("Waiting for the standby unit of ASA device %s to be ready in the failover pair", deviceUid)).
+		Delay(30*time.Second).
+		Build())
+}
+
+func failoverModeOrStandalone(mode FailoverMode) FailoverMode {
+	if mode == "" {
+		return FailoverModeStandalone
+	}
+	return mode
 }
 
 type upgradeAsaInput struct {
-	SoftwareVersion string `json:"softwareVersion,omitempty"`
-	AsdmVersion     string `json:"asdmVersion,omitempty"`
+	SoftwareVersion string       `json:"softwareVersion,omitempty"`
+	AsdmVersion     string       `json:"asdmVersion,omitempty"`
+	Unit            FailoverRole `json:"unit,omitempty"`
 }
 
 func buildCompatibleVersionsAsString(compatibleVersions []CompatibleVersion) string {
@@ -9,10 +9,12 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactionstatus"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
 	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
 	"github.com/google/uuid"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
+	netHttp "net/http"
 	"testing"
 	"time"
 )
@@ -337,3 +339,177 @@ func TestUpgradeAsa(t *testing.T) {
 		})
 	}
 }
+
+func TestUpgradeAsaHaPair(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.AsaUid.String()
+	softwareVersion := "9.18(2)"
+	asdmVersion := "7.16(3.100)"
+
+	buildSpecificDevice := func(failover asa.FailoverMetadata) asa.ReadSpecificOutput {
+		return asa.NewReadSpecificOutputBuilder().
+			WithSpecificUid(uuid.New().String()).
+			InDoneState().
+			WithFailover(failover).
+			Build()
+	}
+	healthyPair := buildSpecificDevice(asa.FailoverMetadata{
+		Mode:     asa.FailoverModeActiveStandby,
+		Role:     asa.FailoverRoleActive,
+		PeerUnit: "unit-test-asa-secondary",
+		Health:   asa.FailoverHealthHealthy,
+	})
+	upgradeUrl := url.GetUpgradeAsaUrl(testModel.BaseUrl, deviceUid)
+	failoverUrl := url.FailoverAsa(testModel.BaseUrl, deviceUid)
+	readSpecificUrl := url.ReadSpecificDevice(testModel.BaseUrl, deviceUid)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(err error, t *testing.T)
+	}{
+		{
+			testName: "upgrades the standby unit, fails over, upgrades the other unit and fails back",
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(readSpecificUrl, healthyPair)
+				httpmock.RegisterResponder(
+					netHttp.MethodPost,
+					upgradeUrl,
+					func(r *netHttp.Request) (*netHttp.Response, error) {
+						body, err := http.ReadRequestBody[map[string]string](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, string(asa.FailoverRoleStandby), (*body)["unit"])
+						return httpmock.NewJsonResponse(netHttp.StatusAccepted, testModel.CreateDoneTransaction(deviceUid, transactiontype.UPGRADE_ASA))
+					},
+				)
+				internalTesting.MockPostAccepted(failoverUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.FAILOVER_ASA))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.Nil(t, err)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, upgradeUrl, 2, t)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, failoverUrl, 2, t)
+				// the pair is checked before the upgrade, after each unit is upgraded, after each failover, and once it has failed back
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodGet, readSpecificUrl, 6, t)
+			},
+		},
+		{
+			testName: "should not fail over if the upgraded unit cannot be checked to have rejoined the pair",
+			setupFunc: func(t *testing.T) {
+				count := 0
+				httpmock.RegisterResponder(
+					netHttp.MethodGet,
+					readSpecificUrl,
+					func(r *netHttp.Request) (*netHttp.Response, error) {
+						count += 1
+						if count > 1 {
+							return httpmock.NewJsonResponse(netHttp.StatusInternalServerError, "intentional error")
+						}
+						return httpmock.NewJsonResponse(netHttp.StatusOK, healthyPair)
+					},
+				)
+				internalTesting.MockPostAccepted(upgradeUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.UPGRADE_ASA))
+				internalTesting.MockPostAccepted(failoverUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.FAILOVER_ASA))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.NotNil(t, err)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, upgradeUrl, 1, t)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+failoverUrl])
+			},
+		},
+		{
+			testName: "should fail if the pair cannot be checked to be healthy after failing back",
+			setupFunc: func(t *testing.T) {
+				count := 0
+				httpmock.RegisterResponder(
+					netHttp.MethodGet,
+					readSpecificUrl,
+					func(r *netHttp.Request) (*netHttp.Response, error) {
+						count += 1
+						if count > 5 {
+							return httpmock.NewJsonResponse(netHttp.StatusInternalServerError, "intentional error")
+						}
+						return httpmock.NewJsonResponse(netHttp.StatusOK, healthyPair)
+					},
+				)
+				internalTesting.MockPostAccepted(upgradeUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.UPGRADE_ASA))
+				internalTesting.MockPostAccepted(failoverUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.FAILOVER_ASA))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.NotNil(t, err)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, upgradeUrl, 2, t)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, failoverUrl, 2, t)
+			},
+		},
+		{
+			testName: "should fail if the ASA is not part of a failover pair",
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(readSpecificUrl, buildSpecificDevice(asa.FailoverMetadata{}))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, string(asa.FailoverModeStandalone))
+			},
+		},
+		{
+			testName: "should fail if the failover pair is not healthy",
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(readSpecificUrl, buildSpecificDevice(asa.FailoverMetadata{
+					Mode:     asa.FailoverModeActiveStandby,
+					Role:     asa.FailoverRoleActive,
+					PeerUnit: "unit-test-asa-secondary",
+					Health:   asa.FailoverHealthDegraded,
+				}))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, string(asa.FailoverHealthDegraded))
+			},
+		},
+		{
+			testName: "should not fail back if upgrading the second unit fails",
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(readSpecificUrl, healthyPair)
+				count := 0
+				httpmock.RegisterResponder(
+					netHttp.MethodPost,
+					upgradeUrl,
+					func(r *netHttp.Request) (*netHttp.Response, error) {
+						count += 1
+						if count > 1 {
+							return httpmock.NewJsonResponse(netHttp.StatusAccepted, testModel.CreateErrorTransaction(deviceUid, transactiontype.UPGRADE_ASA))
+						}
+						return httpmock.NewJsonResponse(netHttp.StatusAccepted, testModel.CreateDoneTransaction(deviceUid, transactiontype.UPGRADE_ASA))
+					},
+				)
+				internalTesting.MockPostAccepted(failoverUrl, testModel.CreateDoneTransaction(deviceUid, transactiontype.FAILOVER_ASA))
+			},
+			assertFunc: func(err error, t *testing.T) {
+				assert.NotNil(t, err)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, failoverUrl, 1, t)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			err := asa.UpgradeAsaHaPair(
+				context.Background(),
+				*http.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				deviceUid,
+				softwareVersion,
+				asdmVersion,
+			)
+
+			testCase.assertFunc(err, t)
+		})
+	}
+}
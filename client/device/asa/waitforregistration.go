@@ -0,0 +1,47 @@
+package asa
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+)
+
+type WaitForRegistrationInput struct {
+	Uid string
+}
+
+type WaitForRegistrationOutput = ReadOutput
+
+func NewWaitForRegistrationInput(uid string) WaitForRegistrationInput {
+	return WaitForRegistrationInput{
+		Uid: uid,
+	}
+}
+
+// WaitForRegistration polls an ASA created with CreateWithRegistrationKey until the registration command
+// has been run on the device and CDO has finished onboarding it.
+func WaitForRegistration(ctx context.Context, client http.Client, waitInp WaitForRegistrationInput) (*WaitForRegistrationOutput, error) {
+
+	client.Logger.Println("waiting for asa device to register")
+
+	// poll every 10 seconds for up to 30 minutes, the registration command is run on the device outside of CDO
+	err := retry.Do(
+		ctx,
+		UntilStateDoneAndConnectivityOk(ctx, client, waitInp.Uid),
+		retry.NewOptionsBuilder().
+			Logger(client.Logger).
+			Timeout(30*time.Minute).
+			Retries(-1).
+			EarlyExitOnError(true).
+			Message("Waiting for ASA to register with CDO...").
+			Delay(10*time.Second).
+			Build(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, *NewReadInput(waitInp.Uid))
+}
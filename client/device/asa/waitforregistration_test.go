@@ -0,0 +1,93 @@
+package asa_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/jarcoal/httpmock"
+)
+
+func TestAsaWaitForRegistration(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	registeredOutput := testModel.AsaReadOutput()
+	registeredOutput.Status = "IDLE"
+	registeredOutput.ConnectivityState = 1
+	errorOutput := testModel.AsaReadOutput()
+	errorOutput.State = state.ERROR
+
+	testCases := []struct {
+		testName   string
+		input      asa.WaitForRegistrationInput
+		setupFunc  func()
+		assertFunc func(output *asa.WaitForRegistrationOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "returns ASA once it has registered",
+			input:    asa.NewWaitForRegistrationInput(registeredOutput.Uid),
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, registeredOutput.Uid), registeredOutput)
+			},
+
+			assertFunc: func(output *asa.WaitForRegistrationOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, registeredOutput, *output)
+			},
+		},
+		{
+			testName: "fails if ASA goes into error state",
+			input:    asa.NewWaitForRegistrationInput(errorOutput.Uid),
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, errorOutput.Uid), errorOutput)
+			},
+
+			assertFunc: func(output *asa.WaitForRegistrationOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			testName: "fails if ASA cannot be read",
+			input:    asa.NewWaitForRegistrationInput(registeredOutput.Uid),
+
+			setupFunc: func() {
+				internalTesting.MockGetError(url.ReadDevice(testModel.BaseUrl, registeredOutput.Uid), "intentional error")
+			},
+
+			assertFunc: func(output *asa.WaitForRegistrationOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := asa.WaitForRegistration(
+				context.Background(),
+				*http.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
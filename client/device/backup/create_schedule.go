@@ -0,0 +1,35 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type CreateScheduleInput struct {
+	Schedule backup.Schedule
+}
+
+type CreateScheduleOutput = backup.Schedule
+
+func NewCreateScheduleInput(schedule backup.Schedule) CreateScheduleInput {
+	return CreateScheduleInput{
+		Schedule: schedule,
+	}
+}
+
+func CreateSchedule(ctx context.Context, client http.Client, createInp CreateScheduleInput) (*CreateScheduleOutput, error) {
+
+	client.Logger.Println("creating device backup schedule")
+
+	req := client.NewPost(ctx, url.DeviceBackupSchedule(client.BaseUrl(), createInp.Schedule.DeviceUid), createInp.Schedule)
+
+	var outp CreateScheduleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
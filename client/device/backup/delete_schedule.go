@@ -0,0 +1,36 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteScheduleInput struct {
+	DeviceUid string
+}
+
+type DeleteScheduleOutput struct {
+}
+
+func NewDeleteScheduleInput(deviceUid string) DeleteScheduleInput {
+	return DeleteScheduleInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// DeleteSchedule stops the scheduled backups of the device, the backups that were already made are retained.
+func DeleteSchedule(ctx context.Context, client http.Client, deleteInp DeleteScheduleInput) (*DeleteScheduleOutput, error) {
+
+	client.Logger.Println("deleting device backup schedule")
+
+	req := client.NewDelete(ctx, url.DeviceBackupSchedule(client.BaseUrl(), deleteInp.DeviceUid))
+
+	var outp DeleteScheduleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type DownloadInput struct {
+	DeviceUid string
+	BackupUid string
+}
+
+type DownloadOutput = backup.Content
+
+func NewDownloadInput(deviceUid string, backupUid string) DownloadInput {
+	return DownloadInput{
+		DeviceUid: deviceUid,
+		BackupUid: backupUid,
+	}
+}
+
+func Download(ctx context.Context, client http.Client, downloadInp DownloadInput) (*DownloadOutput, error) {
+
+	client.Logger.Println("downloading device backup")
+
+	req := client.NewGet(ctx, url.DownloadDeviceBackup(client.BaseUrl(), downloadInp.DeviceUid, downloadInp.BackupUid))
+
+	var outp DownloadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,78 @@
+package backup_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelBackup "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownload(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	validContent := modelBackup.Content{
+		Uid:    backupUid,
+		Config: "hostname unit-test\nssh version 2\n",
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *backup.DownloadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully downloads the backup",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.DownloadDeviceBackup(baseUrl, deviceUid, backupUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validContent),
+				)
+			},
+			assertFunc: func(output *backup.DownloadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validContent, *output)
+			},
+		},
+		{
+			testName: "returns not found error when backup does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.DownloadDeviceBackup(baseUrl, deviceUid, backupUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *backup.DownloadOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := backup.Download(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewDownloadInput(deviceUid, backupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package backup_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	deviceUid = "unit-test-device-uid"
+	backupUid = "unit-test-backup-uid"
+)
+
+var (
+	validBackup = backup.Backup{
+		Uid:         backupUid,
+		DeviceUid:   deviceUid,
+		Version:     3,
+		CreatedDate: 1700000000000,
+	}
+
+	validSchedule = backup.Schedule{
+		DeviceUid:      deviceUid,
+		Frequency:      backup.Weekly,
+		Time:           "02:30",
+		DayOfWeek:      "SUNDAY",
+		RetentionCount: 10,
+	}
+)
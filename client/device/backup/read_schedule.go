@@ -0,0 +1,35 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type ReadScheduleInput struct {
+	DeviceUid string
+}
+
+type ReadScheduleOutput = backup.Schedule
+
+func NewReadScheduleInput(deviceUid string) ReadScheduleInput {
+	return ReadScheduleInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+func ReadSchedule(ctx context.Context, client http.Client, readInp ReadScheduleInput) (*ReadScheduleOutput, error) {
+
+	client.Logger.Println("reading device backup schedule")
+
+	req := client.NewGet(ctx, url.DeviceBackupSchedule(client.BaseUrl(), readInp.DeviceUid))
+
+	var outp ReadScheduleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,72 @@
+package backup_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadSchedule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *backup.ReadScheduleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the backup schedule of the device",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.DeviceBackupSchedule(baseUrl, deviceUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validSchedule),
+				)
+			},
+			assertFunc: func(output *backup.ReadScheduleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validSchedule, *output)
+			},
+		},
+		{
+			testName: "returns not found error when device has no backup schedule",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.DeviceBackupSchedule(baseUrl, deviceUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *backup.ReadScheduleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := backup.ReadSchedule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewReadScheduleInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
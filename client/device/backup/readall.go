@@ -0,0 +1,37 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type ReadAllInput struct {
+	DeviceUid string
+}
+
+type ReadAllOutput = model.CdoListResponse[backup.Backup]
+
+func NewReadAllInput(deviceUid string) ReadAllInput {
+	return ReadAllInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// ReadAll reads the backups of the device that CDO retains.
+func ReadAll(ctx context.Context, client http.Client, readInp ReadAllInput) (*ReadAllOutput, error) {
+
+	client.Logger.Println("reading all device backups")
+
+	req := client.NewGet(ctx, url.ReadAllDeviceBackups(client.BaseUrl(), readInp.DeviceUid))
+
+	var outp ReadAllOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
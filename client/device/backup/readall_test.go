@@ -0,0 +1,82 @@
+package backup_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	modelBackup "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	validBackups := model.CdoListResponse[modelBackup.Backup]{
+		Count: 2,
+		Items: []modelBackup.Backup{
+			validBackup,
+			{Uid: "unit-test-older-backup-uid", DeviceUid: deviceUid, Version: 2, CreatedDate: 1690000000000},
+		},
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *backup.ReadAllOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the backups of the device",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllDeviceBackups(baseUrl, deviceUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validBackups),
+				)
+			},
+			assertFunc: func(output *backup.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validBackups, *output)
+			},
+		},
+		{
+			testName: "returns not found error when device does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllDeviceBackups(baseUrl, deviceUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *backup.ReadAllOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := backup.ReadAll(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewReadAllInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
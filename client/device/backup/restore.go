@@ -0,0 +1,51 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type RestoreInput struct {
+	DeviceUid string
+	BackupUid string
+}
+
+type RestoreOutput struct {
+}
+
+func NewRestoreInput(deviceUid string, backupUid string) RestoreInput {
+	return RestoreInput{
+		DeviceUid: deviceUid,
+		BackupUid: backupUid,
+	}
+}
+
+// Restore writes the configuration in the backup to the device, replacing its configuration, and waits for the restore to finish.
+func Restore(ctx context.Context, client http.Client, restoreInp RestoreInput) (*RestoreOutput, error) {
+
+	client.Logger.Println("restoring device backup")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.RestoreDeviceBackup(client.BaseUrl(), restoreInp.DeviceUid, restoreInp.BackupUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for the backup to be restored to the device...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return &RestoreOutput{}, nil
+}
@@ -0,0 +1,85 @@
+package backup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestore(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	doneTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.RESTORE_DEVICE_BACKUP)
+	errorTransaction := testModel.CreateErrorTransaction(deviceUid, transactiontype.RESTORE_DEVICE_BACKUP)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *backup.RestoreOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully restores the backup to the device",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.RestoreDeviceBackup(testModel.BaseUrl, deviceUid, backupUid), doneTransaction)
+			},
+
+			assertFunc: func(output *backup.RestoreOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.RestoreDeviceBackup(testModel.BaseUrl, deviceUid, backupUid), errorTransaction)
+			},
+
+			assertFunc: func(output *backup.RestoreOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.RestoreDeviceBackup(testModel.BaseUrl, deviceUid, backupUid), "internal server error")
+			},
+
+			assertFunc: func(output *backup.RestoreOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := backup.Restore(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewRestoreInput(deviceUid, backupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type TriggerInput struct {
+	DeviceUid string
+}
+
+type TriggerOutput = backup.Backup
+
+func NewTriggerInput(deviceUid string) TriggerInput {
+	return TriggerInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// Trigger backs up the configuration of the device, waits for the backup to finish, and returns the new backup.
+func Trigger(ctx context.Context, client http.Client, triggerInp TriggerInput) (*TriggerOutput, error) {
+
+	client.Logger.Println("backing up device config")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.TriggerDeviceBackup(client.BaseUrl(), triggerInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for the configuration of the device to be backed up...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	// the entity of the transaction is the new backup
+	req := client.NewGet(ctx, url.ReadDeviceBackup(client.BaseUrl(), triggerInp.DeviceUid, transaction.EntityUid))
+
+	var outp TriggerOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,87 @@
+package backup_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrigger(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	doneTransaction := testModel.CreateDoneTransaction(backupUid, transactiontype.BACKUP_DEVICE)
+	errorTransaction := testModel.CreateErrorTransaction(backupUid, transactiontype.BACKUP_DEVICE)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *backup.TriggerOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully backs up the device config",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.TriggerDeviceBackup(testModel.BaseUrl, deviceUid), doneTransaction)
+				internalTesting.MockGetOk(url.ReadDeviceBackup(testModel.BaseUrl, deviceUid, backupUid), validBackup)
+			},
+
+			assertFunc: func(output *backup.TriggerOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validBackup, *output)
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.TriggerDeviceBackup(testModel.BaseUrl, deviceUid), errorTransaction)
+			},
+
+			assertFunc: func(output *backup.TriggerOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.TriggerDeviceBackup(testModel.BaseUrl, deviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *backup.TriggerOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := backup.Trigger(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewTriggerInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package backup
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+)
+
+type UpdateScheduleInput struct {
+	Schedule backup.Schedule
+}
+
+type UpdateScheduleOutput = backup.Schedule
+
+func NewUpdateScheduleInput(schedule backup.Schedule) UpdateScheduleInput {
+	return UpdateScheduleInput{
+		Schedule: schedule,
+	}
+}
+
+func UpdateSchedule(ctx context.Context, client http.Client, updateInp UpdateScheduleInput) (*UpdateScheduleOutput, error) {
+
+	client.Logger.Println("updating device backup schedule")
+
+	req := client.NewPut(ctx, url.DeviceBackupSchedule(client.BaseUrl(), updateInp.Schedule.DeviceUid), updateInp.Schedule)
+
+	var outp UpdateScheduleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,87 @@
+package backup_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelBackup "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSchedule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	monthlySchedule := modelBackup.Schedule{
+		DeviceUid:      deviceUid,
+		Frequency:      modelBackup.Monthly,
+		Time:           "23:00",
+		DayOfMonth:     1,
+		RetentionCount: 12,
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *backup.UpdateScheduleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates backup schedule to monthly",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.DeviceBackupSchedule(baseUrl, deviceUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[modelBackup.Schedule](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, monthlySchedule, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, monthlySchedule)
+					},
+				)
+			},
+			assertFunc: func(output *backup.UpdateScheduleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, monthlySchedule, *output)
+			},
+		},
+		{
+			testName: "returns error when update fails",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.DeviceBackupSchedule(baseUrl, deviceUid),
+					httpmock.NewStringResponder(http.StatusBadRequest, "invalid time"),
+				)
+			},
+			assertFunc: func(output *backup.UpdateScheduleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := backup.UpdateSchedule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				backup.NewUpdateScheduleInput(monthlySchedule),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package changes
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type AcceptConflictInput struct {
+	DeviceUid string
+}
+
+type AcceptConflictOutput = ReadOutput
+
+func NewAcceptConflictInput(deviceUid string) AcceptConflictInput {
+	return AcceptConflictInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// AcceptConflict accepts the out-of-band change to the configuration of the device, replacing CDO's copy of the
+// configuration with the configuration on the device. It waits for the conflict to be resolved.
+func AcceptConflict(ctx context.Context, client http.Client, acceptInp AcceptConflictInput) (*AcceptConflictOutput, error) {
+
+	client.Logger.Println("accepting device conflict")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.AcceptDeviceConflict(client.BaseUrl(), acceptInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for the out-of-band change to be accepted...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, NewReadInput(acceptInp.DeviceUid))
+}
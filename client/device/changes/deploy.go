@@ -0,0 +1,68 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+// deployUrls are the endpoints that deploy the pending changes of each type of device that supports it.
+var deployUrls = map[devicetype.Type]func(baseUrl string, deviceUid string) string{
+	devicetype.Asa: url.DeployAsaChanges,
+}
+
+type DeployInput struct {
+	DeviceUid  string
+	DeviceType devicetype.Type
+}
+
+type DeployOutput = ReadOutput
+
+func NewDeployInput(deviceUid string, deviceType devicetype.Type) DeployInput {
+	return DeployInput{
+		DeviceUid:  deviceUid,
+		DeviceType: deviceType,
+	}
+}
+
+// IsDeploySupported returns whether changes can be deployed to devices of the given type, which are currently only ASA devices.
+func IsDeploySupported(deviceType devicetype.Type) bool {
+	_, ok := deployUrls[deviceType]
+	return ok
+}
+
+// Deploy deploys the pending changes on CDO to the device, and waits for the deployment to finish.
+func Deploy(ctx context.Context, client http.Client, deployInp DeployInput) (*DeployOutput, error) {
+
+	client.Logger.Println("deploying changes to device")
+
+	deployUrl, ok := deployUrls[deployInp.DeviceType]
+	if !ok {
+		return nil, fmt.Errorf("deploying changes is not supported for devices of type %s", deployInp.DeviceType)
+	}
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, deployUrl(client.BaseUrl(), deployInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for changes to be deployed to the device...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, NewReadInput(deployInp.DeviceUid))
+}
@@ -0,0 +1,52 @@
+package changes
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/configstate"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+type ReadInput struct {
+	DeviceUid string
+}
+
+type ReadOutput struct {
+	Uid               string           `json:"uid"`
+	Name              string           `json:"name"`
+	DeviceType        devicetype.Type  `json:"deviceType"`
+	ConfigState       configstate.Type `json:"configState"`
+	ConnectivityState string           `json:"connectivityState"`
+}
+
+func NewReadInput(deviceUid string) ReadInput {
+	return ReadInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// HasPendingChanges returns whether the device has changes made on CDO that have not been deployed to it yet.
+func (o ReadOutput) HasPendingChanges() bool {
+	return o.ConfigState == configstate.NotSynced
+}
+
+// HasConflict returns whether the configuration of the device was changed outside CDO, and conflicts with CDO's copy.
+func (o ReadOutput) HasConflict() bool {
+	return o.ConfigState == configstate.ConflictDetected
+}
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading device config state")
+
+	req := client.NewGet(ctx, url.ReadInventoryDevice(client.BaseUrl(), readInp.DeviceUid))
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,81 @@
+package changes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/configstate"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	readOutput := changes.ReadOutput{
+		Uid:               testModel.AsaUid.String(),
+		Name:              testModel.AsaName,
+		DeviceType:        devicetype.Asa,
+		ConfigState:       configstate.NotSynced,
+		ConnectivityState: "ONLINE",
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *changes.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the config state of a device",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(output *changes.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, readOutput, *output)
+				assert.True(t, output.HasPendingChanges())
+				assert.False(t, output.HasConflict())
+			},
+		},
+		{
+			testName: "returns error when reading the device fails",
+
+			setupFunc: func() {
+				internalTesting.MockGetError(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), "internal server error")
+			},
+
+			assertFunc: func(output *changes.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := changes.Read(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				changes.NewReadInput(readOutput.Uid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
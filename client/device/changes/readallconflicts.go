@@ -0,0 +1,56 @@
+package changes
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+)
+
+type ReadAllConflictsInput struct {
+	Label string
+}
+
+type ReadAllConflictsOutput = model.CdoListResponse[ReadOutput]
+
+// NewReadAllConflictsInput reads the devices with a conflict that have the label, or all devices with a conflict if the label is empty.
+func NewReadAllConflictsInput(label string) ReadAllConflictsInput {
+	return ReadAllConflictsInput{
+		Label: label,
+	}
+}
+
+// ReadAllConflicts reads the devices whose configuration was changed outside CDO, and conflicts with CDO's copy.
+func ReadAllConflicts(ctx context.Context, client http.Client, readInp ReadAllConflictsInput) (*ReadAllConflictsOutput, error) {
+
+	client.Logger.Println("reading all devices with conflicts")
+
+	limit := 200
+	offset := 0
+	count := 1
+	outp := ReadAllConflictsOutput{
+		Items: []ReadOutput{},
+	}
+
+	for count > offset {
+		client.Logger.Printf("Getting devices with conflicts from %d to %d\n", offset, offset+limit)
+		readUrl := url.ReadAllConflictedDevices(client.BaseUrl(), limit, offset)
+		if readInp.Label != "" {
+			readUrl = url.ReadAllConflictedDevicesWithLabel(client.BaseUrl(), readInp.Label, limit, offset)
+		}
+		req := client.NewGet(ctx, readUrl)
+
+		var devicePage ReadAllConflictsOutput
+		if err := req.Send(&devicePage); err != nil {
+			return nil, err
+		}
+		outp.Items = append(outp.Items, devicePage.Items...)
+
+		offset += limit
+		count = devicePage.Count
+	}
+	outp.Count = count
+
+	return &outp, nil
+}
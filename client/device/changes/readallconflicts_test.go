@@ -0,0 +1,157 @@
+package changes_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/configstate"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAllConflicts(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	readAllUrl := testModel.BaseUrl + "/api/rest/v1/inventory/devices"
+
+	conflictedDevices := model.CdoListResponse[changes.ReadOutput]{
+		Items: []changes.ReadOutput{
+			{
+				Uid:               testModel.AsaUid.String(),
+				Name:              testModel.AsaName,
+				DeviceType:        devicetype.Asa,
+				ConfigState:       configstate.ConflictDetected,
+				ConnectivityState: "ONLINE",
+			},
+		},
+		Count: 1,
+	}
+
+	firstPage := model.CdoListResponse[changes.ReadOutput]{
+		Items: make([]changes.ReadOutput, 200),
+		Count: 201,
+	}
+	for i := range firstPage.Items {
+		firstPage.Items[i] = changes.ReadOutput{
+			Uid:               fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+			Name:              fmt.Sprintf("unit-test-device-%d", i),
+			DeviceType:        devicetype.Asa,
+			ConfigState:       configstate.ConflictDetected,
+			ConnectivityState: "ONLINE",
+		}
+	}
+	secondPage := model.CdoListResponse[changes.ReadOutput]{
+		Items: conflictedDevices.Items,
+		Count: 201,
+	}
+
+	testCases := []struct {
+		testName   string
+		label      string
+		setupFunc  func()
+		assertFunc func(output *changes.ReadAllConflictsOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads all devices with conflicts",
+			label:    "",
+
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, "q=configState:CONFLICT_DETECTED&limit=200&offset=0", httpmock.NewJsonResponderOrPanic(http.StatusOK, conflictedDevices))
+			},
+
+			assertFunc: func(output *changes.ReadAllConflictsOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, conflictedDevices, *output)
+			},
+		},
+		{
+			testName: "successfully reads the devices with conflicts that have the label",
+			label:    "unit-test-label",
+
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, "q=configState:CONFLICT_DETECTED+AND+labels:unit-test-label&limit=200&offset=0", httpmock.NewJsonResponderOrPanic(http.StatusOK, conflictedDevices))
+			},
+
+			assertFunc: func(output *changes.ReadAllConflictsOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, conflictedDevices, *output)
+			},
+		},
+		{
+			testName: "escapes the label in the query",
+			label:    "unit test&label",
+
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, map[string]string{
+					"q":      "configState:CONFLICT_DETECTED AND labels:unit test&label",
+					"limit":  "200",
+					"offset": "0",
+				}, httpmock.NewJsonResponderOrPanic(http.StatusOK, conflictedDevices))
+			},
+
+			assertFunc: func(output *changes.ReadAllConflictsOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, conflictedDevices, *output)
+			},
+		},
+		{
+			testName: "successfully reads the devices with conflicts page by page",
+			label:    "",
+
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, "q=configState:CONFLICT_DETECTED&limit=200&offset=0", httpmock.NewJsonResponderOrPanic(http.StatusOK, firstPage))
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, "q=configState:CONFLICT_DETECTED&limit=200&offset=200", httpmock.NewJsonResponderOrPanic(http.StatusOK, secondPage))
+			},
+
+			assertFunc: func(output *changes.ReadAllConflictsOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, 201, output.Count)
+				assert.Equal(t, append(firstPage.Items, secondPage.Items...), output.Items)
+				assert.Equal(t, 2, httpmock.GetTotalCallCount())
+			},
+		},
+		{
+			testName: "returns error when reading the devices fails",
+			label:    "",
+
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readAllUrl, "q=configState:CONFLICT_DETECTED&limit=200&offset=0", httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"))
+			},
+
+			assertFunc: func(output *changes.ReadAllConflictsOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := changes.ReadAllConflicts(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				changes.NewReadAllConflictsInput(testCase.label),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package changes
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/conflict"
+)
+
+type ReadConflictInput struct {
+	DeviceUid string
+}
+
+type ReadConflictOutput = conflict.Conflict
+
+func NewReadConflictInput(deviceUid string) ReadConflictInput {
+	return ReadConflictInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// ReadConflict reads the out-of-band change to the configuration of the device, it fails with a not found error
+// if the device has no conflict.
+func ReadConflict(ctx context.Context, client http.Client, readInp ReadConflictInput) (*ReadConflictOutput, error) {
+
+	client.Logger.Println("reading device conflict")
+
+	req := client.NewGet(ctx, url.ReadDeviceConflict(client.BaseUrl(), readInp.DeviceUid))
+
+	var outp ReadConflictOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,82 @@
+package changes_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/conflict"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadConflict(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.AsaUid.String()
+
+	deviceConflict := conflict.Conflict{
+		DeviceUid:    deviceUid,
+		DetectedDate: 1700000000000,
+		Diff:         "-hostname cdo-copy\n+hostname out-of-band\n",
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *changes.ReadConflictOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the conflict of a device",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadDeviceConflict(testModel.BaseUrl, deviceUid), deviceConflict)
+			},
+
+			assertFunc: func(output *changes.ReadConflictOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, deviceConflict, *output)
+			},
+		},
+		{
+			testName: "returns not found error when the device has no conflict",
+
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadDeviceConflict(testModel.BaseUrl, deviceUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+
+			assertFunc: func(output *changes.ReadConflictOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := changes.ReadConflict(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				changes.NewReadConflictInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package changes
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+// readFromDeviceUrls are the endpoints that read the configuration of each type of device that supports it.
+var readFromDeviceUrls = map[devicetype.Type]func(baseUrl string, deviceUid string) string{
+	devicetype.Asa: url.ReadConfigFromAsa,
+}
+
+type ReadFromDeviceInput struct {
+	DeviceUid  string
+	DeviceType devicetype.Type
+}
+
+type ReadFromDeviceOutput = ReadOutput
+
+func NewReadFromDeviceInput(deviceUid string, deviceType devicetype.Type) ReadFromDeviceInput {
+	return ReadFromDeviceInput{
+		DeviceUid:  deviceUid,
+		DeviceType: deviceType,
+	}
+}
+
+// ReadFromDevice replaces CDO's copy of the device configuration with the configuration on the device, discarding
+// changes pending deploy. It waits for the read to finish.
+func ReadFromDevice(ctx context.Context, client http.Client, readInp ReadFromDeviceInput) (*ReadFromDeviceOutput, error) {
+
+	client.Logger.Println("reading config from device")
+
+	readUrl, ok := readFromDeviceUrls[readInp.DeviceType]
+	if !ok {
+		return nil, fmt.Errorf("reading the configuration is not supported for devices of type %s", readInp.DeviceType)
+	}
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, readUrl(client.BaseUrl(), readInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for the configuration to be read from the device...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, NewReadInput(readInp.DeviceUid))
+}
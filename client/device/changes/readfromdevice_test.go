@@ -0,0 +1,115 @@
+package changes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/configstate"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadFromDevice(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	readOutput := changes.ReadOutput{
+		Uid:               testModel.AsaUid.String(),
+		Name:              testModel.AsaName,
+		DeviceType:        devicetype.Asa,
+		ConfigState:       configstate.Synced,
+		ConnectivityState: "ONLINE",
+	}
+	doneTransaction := testModel.CreateDoneTransaction(readOutput.Uid, transactiontype.READ_ASA)
+	errorTransaction := testModel.CreateErrorTransaction(readOutput.Uid, transactiontype.READ_ASA)
+
+	testCases := []struct {
+		testName   string
+		deviceType devicetype.Type
+		setupFunc  func()
+		assertFunc func(output *changes.ReadFromDeviceOutput, err error, t *testing.T)
+	}{
+		{
+			testName:   "successfully reads the config from the device",
+			deviceType: devicetype.Asa,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.ReadConfigFromAsa(testModel.BaseUrl, readOutput.Uid), doneTransaction)
+				internalTesting.MockGetOk(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(output *changes.ReadFromDeviceOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, readOutput, *output)
+			},
+		},
+		{
+			testName:   "returns error when the transaction fails",
+			deviceType: devicetype.Asa,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.ReadConfigFromAsa(testModel.BaseUrl, readOutput.Uid), errorTransaction)
+				internalTesting.MockGetOk(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(output *changes.ReadFromDeviceOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName:   "returns error when the transaction cannot be triggered",
+			deviceType: devicetype.Asa,
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.ReadConfigFromAsa(testModel.BaseUrl, readOutput.Uid), "internal server error")
+			},
+
+			assertFunc: func(output *changes.ReadFromDeviceOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			testName:   "returns error when the device type does not support it",
+			deviceType: devicetype.Ios,
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.ReadConfigFromAsa(testModel.BaseUrl, readOutput.Uid), doneTransaction)
+			},
+
+			assertFunc: func(output *changes.ReadFromDeviceOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "reading the configuration is not supported for devices of type IOS")
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+url.ReadConfigFromAsa(testModel.BaseUrl, readOutput.Uid)])
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := changes.ReadFromDevice(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				changes.NewReadFromDeviceInput(readOutput.Uid, testCase.deviceType),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
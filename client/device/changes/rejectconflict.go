@@ -0,0 +1,49 @@
+package changes
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type RejectConflictInput struct {
+	DeviceUid string
+}
+
+type RejectConflictOutput = ReadOutput
+
+func NewRejectConflictInput(deviceUid string) RejectConflictInput {
+	return RejectConflictInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// RejectConflict rejects the out-of-band change to the configuration of the device, deploying CDO's copy of the
+// configuration to the device. It waits for the conflict to be resolved.
+func RejectConflict(ctx context.Context, client http.Client, rejectInp RejectConflictInput) (*RejectConflictOutput, error) {
+
+	client.Logger.Println("rejecting device conflict")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.RejectDeviceConflict(client.BaseUrl(), rejectInp.DeviceUid), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(15*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message("Waiting for the out-of-band change to be rejected...").
+		Delay(5*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, NewReadInput(rejectInp.DeviceUid))
+}
@@ -0,0 +1,98 @@
+package changes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/configstate"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRejectConflict(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	readOutput := changes.ReadOutput{
+		Uid:               testModel.AsaUid.String(),
+		Name:              testModel.AsaName,
+		DeviceType:        devicetype.Asa,
+		ConfigState:       configstate.Synced,
+		ConnectivityState: "ONLINE",
+	}
+	doneTransaction := testModel.CreateDoneTransaction(readOutput.Uid, transactiontype.REJECT_DEVICE_CONFLICT)
+	errorTransaction := testModel.CreateErrorTransaction(readOutput.Uid, transactiontype.REJECT_DEVICE_CONFLICT)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *changes.RejectConflictOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully rejects the out-of-band change",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.RejectDeviceConflict(testModel.BaseUrl, readOutput.Uid), doneTransaction)
+				internalTesting.MockGetOk(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(output *changes.RejectConflictOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, readOutput, *output)
+				assert.False(t, output.HasConflict())
+			},
+		},
+		{
+			testName: "returns error when the transaction fails",
+
+			setupFunc: func() {
+				internalTesting.MockPostAccepted(url.RejectDeviceConflict(testModel.BaseUrl, readOutput.Uid), errorTransaction)
+				internalTesting.MockGetOk(url.ReadInventoryDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(output *changes.RejectConflictOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when the transaction cannot be triggered",
+
+			setupFunc: func() {
+				internalTesting.MockPostError(url.RejectDeviceConflict(testModel.BaseUrl, readOutput.Uid), "internal server error")
+			},
+
+			assertFunc: func(output *changes.RejectConflictOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := changes.RejectConflict(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				changes.NewRejectConflictInput(readOutput.Uid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+type CreateInput struct {
+	FmcHostname   string
+	FmcDomainUid  string
+	Name          string
+	Description   string
+	DefaultAction accesspolicy.DefaultAction
+}
+
+func NewCreateInput(fmcHostname, fmcDomainUid, name, description string, defaultAction accesspolicy.DefaultAction) CreateInput {
+	return CreateInput{
+		FmcHostname:   fmcHostname,
+		FmcDomainUid:  fmcDomainUid,
+		Name:          name,
+		Description:   description,
+		DefaultAction: defaultAction,
+	}
+}
+
+type CreateOutput = accesspolicy.AccessPolicy
+
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating FMC access policy")
+
+	createUrl := url.CreateFmcAccessPolicy(client.BaseUrl(), createInp.FmcDomainUid)
+	createBody := accesspolicy.New("", createInp.Name, createInp.Description, createInp.DefaultAction)
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var outp CreateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,61 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+const (
+	SectionMandatory = "mandatory"
+	SectionDefault   = "default"
+)
+
+type CreateRuleInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	AccessPolicyUid string
+	Section         string // mandatory or default, ignored if InsertBefore is set
+	InsertBefore    *int   // the 1-based index of the rule that the new rule will be inserted before
+	Rule            accesspolicy.Rule
+}
+
+func NewCreateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, section string, insertBefore *int, rule accesspolicy.Rule) CreateRuleInput {
+	return CreateRuleInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		AccessPolicyUid: accessPolicyUid,
+		Section:         section,
+		InsertBefore:    insertBefore,
+		Rule:            rule,
+	}
+}
+
+type CreateRuleOutput = accesspolicy.Rule
+
+func CreateRule(ctx context.Context, client http.Client, createInp CreateRuleInput) (*CreateRuleOutput, error) {
+
+	client.Logger.Println("creating FMC access rule")
+
+	createUrl := url.CreateFmcAccessRule(client.BaseUrl(), createInp.FmcDomainUid, createInp.AccessPolicyUid)
+	createBody := createInp.Rule
+	createBody.Type = accesspolicy.RuleType
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+	if createInp.InsertBefore != nil {
+		req.QueryParams.Add("insertBefore", strconv.Itoa(*createInp.InsertBefore))
+	} else if createInp.Section != "" {
+		req.QueryParams.Add("section", createInp.Section)
+	}
+
+	var outp CreateRuleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,110 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	insertBefore := 3
+	ruleToCreate := validAccessRule
+	ruleToCreate.Id = ""
+	ruleToCreate.Type = ""
+	ruleToCreate.Metadata = nil
+
+	testCases := []struct {
+		testName   string
+		input      fmcaccesspolicy.CreateRuleInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcaccesspolicy.CreateRuleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates access rule in section",
+			input:    fmcaccesspolicy.NewCreateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, fmcaccesspolicy.SectionMandatory, nil, ruleToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodPost,
+					url.CreateFmcAccessRule(baseUrl, fmcDomainUid, accessPolicyUid),
+					"section=mandatory",
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[accesspolicy.Rule](r)
+						if err != nil {
+							return nil, err
+						}
+						expectedBody := ruleToCreate
+						expectedBody.Type = accesspolicy.RuleType
+						assert.Equal(t, expectedBody, *body)
+						return httpmock.NewJsonResponse(http.StatusCreated, validAccessRule)
+					},
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.CreateRuleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessRule, *output)
+			},
+		},
+		{
+			testName: "successfully creates access rule before given index",
+			input:    fmcaccesspolicy.NewCreateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, fmcaccesspolicy.SectionMandatory, &insertBefore, ruleToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodPost,
+					url.CreateFmcAccessRule(baseUrl, fmcDomainUid, accessPolicyUid),
+					"insertBefore=3",
+					httpmock.NewJsonResponderOrPanic(http.StatusCreated, validAccessRule),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.CreateRuleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessRule, *output)
+			},
+		},
+		{
+			testName: "returns error when create access rule error",
+			input:    fmcaccesspolicy.NewCreateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, fmcaccesspolicy.SectionDefault, nil, ruleToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodPost,
+					url.CreateFmcAccessRule(baseUrl, fmcDomainUid, accessPolicyUid),
+					"section=default",
+					httpmock.NewStringResponder(http.StatusUnprocessableEntity, "invalid rule"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.CreateRuleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcaccesspolicy.CreateRule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
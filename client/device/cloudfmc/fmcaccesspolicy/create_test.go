@@ -0,0 +1,85 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		input      fmcaccesspolicy.CreateInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcaccesspolicy.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates access policy",
+			input:    fmcaccesspolicy.NewCreateInput(fmcHostname, fmcDomainUid, accessPolicyName, accessPolicyDescription, accesspolicy.NewDefaultAction("", defaultActionAction, false, true, true)),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcAccessPolicy(baseUrl, fmcDomainUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[accesspolicy.AccessPolicy](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, accesspolicy.Type, body.Type)
+						assert.Equal(t, accessPolicyName, body.Name)
+						assert.Equal(t, defaultActionAction, body.DefaultAction.Action)
+						return httpmock.NewJsonResponse(http.StatusCreated, validAccessPolicy)
+					},
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessPolicy, *output)
+			},
+		},
+		{
+			testName: "returns error when create access policy error",
+			input:    fmcaccesspolicy.NewCreateInput(fmcHostname, fmcDomainUid, accessPolicyName, accessPolicyDescription, validDefaultAction),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcAccessPolicy(baseUrl, fmcDomainUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcaccesspolicy.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
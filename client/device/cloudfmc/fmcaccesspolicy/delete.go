@@ -0,0 +1,42 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string
+}
+
+func NewDeleteInput(fmcHostname, fmcDomainUid, uid string) DeleteInput {
+	return DeleteInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+	}
+}
+
+type DeleteOutput struct {
+}
+
+func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*DeleteOutput, error) {
+
+	client.Logger.Println("deleting FMC access policy")
+
+	deleteUrl := url.FmcAccessPolicyByUid(client.BaseUrl(), deleteInp.FmcDomainUid, deleteInp.Uid)
+
+	req := client.NewDelete(ctx, deleteUrl)
+	req.Header.Add("Fmc-Hostname", deleteInp.FmcHostname)
+
+	var outp DeleteOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
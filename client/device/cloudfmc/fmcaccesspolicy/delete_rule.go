@@ -0,0 +1,44 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteRuleInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	AccessPolicyUid string
+	Uid             string
+}
+
+func NewDeleteRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, uid string) DeleteRuleInput {
+	return DeleteRuleInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		AccessPolicyUid: accessPolicyUid,
+		Uid:             uid,
+	}
+}
+
+type DeleteRuleOutput struct {
+}
+
+func DeleteRule(ctx context.Context, client http.Client, deleteInp DeleteRuleInput) (*DeleteRuleOutput, error) {
+
+	client.Logger.Println("deleting FMC access rule")
+
+	deleteUrl := url.FmcAccessRuleByUid(client.BaseUrl(), deleteInp.FmcDomainUid, deleteInp.AccessPolicyUid, deleteInp.Uid)
+
+	req := client.NewDelete(ctx, deleteUrl)
+	req.Header.Add("Fmc-Hostname", deleteInp.FmcHostname)
+
+	var outp DeleteRuleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
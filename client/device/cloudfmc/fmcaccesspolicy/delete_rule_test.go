@@ -0,0 +1,70 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcaccesspolicy.DeleteRuleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes access rule",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessRule),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.DeleteRuleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when delete access rule error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.DeleteRuleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcaccesspolicy.DeleteRule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcaccesspolicy.NewDeleteRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, accessRuleUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcaccesspolicy.DeleteOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes access policy",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessPolicy),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.DeleteOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when delete access policy error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.DeleteOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcaccesspolicy.Delete(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcaccesspolicy.NewDeleteInput(fmcHostname, fmcDomainUid, accessPolicyUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package fmcaccesspolicy_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname  = "unit-test-fmc-hostname.net"
+	fmcDomainUid = "unit-test-fmc-domain-uid"
+
+	accessPolicyUid         = "unit-test-access-policy-uid"
+	accessPolicyName        = "unit-test-access-policy-name"
+	accessPolicyDescription = "unit-test-access-policy-description"
+	defaultActionUid        = "unit-test-default-action-uid"
+	defaultActionAction     = "BLOCK"
+
+	accessRuleUid    = "unit-test-access-rule-uid"
+	accessRuleName   = "unit-test-access-rule-name"
+	accessRuleAction = "ALLOW"
+	securityZoneUid  = "unit-test-security-zone-uid"
+	networkUid       = "unit-test-network-uid"
+	networkType      = "Network"
+	hostLiteral      = "10.10.10.10"
+)
+
+var (
+	validDefaultAction = accesspolicy.NewDefaultAction(defaultActionUid, defaultActionAction, false, true, true)
+	validAccessPolicy  = accesspolicy.New(accessPolicyUid, accessPolicyName, accessPolicyDescription, validDefaultAction)
+
+	validAccessRule = accesspolicy.Rule{
+		Id:                  accessRuleUid,
+		Type:                accesspolicy.RuleType,
+		Name:                accessRuleName,
+		Action:              accessRuleAction,
+		Enabled:             true,
+		SourceZones:         accesspolicy.NewRuleEntities([]accesspolicy.RuleObject{accesspolicy.NewRuleObject(securityZoneUid, accesspolicy.SecurityZoneType)}, nil),
+		DestinationNetworks: accesspolicy.NewRuleEntities([]accesspolicy.RuleObject{accesspolicy.NewRuleObject(networkUid, networkType)}, []accesspolicy.RuleLiteral{accesspolicy.NewRuleLiteral("Host", hostLiteral)}),
+		LogEnd:              true,
+		SendEventsToFMC:     true,
+		Metadata: &accesspolicy.RuleMetadata{
+			RuleIndex: 1,
+			Section:   "Mandatory",
+			Category:  "--Undefined--",
+		},
+	}
+)
@@ -0,0 +1,42 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+type ReadInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string
+}
+
+func NewReadInput(fmcHostname, fmcDomainUid, uid string) ReadInput {
+	return ReadInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+	}
+}
+
+type ReadOutput = accesspolicy.AccessPolicy
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading FMC access policy")
+
+	readUrl := url.FmcAccessPolicyByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,44 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+type ReadRuleInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	AccessPolicyUid string
+	Uid             string
+}
+
+func NewReadRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, uid string) ReadRuleInput {
+	return ReadRuleInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		AccessPolicyUid: accessPolicyUid,
+		Uid:             uid,
+	}
+}
+
+type ReadRuleOutput = accesspolicy.Rule
+
+func ReadRule(ctx context.Context, client http.Client, readInp ReadRuleInput) (*ReadRuleOutput, error) {
+
+	client.Logger.Println("reading FMC access rule")
+
+	readUrl := url.FmcAccessRuleByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.AccessPolicyUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadRuleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,73 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcaccesspolicy.ReadRuleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads access rule",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessRule),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.ReadRuleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validAccessRule, *output)
+			},
+		},
+		{
+			testName: "returns not found error when access rule does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.ReadRuleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.True(t, errors.Is(err, internalHttp.NotFoundError))
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcaccesspolicy.ReadRule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcaccesspolicy.NewReadRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, accessRuleUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
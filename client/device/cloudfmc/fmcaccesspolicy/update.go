@@ -0,0 +1,58 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+type UpdateInput struct {
+	FmcHostname   string
+	FmcDomainUid  string
+	Uid           string
+	Name          string
+	Description   string
+	DefaultAction accesspolicy.DefaultAction
+}
+
+func NewUpdateInput(fmcHostname, fmcDomainUid, uid, name, description string, defaultAction accesspolicy.DefaultAction) UpdateInput {
+	return UpdateInput{
+		FmcHostname:   fmcHostname,
+		FmcDomainUid:  fmcDomainUid,
+		Uid:           uid,
+		Name:          name,
+		Description:   description,
+		DefaultAction: defaultAction,
+	}
+}
+
+type UpdateOutput = accesspolicy.AccessPolicy
+
+func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating FMC access policy")
+
+	// the default action has its own id, which FMC requires when it is updated together with the policy
+	readOutp, err := Read(ctx, client, NewReadInput(updateInp.FmcHostname, updateInp.FmcDomainUid, updateInp.Uid))
+	if err != nil {
+		return nil, err
+	}
+	defaultAction := updateInp.DefaultAction
+	defaultAction.Id = readOutp.DefaultAction.Id
+	defaultAction.Type = accesspolicy.DefaultActionType
+
+	updateUrl := url.FmcAccessPolicyByUid(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.Uid)
+	updateBody := accesspolicy.New(updateInp.Uid, updateInp.Name, updateInp.Description, defaultAction)
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,50 @@
+package fmcaccesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+)
+
+type UpdateRuleInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	AccessPolicyUid string
+	Uid             string
+	Rule            accesspolicy.Rule
+}
+
+func NewUpdateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, uid string, rule accesspolicy.Rule) UpdateRuleInput {
+	return UpdateRuleInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		AccessPolicyUid: accessPolicyUid,
+		Uid:             uid,
+		Rule:            rule,
+	}
+}
+
+type UpdateRuleOutput = accesspolicy.Rule
+
+func UpdateRule(ctx context.Context, client http.Client, updateInp UpdateRuleInput) (*UpdateRuleOutput, error) {
+
+	client.Logger.Println("updating FMC access rule")
+
+	updateUrl := url.FmcAccessRuleByUid(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.AccessPolicyUid, updateInp.Uid)
+	updateBody := updateInp.Rule
+	updateBody.Id = updateInp.Uid
+	updateBody.Type = accesspolicy.RuleType
+	updateBody.Metadata = nil // the position of a rule cannot be changed by an update
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdateRuleOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,87 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateRule(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	updatedAccessRule := validAccessRule
+	updatedAccessRule.Action = "BLOCK"
+	updatedAccessRule.Enabled = false
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcaccesspolicy.UpdateRuleOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates access rule",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[accesspolicy.Rule](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, accessRuleUid, body.Id)
+						assert.Equal(t, accesspolicy.RuleType, body.Type)
+						assert.Equal(t, "BLOCK", body.Action)
+						assert.Nil(t, body.Metadata)
+						return httpmock.NewJsonResponse(http.StatusOK, updatedAccessRule)
+					},
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.UpdateRuleOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, updatedAccessRule, *output)
+			},
+		},
+		{
+			testName: "returns error when update access rule error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcAccessRuleByUid(baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.UpdateRuleOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcaccesspolicy.UpdateRule(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcaccesspolicy.NewUpdateRuleInput(fmcHostname, fmcDomainUid, accessPolicyUid, accessRuleUid, updatedAccessRule),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package fmcaccesspolicy_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	newDefaultAction := accesspolicy.NewDefaultAction("", "TRUST", false, true, false)
+	updatedAccessPolicy := accesspolicy.New(accessPolicyUid, "new-name", accessPolicyDescription, accesspolicy.NewDefaultAction(defaultActionUid, "TRUST", false, true, false))
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcaccesspolicy.UpdateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates access policy and its default action",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessPolicy),
+				)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[accesspolicy.AccessPolicy](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, updatedAccessPolicy, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, updatedAccessPolicy)
+					},
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, updatedAccessPolicy, *output)
+			},
+		},
+		{
+			testName: "returns error when read access policy error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when update access policy error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validAccessPolicy),
+				)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcAccessPolicyByUid(baseUrl, fmcDomainUid, accessPolicyUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcaccesspolicy.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcaccesspolicy.Update(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcaccesspolicy.NewUpdateInput(fmcHostname, fmcDomainUid, accessPolicyUid, "new-name", accessPolicyDescription, newDefaultAction),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package fmcconfig
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
+)
+
+type UpdateDeviceRecordInput struct {
+	FmcDomainUid    string
+	FmcHostname     string
+	DeviceRecordUid string
+	Name            string
+	LicenseCaps     *[]license.Type // nil to leave unchanged
+	PerformanceTier *tier.Type      // nil to leave unchanged
+}
+
+func NewUpdateDeviceRecordInput(fmcDomainUid, fmcHostname, deviceRecordUid, name string, licenseCaps *[]license.Type, performanceTier *tier.Type) UpdateDeviceRecordInput {
+	return UpdateDeviceRecordInput{
+		FmcDomainUid:    fmcDomainUid,
+		FmcHostname:     fmcHostname,
+		DeviceRecordUid: deviceRecordUid,
+		Name:            name,
+		LicenseCaps:     licenseCaps,
+		PerformanceTier: performanceTier,
+	}
+}
+
+type updateDeviceRecordRequestBody struct {
+	Id              string          `json:"id"`
+	Type            string          `json:"type"`
+	Name            string          `json:"name"`
+	LicenseCaps     *[]license.Type `json:"license_caps,omitempty"` // must be FMC license types
+	PerformanceTier *tier.Type      `json:"performanceTier,omitempty"`
+}
+
+type UpdateDeviceRecordOutput = fmcconfig.DeviceRecord
+
+func UpdateDeviceRecord(ctx context.Context, client http.Client, updateInp UpdateDeviceRecordInput) (*UpdateDeviceRecordOutput, error) {
+
+	client.Logger.Println("updating FMC device record")
+
+	updateUrl := url.UpdateFmcDeviceRecord(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.DeviceRecordUid)
+
+	body := updateDeviceRecordRequestBody{
+		Id:              updateInp.DeviceRecordUid,
+		Type:            "Device",
+		Name:            updateInp.Name,
+		PerformanceTier: updateInp.PerformanceTier,
+	}
+	if updateInp.LicenseCaps != nil {
+		fmcLicenseCaps := license.LicensesToFmcLicenses(*updateInp.LicenseCaps)
+		body.LicenseCaps = &fmcLicenseCaps
+	}
+	req := client.NewPut(ctx, updateUrl, body)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var updateOutp UpdateDeviceRecordOutput
+	if err := req.Send(&updateOutp); err != nil {
+		return nil, err
+	}
+
+	return &updateOutp, nil
+}
@@ -0,0 +1,140 @@
+package fmcconfig_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelFmcConfig "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	baseUrl         = "https://unit-test.net"
+	fmcHostname     = "unit-test-fmc-hostname.net"
+	fmcDomainUid    = "unit-test-fmc-domain-uid"
+	deviceRecordUid = "unit-test-device-record-uid"
+	deviceName      = "unit-test-device-name"
+)
+
+func TestUpdateDeviceRecord(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	performanceTier := tier.FTDv20
+	validDeviceRecord := modelFmcConfig.DeviceRecord{
+		Id:              deviceRecordUid,
+		Type:            "Device",
+		Name:            deviceName,
+		LicenseCaps:     []string{string(license.Essentials), string(license.IPS)},
+		PerformanceTier: string(performanceTier),
+	}
+
+	testCases := []struct {
+		testName   string
+		input      fmcconfig.UpdateDeviceRecordInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcconfig.UpdateDeviceRecordOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates licenses in FMC terms and performance tier",
+			input:    fmcconfig.NewUpdateDeviceRecordInput(fmcDomainUid, fmcHostname, deviceRecordUid, deviceName, &[]license.Type{license.Base, license.Threat}, &performanceTier),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateFmcDeviceRecord(baseUrl, fmcDomainUid, deviceRecordUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := readBody(r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, deviceRecordUid, body["id"])
+						assert.Equal(t, deviceName, body["name"])
+						assert.Equal(t, []any{string(license.Essentials), string(license.IPS)}, body["license_caps"])
+						assert.Equal(t, string(performanceTier), body["performanceTier"])
+						return httpmock.NewJsonResponse(http.StatusOK, validDeviceRecord)
+					},
+				)
+			},
+			assertFunc: func(output *fmcconfig.UpdateDeviceRecordOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validDeviceRecord, *output)
+			},
+		},
+		{
+			testName: "leaves out the licenses and performance tier that are not changed",
+			input:    fmcconfig.NewUpdateDeviceRecordInput(fmcDomainUid, fmcHostname, deviceRecordUid, deviceName, nil, nil),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateFmcDeviceRecord(baseUrl, fmcDomainUid, deviceRecordUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := readBody(r)
+						if err != nil {
+							return nil, err
+						}
+						assert.NotContains(t, body, "license_caps")
+						assert.NotContains(t, body, "performanceTier")
+						return httpmock.NewJsonResponse(http.StatusOK, validDeviceRecord)
+					},
+				)
+			},
+			assertFunc: func(output *fmcconfig.UpdateDeviceRecordOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when update device record error",
+			input:    fmcconfig.NewUpdateDeviceRecordInput(fmcDomainUid, fmcHostname, deviceRecordUid, deviceName, nil, &performanceTier),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateFmcDeviceRecord(baseUrl, fmcDomainUid, deviceRecordUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcconfig.UpdateDeviceRecordOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcconfig.UpdateDeviceRecord(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
+
+func readBody(r *http.Request) (map[string]any, error) {
+	bodyBytes, err := io.ReadAll(r.Body)
+	if err != nil {
+		return nil, err
+	}
+	var body map[string]any
+	err = json.Unmarshal(bodyBytes, &body)
+	return body, err
+}
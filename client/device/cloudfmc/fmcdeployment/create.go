@@ -0,0 +1,48 @@
+package fmcdeployment
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/deployment"
+)
+
+type CreateInput struct {
+	FmcHostname    string
+	FmcDomainUid   string
+	Version        string
+	DeviceUids     []string
+	DeploymentNote string
+}
+
+func NewCreateInput(fmcHostname, fmcDomainUid, version string, deviceUids []string, deploymentNote string) CreateInput {
+	return CreateInput{
+		FmcHostname:    fmcHostname,
+		FmcDomainUid:   fmcDomainUid,
+		Version:        version,
+		DeviceUids:     deviceUids,
+		DeploymentNote: deploymentNote,
+	}
+}
+
+type CreateOutput = deployment.Request
+
+// Create creates a deployment request on the FMC, the deployment runs asynchronously as the FMC task in the metadata of the output.
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating FMC deployment request")
+
+	createUrl := url.CreateFmcDeploymentRequest(client.BaseUrl(), createInp.FmcDomainUid)
+	createBody := deployment.NewRequest(createInp.Version, createInp.DeviceUids, createInp.DeploymentNote)
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var outp CreateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,88 @@
+package fmcdeployment_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcdeployment"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/deployment"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		input      fmcdeployment.CreateInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcdeployment.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates deployment request",
+			input:    fmcdeployment.NewCreateInput(fmcHostname, fmcDomainUid, deviceVersion2, []string{deviceUid1, deviceUid2}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcDeploymentRequest(baseUrl, fmcDomainUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[deployment.Request](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, deployment.DeploymentRequestType, body.Type)
+						assert.Equal(t, deviceVersion2, body.Version)
+						assert.Equal(t, []string{deviceUid1, deviceUid2}, body.DeviceList)
+						assert.Equal(t, deploymentNote, body.DeploymentNote)
+						assert.True(t, body.IgnoreWarning)
+						assert.False(t, body.ForceDeploy)
+						return httpmock.NewJsonResponse(http.StatusAccepted, validDeploymentRequest)
+					},
+				)
+			},
+			assertFunc: func(output *fmcdeployment.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validDeploymentRequest, *output)
+			},
+		},
+		{
+			testName: "returns error when create deployment request error",
+			input:    fmcdeployment.NewCreateInput(fmcHostname, fmcDomainUid, deviceVersion2, []string{deviceUid1, deviceUid2}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcDeploymentRequest(baseUrl, fmcDomainUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcdeployment.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcdeployment.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package fmcdeployment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/sliceutil"
+)
+
+type DeployInput struct {
+	FmcHostname    string
+	FmcDomainUid   string
+	DeviceUids     []string
+	DeploymentNote string
+}
+
+func NewDeployInput(fmcHostname, fmcDomainUid string, deviceUids []string, deploymentNote string) DeployInput {
+	return DeployInput{
+		FmcHostname:    fmcHostname,
+		FmcDomainUid:   fmcDomainUid,
+		DeviceUids:     deviceUids,
+		DeploymentNote: deploymentNote,
+	}
+}
+
+type DeployOutput struct {
+	// DeployedDeviceUids are the uids of the devices that had pending changes and were deployed to.
+	DeployedDeviceUids []string
+	// TaskId is the id of the FMC task of the deployment, it is empty if no device had pending changes.
+	TaskId string
+}
+
+// Deploy deploys the pending changes on the FMC to the given devices, and waits for the deployment task to finish.
+// Devices without pending changes are skipped, if none of the devices has pending changes, no deployment request is made.
+func Deploy(ctx context.Context, client http.Client, deployInp DeployInput) (*DeployOutput, error) {
+
+	client.Logger.Println("deploying FMC changes to devices")
+
+	deployableDevices, err := ReadDeployableDevices(ctx, client, NewReadDeployableDevicesInput(deployInp.FmcHostname, deployInp.FmcDomainUid))
+	if err != nil {
+		return nil, err
+	}
+
+	// the deployment request deploys all changes up to a version, so we take the latest version of the devices we deploy to
+	// the versions are timestamps, they are compared as numbers as they do not always have the same number of digits
+	var version string
+	var latestVersion int64
+	var deviceUids []string
+	for _, deployableDevice := range deployableDevices.Items {
+		if !deployableDevice.CanBeDeployed || !sliceutil.Contains(deployInp.DeviceUids, deployableDevice.Device.Id) {
+			continue
+		}
+		deviceVersion, err := strconv.ParseInt(deployableDevice.Version, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse version %s of device %s, cause=%w", deployableDevice.Version, deployableDevice.Name, err)
+		}
+		deviceUids = append(deviceUids, deployableDevice.Device.Id)
+		if version == "" || deviceVersion > latestVersion {
+			version = deployableDevice.Version
+			latestVersion = deviceVersion
+		}
+	}
+	if len(deviceUids) == 0 {
+		client.Logger.Println("no pending changes to deploy")
+		return &DeployOutput{}, nil
+	}
+
+	createOutp, err := Create(ctx, client, NewCreateInput(deployInp.FmcHostname, deployInp.FmcDomainUid, version, deviceUids, deployInp.DeploymentNote))
+	if err != nil {
+		return nil, err
+	}
+	if createOutp.Metadata == nil || createOutp.Metadata.Task.Id == "" {
+		return nil, fmt.Errorf("deployment request was created without a task to track it")
+	}
+
+	err = retry.Do(
+		ctx,
+		fmcconfig.UntilTaskStatusSuccess(ctx, client, fmcconfig.NewReadTaskStatusInput(deployInp.FmcDomainUid, createOutp.Metadata.Task.Id, deployInp.FmcHostname)),
+		retry.NewOptionsBuilder().
+			Message("Waiting for FMC deployment to finish...").
+			Retries(-1).
+			Logger(client.Logger).
+			Timeout(30*time.Minute). // usually 5-10 minutes
+			EarlyExitOnError(true).
+			Delay(3*time.Second).
+			Build(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeployOutput{
+		DeployedDeviceUids: deviceUids,
+		TaskId:             createOutp.Metadata.Task.Id,
+	}, nil
+}
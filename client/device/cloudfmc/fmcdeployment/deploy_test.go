@@ -0,0 +1,194 @@
+package fmcdeployment_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcdeployment"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/deployment"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeploy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	readDeployableDevicesUrl := url.ReadFmcDeployableDevices(baseUrl, fmcDomainUid)
+	createUrl := url.CreateFmcDeploymentRequest(baseUrl, fmcDomainUid)
+	readTaskStatusUrl := url.ReadFmcTaskStatus(baseUrl, fmcDomainUid, taskUid)
+
+	testCases := []struct {
+		testName   string
+		input      fmcdeployment.DeployInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcdeployment.DeployOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deploys to the devices with pending changes",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1, deviceUid2, deviceUid3}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, validDeployableDevices))
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					createUrl,
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[deployment.Request](r)
+						if err != nil {
+							return nil, err
+						}
+						// device 3 cannot be deployed, and the version is the latest of the devices deployed to
+						assert.Equal(t, []string{deviceUid1, deviceUid2}, body.DeviceList)
+						assert.Equal(t, deviceVersion2, body.Version)
+						return httpmock.NewJsonResponse(http.StatusAccepted, validDeploymentRequest)
+					},
+				)
+				httpmock.RegisterResponder(http.MethodGet, readTaskStatusUrl, httpmock.NewJsonResponderOrPanic(http.StatusOK, successTaskStatus))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, []string{deviceUid1, deviceUid2}, output.DeployedDeviceUids)
+				assert.Equal(t, taskUid, output.TaskId)
+			},
+		},
+		{
+			testName: "deploys the latest version when the versions have a different number of digits",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1, deviceUid2}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				deployableDevices := deployment.DeployableDevices{
+					Items: []deployment.DeployableDevice{
+						{
+							Type:          deployment.DeployableDeviceType,
+							Name:          deviceName1,
+							Version:       "10",
+							CanBeDeployed: true,
+							Device:        deployment.NewDevice(deviceUid1, deviceName1, "Device"),
+						},
+						{
+							Type:          deployment.DeployableDeviceType,
+							Name:          deviceName2,
+							Version:       "9",
+							CanBeDeployed: true,
+							Device:        deployment.NewDevice(deviceUid2, deviceName2, "Device"),
+						},
+					},
+				}
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, deployableDevices))
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					createUrl,
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[deployment.Request](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, "10", body.Version)
+						return httpmock.NewJsonResponse(http.StatusAccepted, validDeploymentRequest)
+					},
+				)
+				httpmock.RegisterResponder(http.MethodGet, readTaskStatusUrl, httpmock.NewJsonResponderOrPanic(http.StatusOK, successTaskStatus))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, []string{deviceUid1, deviceUid2}, output.DeployedDeviceUids)
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[http.MethodPost+" "+createUrl])
+			},
+		},
+		{
+			testName: "returns error when the version of a device is not a number",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				deployableDevices := deployment.DeployableDevices{
+					Items: []deployment.DeployableDevice{
+						{
+							Type:          deployment.DeployableDeviceType,
+							Name:          deviceName1,
+							Version:       "not-a-version",
+							CanBeDeployed: true,
+							Device:        deployment.NewDevice(deviceUid1, deviceName1, "Device"),
+						},
+					},
+				}
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, deployableDevices))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.ErrorContains(t, err, "failed to parse version not-a-version")
+				assert.Nil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[http.MethodPost+" "+createUrl])
+			},
+		},
+		{
+			testName: "does not create deployment request when no device has pending changes",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid3}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, validDeployableDevices))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Empty(t, output.DeployedDeviceUids)
+				assert.Empty(t, output.TaskId)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[http.MethodPost+" "+createUrl])
+			},
+		},
+		{
+			testName: "returns error when deployment task fails",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, validDeployableDevices))
+				httpmock.RegisterResponder(http.MethodPost, createUrl, httpmock.NewJsonResponderOrPanic(http.StatusAccepted, validDeploymentRequest))
+				httpmock.RegisterResponder(http.MethodGet, readTaskStatusUrl, httpmock.NewJsonResponderOrPanic(http.StatusOK, failedTaskStatus))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, failedTaskStatus.Message)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when read deployable devices error",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when create deployment request error",
+			input:    fmcdeployment.NewDeployInput(fmcHostname, fmcDomainUid, []string{deviceUid1}, deploymentNote),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(http.MethodGet, readDeployableDevicesUrl, "expanded=true", httpmock.NewJsonResponderOrPanic(http.StatusOK, validDeployableDevices))
+				httpmock.RegisterResponder(http.MethodPost, createUrl, httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"))
+			},
+			assertFunc: func(output *fmcdeployment.DeployOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcdeployment.Deploy(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
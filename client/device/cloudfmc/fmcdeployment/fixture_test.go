@@ -0,0 +1,69 @@
+package fmcdeployment_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/deployment"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig/fmctaskstatus"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname  = "unit-test-fmc-hostname.net"
+	fmcDomainUid = "unit-test-fmc-domain-uid"
+
+	deviceUid1     = "unit-test-device-uid-1"
+	deviceName1    = "unit-test-device-name-1"
+	deviceVersion1 = "1700000000001"
+	deviceUid2     = "unit-test-device-uid-2"
+	deviceName2    = "unit-test-device-name-2"
+	deviceVersion2 = "1700000000002"
+	deviceUid3     = "unit-test-device-uid-3"
+	deviceName3    = "unit-test-device-name-3"
+	deviceVersion3 = "1700000000003"
+
+	deploymentNote = "unit-test-deployment-note"
+	taskUid        = "unit-test-task-uid"
+)
+
+var (
+	validDeployableDevices = deployment.DeployableDevices{
+		Items: []deployment.DeployableDevice{
+			{
+				Type:          deployment.DeployableDeviceType,
+				Name:          deviceName1,
+				Version:       deviceVersion1,
+				CanBeDeployed: true,
+				Device:        deployment.NewDevice(deviceUid1, deviceName1, "Device"),
+			},
+			{
+				Type:          deployment.DeployableDeviceType,
+				Name:          deviceName2,
+				Version:       deviceVersion2,
+				CanBeDeployed: true,
+				Device:        deployment.NewDevice(deviceUid2, deviceName2, "Device"),
+			},
+			{
+				Type:          deployment.DeployableDeviceType,
+				Name:          deviceName3,
+				Version:       deviceVersion3,
+				CanBeDeployed: false,
+				Device:        deployment.NewDevice(deviceUid3, deviceName3, "Device"),
+			},
+		},
+	}
+
+	validDeploymentRequest = deployment.Request{
+		Type:           deployment.DeploymentRequestType,
+		Version:        deviceVersion2,
+		IgnoreWarning:  true,
+		DeviceList:     []string{deviceUid1, deviceUid2},
+		DeploymentNote: deploymentNote,
+		Metadata: &deployment.RequestMetadata{
+			Task: fmcconfig.Task{Id: taskUid, Name: "unit-test-task-name", Type: "TaskStatus"},
+		},
+	}
+
+	successTaskStatus = fmcconfig.TaskStatus{Id: taskUid, Status: fmctaskstatus.Success}
+	failedTaskStatus  = fmcconfig.TaskStatus{Id: taskUid, Status: fmctaskstatus.Failed, Message: "unit-test-deployment-failed"}
+)
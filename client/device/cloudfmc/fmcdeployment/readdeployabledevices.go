@@ -0,0 +1,42 @@
+package fmcdeployment
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/deployment"
+)
+
+type ReadDeployableDevicesInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+}
+
+func NewReadDeployableDevicesInput(fmcHostname, fmcDomainUid string) ReadDeployableDevicesInput {
+	return ReadDeployableDevicesInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+	}
+}
+
+type ReadDeployableDevicesOutput = deployment.DeployableDevices
+
+// ReadDeployableDevices reads the devices that have changes on the FMC that are not yet deployed to them.
+func ReadDeployableDevices(ctx context.Context, client http.Client, readInp ReadDeployableDevicesInput) (*ReadDeployableDevicesOutput, error) {
+
+	client.Logger.Println("reading FMC deployable devices")
+
+	readUrl := url.ReadFmcDeployableDevices(client.BaseUrl(), readInp.FmcDomainUid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+	req.QueryParams.Add("expanded", "true")
+
+	var outp ReadDeployableDevicesOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,79 @@
+package fmcdeployment_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcdeployment"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDeployableDevices(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		input      fmcdeployment.ReadDeployableDevicesInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcdeployment.ReadDeployableDevicesOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads deployable devices",
+			input:    fmcdeployment.NewReadDeployableDevicesInput(fmcHostname, fmcDomainUid),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					url.ReadFmcDeployableDevices(baseUrl, fmcDomainUid),
+					"expanded=true",
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						return httpmock.NewJsonResponse(http.StatusOK, validDeployableDevices)
+					},
+				)
+			},
+			assertFunc: func(output *fmcdeployment.ReadDeployableDevicesOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validDeployableDevices, *output)
+			},
+		},
+		{
+			testName: "returns error when read deployable devices error",
+			input:    fmcdeployment.NewReadDeployableDevicesInput(fmcHostname, fmcDomainUid),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					url.ReadFmcDeployableDevices(baseUrl, fmcDomainUid),
+					"expanded=true",
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcdeployment.ReadDeployableDevicesOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcdeployment.ReadDeployableDevices(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
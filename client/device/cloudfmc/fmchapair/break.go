@@ -0,0 +1,50 @@
+package fmchapair
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+type BreakInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string
+	ForceBreak   bool // break the pair even if the standby FTD is not reachable
+}
+
+func NewBreakInput(fmcHostname, fmcDomainUid, uid string, forceBreak bool) BreakInput {
+	return BreakInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+		ForceBreak:   forceBreak,
+	}
+}
+
+type BreakOutput = hapair.HaPair
+
+// Break breaks the HA pair into two standalone FTDs, and waits for it to finish. The FTDs stay registered with the FMC.
+func Break(ctx context.Context, client http.Client, breakInp BreakInput) (*BreakOutput, error) {
+
+	client.Logger.Println("breaking FMC FTD HA pair")
+
+	breakUrl := url.FmcFtdHaPairByUid(client.BaseUrl(), breakInp.FmcDomainUid, breakInp.Uid)
+
+	req := client.NewPut(ctx, breakUrl, hapair.NewBreakAction(breakInp.Uid, breakInp.ForceBreak))
+	req.Header.Add("Fmc-Hostname", breakInp.FmcHostname)
+
+	var outp BreakOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	err := untilTaskDone(ctx, client, breakInp.FmcHostname, breakInp.FmcDomainUid, &outp, "Waiting for FMC FTD HA pair to break...")
+	if err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,103 @@
+package fmchapair_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreak(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	breakUrl := url.FmcFtdHaPairByUid(baseUrl, fmcDomainUid, haPairUid)
+
+	var actionBody hapair.Action
+	breakIsSuccessful := func() {
+		httpmock.RegisterResponder(
+			http.MethodPut,
+			breakUrl,
+			func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					return nil, err
+				}
+				if err := json.Unmarshal(body, &actionBody); err != nil {
+					return nil, err
+				}
+				return httpmock.NewJsonResponse(http.StatusAccepted, validAcceptedHaPair)
+			},
+		)
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmchapair.BreakOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully breaks HA pair",
+			setupFunc: func() {
+				breakIsSuccessful()
+				readTaskStatus(successTaskStatus)
+			},
+			assertFunc: func(output *fmchapair.BreakOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, hapair.NewBreakAction(haPairUid, true), actionBody)
+			},
+		},
+		{
+			testName: "returns error when break task failed",
+			setupFunc: func() {
+				breakIsSuccessful()
+				readTaskStatus(failedTaskStatus)
+			},
+			assertFunc: func(output *fmchapair.BreakOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when break error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					breakUrl,
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmchapair.BreakOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+			actionBody = hapair.Action{}
+
+			testCase.setupFunc()
+
+			output, err := fmchapair.Break(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmchapair.NewBreakInput(fmcHostname, fmcDomainUid, haPairUid, true),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package fmchapair
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+// Link is the failover or state link between the FTDs, InterfaceName is the physical interface of the FTDs used for the link, e.g. GigabitEthernet0/2.
+type Link struct {
+	InterfaceName string
+	LogicalName   string
+	ActiveIp      string
+	StandbyIp     string
+	SubnetMask    string
+}
+
+func NewLink(interfaceName, logicalName, activeIp, standbyIp, subnetMask string) Link {
+	return Link{
+		InterfaceName: interfaceName,
+		LogicalName:   logicalName,
+		ActiveIp:      activeIp,
+		StandbyIp:     standbyIp,
+		SubnetMask:    subnetMask,
+	}
+}
+
+type CreateInput struct {
+	FmcHostname        string
+	FmcDomainUid       string
+	Name               string
+	PrimaryDeviceUid   string // FMC device record uid of the primary FTD
+	SecondaryDeviceUid string // FMC device record uid of the secondary FTD
+	FailoverLink       Link
+	StateLink          *Link  // optional, the failover link is also used as state link if nil
+	SharedKey          string // optional, failover traffic is not encrypted if empty
+}
+
+type CreateOutput = hapair.HaPair
+
+// Create forms a HA pair from two FTDs registered with the FMC, and waits for the pair to be formed.
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating FMC FTD HA pair")
+
+	// the links are given by interface name, but the FMC wants the interface object of the primary FTD
+	interfaces, err := fmcinterface.ReadAllPhysical(ctx, client, fmcinterface.NewReadAllPhysicalInput(createInp.FmcHostname, createInp.FmcDomainUid, createInp.PrimaryDeviceUid))
+	if err != nil {
+		return nil, err
+	}
+	failoverLink, err := newFailoverLink(createInp.FailoverLink, *interfaces)
+	if err != nil {
+		return nil, err
+	}
+	bootstrap := hapair.Bootstrap{
+		UseSameLinkForFailovers: createInp.StateLink == nil,
+		LanFailover:             failoverLink,
+	}
+	if createInp.StateLink != nil {
+		stateLink, err := newFailoverLink(*createInp.StateLink, *interfaces)
+		if err != nil {
+			return nil, err
+		}
+		bootstrap.StatefulFailover = &stateLink
+	} else {
+		bootstrap.StatefulFailover = &failoverLink
+	}
+	if createInp.SharedKey != "" {
+		bootstrap.IsEncryptionEnabled = true
+		bootstrap.EncKeyGenerationScheme = hapair.EncKeyGenerationSchemeCustom
+		bootstrap.SharedKey = createInp.SharedKey
+	}
+
+	createUrl := url.CreateFmcFtdHaPair(client.BaseUrl(), createInp.FmcDomainUid)
+	createBody := hapair.New(createInp.Name, createInp.PrimaryDeviceUid, createInp.SecondaryDeviceUid, bootstrap)
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var createOutp hapair.HaPair
+	if err := req.Send(&createOutp); err != nil {
+		return nil, err
+	}
+
+	err = untilTaskDone(ctx, client, createInp.FmcHostname, createInp.FmcDomainUid, &createOutp, "Waiting for FMC FTD HA pair to be formed...")
+	if err != nil {
+		return nil, err
+	}
+
+	// the pair only gets its uid when it is formed, find it by name
+	allPairs, err := ReadAll(ctx, client, NewReadAllInput(createInp.FmcHostname, createInp.FmcDomainUid))
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range *allPairs {
+		if pair.Name == createInp.Name {
+			return &pair, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: FTD HA pair %s not found after it is formed", http.NotFoundError, createInp.Name)
+}
+
+func newFailoverLink(link Link, interfaces []modelInterface.PhysicalInterface) (hapair.FailoverLink, error) {
+	for _, physicalInterface := range interfaces {
+		if physicalInterface.Name == link.InterfaceName {
+			return hapair.FailoverLink{
+				SubnetMask:      link.SubnetMask,
+				InterfaceObject: hapair.NewInterface(physicalInterface.Id, physicalInterface.Name, physicalInterface.Type),
+				LogicalName:     link.LogicalName,
+				ActiveIp:        link.ActiveIp,
+				StandbyIp:       link.StandbyIp,
+			}, nil
+		}
+	}
+	return hapair.FailoverLink{}, fmt.Errorf("%w: physical interface %s not found on the primary FTD", http.NotFoundError, link.InterfaceName)
+}
@@ -0,0 +1,55 @@
+package fmchapair
+
+type CreateInputBuilder struct {
+	createInput *CreateInput
+}
+
+func NewCreateInputBuilder() *CreateInputBuilder {
+	createInput := &CreateInput{}
+	b := &CreateInputBuilder{createInput: createInput}
+	return b
+}
+
+func (b *CreateInputBuilder) FmcHostname(fmcHostname string) *CreateInputBuilder {
+	b.createInput.FmcHostname = fmcHostname
+	return b
+}
+
+func (b *CreateInputBuilder) FmcDomainUid(fmcDomainUid string) *CreateInputBuilder {
+	b.createInput.FmcDomainUid = fmcDomainUid
+	return b
+}
+
+func (b *CreateInputBuilder) Name(name string) *CreateInputBuilder {
+	b.createInput.Name = name
+	return b
+}
+
+func (b *CreateInputBuilder) PrimaryDeviceUid(primaryDeviceUid string) *CreateInputBuilder {
+	b.createInput.PrimaryDeviceUid = primaryDeviceUid
+	return b
+}
+
+func (b *CreateInputBuilder) SecondaryDeviceUid(secondaryDeviceUid string) *CreateInputBuilder {
+	b.createInput.SecondaryDeviceUid = secondaryDeviceUid
+	return b
+}
+
+func (b *CreateInputBuilder) FailoverLink(failoverLink Link) *CreateInputBuilder {
+	b.createInput.FailoverLink = failoverLink
+	return b
+}
+
+func (b *CreateInputBuilder) StateLink(stateLink *Link) *CreateInputBuilder {
+	b.createInput.StateLink = stateLink
+	return b
+}
+
+func (b *CreateInputBuilder) SharedKey(sharedKey string) *CreateInputBuilder {
+	b.createInput.SharedKey = sharedKey
+	return b
+}
+
+func (b *CreateInputBuilder) Build() CreateInput {
+	return *b.createInput
+}
@@ -0,0 +1,185 @@
+package fmchapair_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	createUrl := url.CreateFmcFtdHaPair(baseUrl, fmcDomainUid)
+	stateLink := fmchapair.NewLink(stateInterfaceName, "state-link", "10.0.1.1", "10.0.1.2", "255.255.255.0")
+
+	inputBuilder := func() *fmchapair.CreateInputBuilder {
+		return fmchapair.NewCreateInputBuilder().
+			FmcHostname(fmcHostname).
+			FmcDomainUid(fmcDomainUid).
+			Name(haPairName).
+			PrimaryDeviceUid(primaryDeviceUid).
+			SecondaryDeviceUid(secondaryDeviceUid).
+			FailoverLink(fmchapair.NewLink(failoverInterfaceName, "failover-link", "10.0.0.1", "10.0.0.2", "255.255.255.0"))
+	}
+
+	var createdBody hapair.HaPair
+	createIsSuccessful := func() {
+		httpmock.RegisterResponder(
+			http.MethodPost,
+			createUrl,
+			func(req *http.Request) (*http.Response, error) {
+				body, err := io.ReadAll(req.Body)
+				if err != nil {
+					return nil, err
+				}
+				if err := json.Unmarshal(body, &createdBody); err != nil {
+					return nil, err
+				}
+				return httpmock.NewJsonResponse(http.StatusAccepted, validAcceptedHaPair)
+			},
+		)
+	}
+	readPhysicalInterfacesIsSuccessful := func() {
+		httpmock.RegisterResponder(
+			http.MethodGet,
+			url.ReadAllFmcPhysicalInterfaces(baseUrl, fmcDomainUid, primaryDeviceUid),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, validPhysicalInterfaces),
+		)
+	}
+
+	testCases := []struct {
+		testName   string
+		input      fmchapair.CreateInput
+		setupFunc  func()
+		assertFunc func(output *fmchapair.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates HA pair using the failover link as state link",
+			input:    inputBuilder().Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				createIsSuccessful()
+				readTaskStatus(successTaskStatus)
+				readAllHaPairs(validHaPair)
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validHaPair, *output)
+				assert.Equal(t, primaryDeviceUid, createdBody.Primary.Id)
+				assert.Equal(t, secondaryDeviceUid, createdBody.Secondary.Id)
+				assert.True(t, createdBody.FtdHaBootstrap.UseSameLinkForFailovers)
+				assert.False(t, createdBody.FtdHaBootstrap.IsEncryptionEnabled)
+				assert.Equal(t, failoverInterfaceUid, createdBody.FtdHaBootstrap.LanFailover.InterfaceObject.Id)
+				assert.Equal(t, failoverInterfaceUid, createdBody.FtdHaBootstrap.StatefulFailover.InterfaceObject.Id)
+			},
+		},
+		{
+			testName: "successfully creates HA pair with separate state link and encryption",
+			input:    inputBuilder().StateLink(&stateLink).SharedKey("unit-test-shared-key").Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				createIsSuccessful()
+				readTaskStatus(successTaskStatus)
+				readAllHaPairs(validHaPair)
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.False(t, createdBody.FtdHaBootstrap.UseSameLinkForFailovers)
+				assert.True(t, createdBody.FtdHaBootstrap.IsEncryptionEnabled)
+				assert.Equal(t, hapair.EncKeyGenerationSchemeCustom, createdBody.FtdHaBootstrap.EncKeyGenerationScheme)
+				assert.Equal(t, "unit-test-shared-key", createdBody.FtdHaBootstrap.SharedKey)
+				assert.Equal(t, stateInterfaceUid, createdBody.FtdHaBootstrap.StatefulFailover.InterfaceObject.Id)
+				assert.Equal(t, "10.0.1.2", createdBody.FtdHaBootstrap.StatefulFailover.StandbyIp)
+			},
+		},
+		{
+			testName: "returns error when failover interface is not found",
+			input:    inputBuilder().FailoverLink(fmchapair.NewLink("GigabitEthernet0/9", "failover-link", "10.0.0.1", "10.0.0.2", "255.255.255.0")).Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				createIsSuccessful()
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[http.MethodPost+" "+createUrl])
+			},
+		},
+		{
+			testName: "returns error when HA pair task failed",
+			input:    inputBuilder().Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				createIsSuccessful()
+				readTaskStatus(failedTaskStatus)
+				readAllHaPairs(validHaPair)
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when HA pair is not found after it is formed",
+			input:    inputBuilder().Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				createIsSuccessful()
+				readTaskStatus(successTaskStatus)
+				readAllHaPairs()
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPost, createUrl, 1, t)
+			},
+		},
+		{
+			testName: "returns error when create error",
+			input:    inputBuilder().Build(),
+			setupFunc: func() {
+				readPhysicalInterfacesIsSuccessful()
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					createUrl,
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmchapair.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+			createdBody = hapair.HaPair{}
+
+			testCase.setupFunc()
+
+			output, err := fmchapair.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package fmchapair_test
+
+import (
+	"net/http"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig/fmctaskstatus"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+	"github.com/jarcoal/httpmock"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname  = "unit-test-fmc-hostname.net"
+	fmcDomainUid = "unit-test-fmc-domain-uid"
+
+	haPairUid          = "unit-test-ha-pair-uid"
+	haPairName         = "unit-test-ha-pair-name"
+	primaryDeviceUid   = "unit-test-primary-device-uid"
+	secondaryDeviceUid = "unit-test-secondary-device-uid"
+
+	failoverInterfaceUid  = "unit-test-failover-interface-uid"
+	failoverInterfaceName = "GigabitEthernet0/2"
+	stateInterfaceUid     = "unit-test-state-interface-uid"
+	stateInterfaceName    = "GigabitEthernet0/3"
+
+	taskUid = "unit-test-task-uid"
+)
+
+var (
+	validPhysicalInterfaces = fmcinterface.PhysicalInterfaces{
+		Items: []fmcinterface.PhysicalInterface{
+			{Id: failoverInterfaceUid, Name: failoverInterfaceName, Type: fmcinterface.PhysicalInterfaceType},
+			{Id: stateInterfaceUid, Name: stateInterfaceName, Type: fmcinterface.PhysicalInterfaceType},
+		},
+		Paging: fmcinterface.NewPaging(2, 0, 1000, 1),
+	}
+
+	validTask = fmcconfig.Task{Id: taskUid, Name: "unit-test-task-name", Type: "TaskStatus"}
+
+	validAcceptedHaPair = hapair.HaPair{
+		Type:      hapair.HaPairType,
+		Name:      haPairName,
+		Primary:   hapair.NewDevice(primaryDeviceUid, "", ""),
+		Secondary: hapair.NewDevice(secondaryDeviceUid, "", ""),
+		Metadata:  &hapair.Metadata{Task: &validTask},
+	}
+
+	validHaPair = hapair.HaPair{
+		Id:        haPairUid,
+		Type:      hapair.HaPairType,
+		Name:      haPairName,
+		Primary:   hapair.NewDevice(primaryDeviceUid, "unit-test-primary-name", "Device"),
+		Secondary: hapair.NewDevice(secondaryDeviceUid, "unit-test-secondary-name", "Device"),
+		Metadata: &hapair.Metadata{
+			PrimaryStatus:   &hapair.DeviceStatus{Device: hapair.NewDevice(primaryDeviceUid, "", ""), CurrentStatus: hapair.StatusActive},
+			SecondaryStatus: &hapair.DeviceStatus{Device: hapair.NewDevice(secondaryDeviceUid, "", ""), CurrentStatus: hapair.StatusStandby},
+		},
+	}
+
+	successTaskStatus = fmcconfig.TaskStatus{Id: taskUid, Status: fmctaskstatus.Success}
+	failedTaskStatus  = fmcconfig.TaskStatus{Id: taskUid, Status: fmctaskstatus.Failed, Message: "unit-test-ha-pair-failed"}
+)
+
+func readTaskStatus(status fmcconfig.TaskStatus) {
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		url.ReadFmcTaskStatus(baseUrl, fmcDomainUid, taskUid),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, status),
+	)
+}
+
+func readAllHaPairs(haPairs ...hapair.HaPair) {
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		url.ReadAllFmcFtdHaPairs(baseUrl, fmcDomainUid),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, hapair.HaPairs{
+			Items:  haPairs,
+			Paging: hapair.NewPaging(len(haPairs), 0, 1000, 1),
+		}),
+	)
+}
@@ -0,0 +1,42 @@
+package fmchapair
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+type ReadInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string
+}
+
+func NewReadInput(fmcHostname, fmcDomainUid, uid string) ReadInput {
+	return ReadInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+	}
+}
+
+type ReadOutput = hapair.HaPair
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading FMC FTD HA pair")
+
+	readUrl := url.FmcFtdHaPairByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
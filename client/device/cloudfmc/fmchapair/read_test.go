@@ -0,0 +1,74 @@
+package fmchapair_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	readUrl := url.FmcFtdHaPairByUid(baseUrl, fmcDomainUid, haPairUid)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmchapair.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads HA pair",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					readUrl,
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validHaPair),
+				)
+			},
+			assertFunc: func(output *fmchapair.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validHaPair, *output)
+				assert.Equal(t, primaryDeviceUid, output.ActiveDeviceUid())
+			},
+		},
+		{
+			testName: "returns error when read error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					readUrl,
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *fmchapair.ReadOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmchapair.Read(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmchapair.NewReadInput(fmcHostname, fmcDomainUid, haPairUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package fmchapair
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+// pageLimit is the maximum number of HA pairs FMC returns in a page.
+const pageLimit = 1000
+
+type ReadAllInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+}
+
+func NewReadAllInput(fmcHostname, fmcDomainUid string) ReadAllInput {
+	return ReadAllInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+	}
+}
+
+type ReadAllOutput = []hapair.HaPair
+
+// ReadAll reads all FTD HA pairs, following the pages of the FMC response.
+func ReadAll(ctx context.Context, client http.Client, readInp ReadAllInput) (*ReadAllOutput, error) {
+
+	client.Logger.Println("reading all FMC FTD HA pairs")
+
+	readUrl := url.ReadAllFmcFtdHaPairs(client.BaseUrl(), readInp.FmcDomainUid)
+
+	outp := ReadAllOutput{}
+	for offset := 0; ; {
+		req := client.NewGet(ctx, readUrl)
+		req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+		req.QueryParams.Add("expanded", "true")
+		req.QueryParams.Add("limit", strconv.Itoa(pageLimit))
+		req.QueryParams.Add("offset", strconv.Itoa(offset))
+
+		var page hapair.HaPairs
+		if err := req.Send(&page); err != nil {
+			return nil, err
+		}
+		outp = append(outp, page.Items...)
+
+		offset += len(page.Items)
+		if len(page.Items) == 0 || offset >= page.Paging.Count {
+			break
+		}
+	}
+
+	return &outp, nil
+}
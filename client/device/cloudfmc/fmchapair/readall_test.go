@@ -0,0 +1,78 @@
+package fmchapair_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmchapair.ReadAllOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads all HA pairs",
+			setupFunc: func() {
+				readAllHaPairs(validHaPair)
+			},
+			assertFunc: func(output *fmchapair.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, fmchapair.ReadAllOutput{validHaPair}, *output)
+			},
+		},
+		{
+			testName: "successfully reads no HA pairs",
+			setupFunc: func() {
+				readAllHaPairs()
+			},
+			assertFunc: func(output *fmchapair.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Empty(t, *output)
+			},
+		},
+		{
+			testName: "returns error when read error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllFmcFtdHaPairs(baseUrl, fmcDomainUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmchapair.ReadAllOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmchapair.ReadAll(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmchapair.NewReadAllInput(fmcHostname, fmcDomainUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
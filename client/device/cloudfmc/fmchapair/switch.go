@@ -0,0 +1,48 @@
+package fmchapair
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+type SwitchActiveInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string
+}
+
+func NewSwitchActiveInput(fmcHostname, fmcDomainUid, uid string) SwitchActiveInput {
+	return SwitchActiveInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+	}
+}
+
+type SwitchActiveOutput = hapair.HaPair
+
+// SwitchActive makes the standby FTD of the HA pair the active one, waits for it to finish, and returns the pair with its new status.
+func SwitchActive(ctx context.Context, client http.Client, switchInp SwitchActiveInput) (*SwitchActiveOutput, error) {
+
+	client.Logger.Println("switching active FTD of FMC FTD HA pair")
+
+	switchUrl := url.FmcFtdHaPairByUid(client.BaseUrl(), switchInp.FmcDomainUid, switchInp.Uid)
+
+	req := client.NewPut(ctx, switchUrl, hapair.NewSwitchAction(switchInp.Uid))
+	req.Header.Add("Fmc-Hostname", switchInp.FmcHostname)
+
+	var switchOutp hapair.HaPair
+	if err := req.Send(&switchOutp); err != nil {
+		return nil, err
+	}
+
+	err := untilTaskDone(ctx, client, switchInp.FmcHostname, switchInp.FmcDomainUid, &switchOutp, "Waiting for FMC FTD HA pair to switch active FTD...")
+	if err != nil {
+		return nil, err
+	}
+
+	return Read(ctx, client, NewReadInput(switchInp.FmcHostname, switchInp.FmcDomainUid, switchInp.Uid))
+}
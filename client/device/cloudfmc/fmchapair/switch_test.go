@@ -0,0 +1,102 @@
+package fmchapair_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSwitchActive(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	haPairUrl := url.FmcFtdHaPairByUid(baseUrl, fmcDomainUid, haPairUid)
+
+	switchedHaPair := validHaPair
+	switchedHaPair.Metadata = &hapair.Metadata{
+		PrimaryStatus:   &hapair.DeviceStatus{Device: validHaPair.Primary, CurrentStatus: hapair.StatusStandby},
+		SecondaryStatus: &hapair.DeviceStatus{Device: validHaPair.Secondary, CurrentStatus: hapair.StatusActive},
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmchapair.SwitchActiveOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully switches active FTD of HA pair",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					haPairUrl,
+					httpmock.NewJsonResponderOrPanic(http.StatusAccepted, validAcceptedHaPair),
+				)
+				readTaskStatus(successTaskStatus)
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					haPairUrl,
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, switchedHaPair),
+				)
+			},
+			assertFunc: func(output *fmchapair.SwitchActiveOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, switchedHaPair, *output)
+				assert.Equal(t, secondaryDeviceUid, output.ActiveDeviceUid())
+			},
+		},
+		{
+			testName: "returns error when accepted without task",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					haPairUrl,
+					httpmock.NewJsonResponderOrPanic(http.StatusAccepted, validHaPair),
+				)
+			},
+			assertFunc: func(output *fmchapair.SwitchActiveOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when switch task failed",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					haPairUrl,
+					httpmock.NewJsonResponderOrPanic(http.StatusAccepted, validAcceptedHaPair),
+				)
+				readTaskStatus(failedTaskStatus)
+			},
+			assertFunc: func(output *fmchapair.SwitchActiveOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmchapair.SwitchActive(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmchapair.NewSwitchActiveInput(fmcHostname, fmcDomainUid, haPairUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package fmchapair
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+)
+
+// untilTaskDone waits for the FMC task in the metadata of the HA pair response to finish.
+func untilTaskDone(ctx context.Context, client http.Client, fmcHostname, fmcDomainUid string, haPair *hapair.HaPair, message string) error {
+	if haPair.Metadata == nil || haPair.Metadata.Task == nil || haPair.Metadata.Task.Id == "" {
+		return fmt.Errorf("HA pair request was accepted without a task to track it")
+	}
+	return retry.Do(
+		ctx,
+		fmcconfig.UntilTaskStatusSuccess(ctx, client, fmcconfig.NewReadTaskStatusInput(fmcDomainUid, haPair.Metadata.Task.Id, fmcHostname)),
+		retry.NewOptionsBuilder().
+			Message(message).
+			Retries(-1).
+			Logger(client.Logger).
+			Timeout(45*time.Minute). // forming a pair usually takes 10-20 minutes, as the configuration is synced to the secondary
+			EarlyExitOnError(true).
+			Delay(10*time.Second).
+			Build(),
+	)
+}
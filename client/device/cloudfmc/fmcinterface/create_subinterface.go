@@ -0,0 +1,46 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+type CreateSubInterfaceInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	SubInterface    fmcinterface.SubInterface
+}
+
+func NewCreateSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid string, subInterface fmcinterface.SubInterface) CreateSubInterfaceInput {
+	return CreateSubInterfaceInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		SubInterface:    subInterface,
+	}
+}
+
+type CreateSubInterfaceOutput = fmcinterface.SubInterface
+
+func CreateSubInterface(ctx context.Context, client http.Client, createInp CreateSubInterfaceInput) (*CreateSubInterfaceOutput, error) {
+
+	client.Logger.Println("creating FMC sub-interface")
+
+	createUrl := url.CreateFmcSubInterface(client.BaseUrl(), createInp.FmcDomainUid, createInp.DeviceRecordUid)
+	createBody := createInp.SubInterface
+	createBody.Type = fmcinterface.SubInterfaceType
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var outp CreateSubInterfaceOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,86 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateSubInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	subInterfaceToCreate := validSubInterface
+	subInterfaceToCreate.Id = ""
+	subInterfaceToCreate.Type = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcinterface.CreateSubInterfaceOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates sub-interface",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcSubInterface(baseUrl, fmcDomainUid, deviceRecordUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[modelInterface.SubInterface](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, modelInterface.SubInterfaceType, body.Type)
+						assert.Equal(t, subInterfaceToCreate.SubIntfId, body.SubIntfId)
+						assert.Empty(t, body.Id)
+						return httpmock.NewJsonResponse(http.StatusCreated, validSubInterface)
+					},
+				)
+			},
+			assertFunc: func(output *fmcinterface.CreateSubInterfaceOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validSubInterface, *output)
+			},
+		},
+		{
+			testName: "returns error when create sub-interface error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcSubInterface(baseUrl, fmcDomainUid, deviceRecordUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.CreateSubInterfaceOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcinterface.CreateSubInterface(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewCreateSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, subInterfaceToCreate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
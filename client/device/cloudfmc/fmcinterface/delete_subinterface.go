@@ -0,0 +1,44 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteSubInterfaceInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+}
+
+func NewDeleteSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string) DeleteSubInterfaceInput {
+	return DeleteSubInterfaceInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+	}
+}
+
+type DeleteSubInterfaceOutput struct {
+}
+
+func DeleteSubInterface(ctx context.Context, client http.Client, deleteInp DeleteSubInterfaceInput) (*DeleteSubInterfaceOutput, error) {
+
+	client.Logger.Println("deleting FMC sub-interface")
+
+	deleteUrl := url.FmcSubInterfaceByUid(client.BaseUrl(), deleteInp.FmcDomainUid, deleteInp.DeviceRecordUid, deleteInp.Uid)
+
+	req := client.NewDelete(ctx, deleteUrl)
+	req.Header.Add("Fmc-Hostname", deleteInp.FmcHostname)
+
+	var outp DeleteSubInterfaceOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
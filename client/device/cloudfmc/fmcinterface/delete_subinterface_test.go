@@ -0,0 +1,73 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteSubInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcinterface.DeleteSubInterfaceOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes sub-interface",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcSubInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, subInterfaceUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						return httpmock.NewJsonResponse(http.StatusOK, validSubInterface)
+					},
+				)
+			},
+			assertFunc: func(output *fmcinterface.DeleteSubInterfaceOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when delete sub-interface error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcSubInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, subInterfaceUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.DeleteSubInterfaceOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcinterface.DeleteSubInterface(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewDeleteSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, subInterfaceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
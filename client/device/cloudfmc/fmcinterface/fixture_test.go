@@ -0,0 +1,53 @@
+package fmcinterface_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname     = "unit-test-fmc-hostname.net"
+	fmcDomainUid    = "unit-test-fmc-domain-uid"
+	deviceRecordUid = "unit-test-device-record-uid"
+	subInterfaceUid = "unit-test-sub-interface-uid"
+	securityZoneUid = "unit-test-security-zone-uid"
+)
+
+var (
+	validInterface1 = fmcinterface.PhysicalInterface{
+		Id:      "unit-test-interface-uid-1",
+		Type:    fmcinterface.PhysicalInterfaceType,
+		Name:    "GigabitEthernet0/0",
+		IfName:  "outside",
+		Enabled: true,
+	}
+	validInterface2 = fmcinterface.PhysicalInterface{
+		Id:   "unit-test-interface-uid-2",
+		Type: fmcinterface.PhysicalInterfaceType,
+		Name: "GigabitEthernet0/1",
+	}
+	validInterfaceWithSettings = fmcinterface.PhysicalInterface{
+		Id:           "unit-test-interface-uid-3",
+		Type:         fmcinterface.PhysicalInterfaceType,
+		Name:         "GigabitEthernet0/2",
+		IfName:       "inside",
+		Enabled:      true,
+		Mode:         fmcinterface.ModeNone,
+		MTU:          1500,
+		SecurityZone: fmcinterface.NewSecurityZoneReference(securityZoneUid),
+		Ipv4:         fmcinterface.NewStaticIpv4("10.10.10.1", "24"),
+	}
+
+	validSubInterface = fmcinterface.SubInterface{
+		Id:           subInterfaceUid,
+		Type:         fmcinterface.SubInterfaceType,
+		Name:         "GigabitEthernet0/1",
+		SubIntfId:    100,
+		VlanId:       100,
+		IfName:       "vlan100",
+		Enabled:      true,
+		SecurityZone: fmcinterface.NewSecurityZoneReference(securityZoneUid),
+		Ipv4:         fmcinterface.NewDhcpIpv4(false),
+	}
+)
@@ -0,0 +1,44 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+type ReadPhysicalInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+}
+
+func NewReadPhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string) ReadPhysicalInput {
+	return ReadPhysicalInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+	}
+}
+
+type ReadPhysicalOutput = fmcinterface.PhysicalInterface
+
+func ReadPhysical(ctx context.Context, client http.Client, readInp ReadPhysicalInput) (*ReadPhysicalOutput, error) {
+
+	client.Logger.Println("reading FMC physical interface")
+
+	readUrl := url.FmcPhysicalInterfaceByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.DeviceRecordUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadPhysicalOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
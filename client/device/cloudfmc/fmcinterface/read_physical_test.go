@@ -0,0 +1,74 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadPhysical(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcinterface.ReadPhysicalOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads physical interface",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcPhysicalInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, validInterfaceWithSettings.Id),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						return httpmock.NewJsonResponse(http.StatusOK, validInterfaceWithSettings)
+					},
+				)
+			},
+			assertFunc: func(output *fmcinterface.ReadPhysicalOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validInterfaceWithSettings, *output)
+			},
+		},
+		{
+			testName: "returns error when read physical interface error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcPhysicalInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, validInterfaceWithSettings.Id),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.ReadPhysicalOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcinterface.ReadPhysical(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewReadPhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid, validInterfaceWithSettings.Id),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
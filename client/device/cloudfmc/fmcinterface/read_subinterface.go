@@ -0,0 +1,44 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+type ReadSubInterfaceInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+}
+
+func NewReadSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string) ReadSubInterfaceInput {
+	return ReadSubInterfaceInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+	}
+}
+
+type ReadSubInterfaceOutput = fmcinterface.SubInterface
+
+func ReadSubInterface(ctx context.Context, client http.Client, readInp ReadSubInterfaceInput) (*ReadSubInterfaceOutput, error) {
+
+	client.Logger.Println("reading FMC sub-interface")
+
+	readUrl := url.FmcSubInterfaceByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.DeviceRecordUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadSubInterfaceOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,59 @@
+package fmcinterface
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+// pageLimit is the maximum number of interfaces FMC returns in a page.
+const pageLimit = 1000
+
+type ReadAllPhysicalInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+}
+
+func NewReadAllPhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid string) ReadAllPhysicalInput {
+	return ReadAllPhysicalInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+	}
+}
+
+type ReadAllPhysicalOutput = []fmcinterface.PhysicalInterface
+
+// ReadAllPhysical reads all physical interfaces of the FMC device record, following the pages of the FMC response.
+func ReadAllPhysical(ctx context.Context, client http.Client, readInp ReadAllPhysicalInput) (*ReadAllPhysicalOutput, error) {
+
+	client.Logger.Println("reading all FMC physical interfaces")
+
+	readUrl := url.ReadAllFmcPhysicalInterfaces(client.BaseUrl(), readInp.FmcDomainUid, readInp.DeviceRecordUid)
+
+	outp := ReadAllPhysicalOutput{}
+	for offset := 0; ; {
+		req := client.NewGet(ctx, readUrl)
+		req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+		req.QueryParams.Add("expanded", "true")
+		req.QueryParams.Add("limit", strconv.Itoa(pageLimit))
+		req.QueryParams.Add("offset", strconv.Itoa(offset))
+
+		var page fmcinterface.PhysicalInterfaces
+		if err := req.Send(&page); err != nil {
+			return nil, err
+		}
+		outp = append(outp, page.Items...)
+
+		offset += len(page.Items)
+		if len(page.Items) == 0 || offset >= page.Paging.Count {
+			break
+		}
+	}
+
+	return &outp, nil
+}
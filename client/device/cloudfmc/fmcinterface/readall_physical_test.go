@@ -0,0 +1,90 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAllPhysical(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	readAllUrl := url.ReadAllFmcPhysicalInterfaces(baseUrl, fmcDomainUid, deviceRecordUid)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcinterface.ReadAllPhysicalOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads all physical interfaces across pages",
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					readAllUrl,
+					"expanded=true&limit=1000&offset=0",
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, modelInterface.PhysicalInterfaces{
+						Items:  []modelInterface.PhysicalInterface{validInterface1},
+						Paging: modelInterface.NewPaging(2, 0, 1000, 2),
+					}),
+				)
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					readAllUrl,
+					"expanded=true&limit=1000&offset=1",
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, modelInterface.PhysicalInterfaces{
+						Items:  []modelInterface.PhysicalInterface{validInterface2},
+						Paging: modelInterface.NewPaging(2, 1, 1000, 2),
+					}),
+				)
+			},
+			assertFunc: func(output *fmcinterface.ReadAllPhysicalOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, fmcinterface.ReadAllPhysicalOutput{validInterface1, validInterface2}, *output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodGet, readAllUrl+"?expanded=true&limit=1000&offset=0", 1, t)
+				internalTesting.AssertEndpointCalledTimes(http.MethodGet, readAllUrl+"?expanded=true&limit=1000&offset=1", 1, t)
+			},
+		},
+		{
+			testName: "returns error when read page error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					readAllUrl,
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.ReadAllPhysicalOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcinterface.ReadAllPhysical(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewReadAllPhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+type UpdatePhysicalInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Interface       fmcinterface.PhysicalInterface
+}
+
+func NewUpdatePhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid string, physicalInterface fmcinterface.PhysicalInterface) UpdatePhysicalInput {
+	return UpdatePhysicalInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Interface:       physicalInterface,
+	}
+}
+
+type UpdatePhysicalOutput = fmcinterface.PhysicalInterface
+
+// UpdatePhysical replaces the settings of the physical interface, physical interfaces cannot be created or deleted as they are the ports of the FTD.
+func UpdatePhysical(ctx context.Context, client http.Client, updateInp UpdatePhysicalInput) (*UpdatePhysicalOutput, error) {
+
+	client.Logger.Println("updating FMC physical interface")
+
+	updateUrl := url.FmcPhysicalInterfaceByUid(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.DeviceRecordUid, updateInp.Interface.Id)
+	updateBody := updateInp.Interface
+	updateBody.Type = fmcinterface.PhysicalInterfaceType
+	if updateBody.Mode == "" {
+		updateBody.Mode = fmcinterface.ModeNone
+	}
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdatePhysicalOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,85 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdatePhysical(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// the type and mode are filled in for the caller
+	interfaceToUpdate := validInterfaceWithSettings
+	interfaceToUpdate.Type = ""
+	interfaceToUpdate.Mode = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcinterface.UpdatePhysicalOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates physical interface",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcPhysicalInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, validInterfaceWithSettings.Id),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[modelInterface.PhysicalInterface](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, validInterfaceWithSettings, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validInterfaceWithSettings)
+					},
+				)
+			},
+			assertFunc: func(output *fmcinterface.UpdatePhysicalOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validInterfaceWithSettings, *output)
+			},
+		},
+		{
+			testName: "returns error when update physical interface error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcPhysicalInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, validInterfaceWithSettings.Id),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.UpdatePhysicalOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcinterface.UpdatePhysical(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewUpdatePhysicalInput(fmcHostname, fmcDomainUid, deviceRecordUid, interfaceToUpdate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package fmcinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+)
+
+type UpdateSubInterfaceInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+	SubInterface    fmcinterface.SubInterface
+}
+
+func NewUpdateSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string, subInterface fmcinterface.SubInterface) UpdateSubInterfaceInput {
+	return UpdateSubInterfaceInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+		SubInterface:    subInterface,
+	}
+}
+
+type UpdateSubInterfaceOutput = fmcinterface.SubInterface
+
+func UpdateSubInterface(ctx context.Context, client http.Client, updateInp UpdateSubInterfaceInput) (*UpdateSubInterfaceOutput, error) {
+
+	client.Logger.Println("updating FMC sub-interface")
+
+	updateUrl := url.FmcSubInterfaceByUid(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.DeviceRecordUid, updateInp.Uid)
+	updateBody := updateInp.SubInterface
+	updateBody.Id = updateInp.Uid
+	updateBody.Type = fmcinterface.SubInterfaceType
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdateSubInterfaceOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
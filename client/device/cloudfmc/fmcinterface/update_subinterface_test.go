@@ -0,0 +1,84 @@
+package fmcinterface_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateSubInterface(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	subInterfaceToUpdate := validSubInterface
+	subInterfaceToUpdate.Id = ""
+	subInterfaceToUpdate.Type = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcinterface.UpdateSubInterfaceOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates sub-interface",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcSubInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, subInterfaceUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[modelInterface.SubInterface](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, validSubInterface, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validSubInterface)
+					},
+				)
+			},
+			assertFunc: func(output *fmcinterface.UpdateSubInterfaceOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validSubInterface, *output)
+			},
+		},
+		{
+			testName: "returns error when update sub-interface error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcSubInterfaceByUid(baseUrl, fmcDomainUid, deviceRecordUid, subInterfaceUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcinterface.UpdateSubInterfaceOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcinterface.UpdateSubInterface(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcinterface.NewUpdateSubInterfaceInput(fmcHostname, fmcDomainUid, deviceRecordUid, subInterfaceUid, subInterfaceToUpdate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package fmcobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+type CreateInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+	Object       fmcobject.Object
+}
+
+func NewCreateInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind, object fmcobject.Object) CreateInput {
+	return CreateInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+		Object:       object,
+	}
+}
+
+type CreateOutput = fmcobject.Object
+
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating FMC object")
+
+	domainUid, err := readDomainUid(ctx, client, createInp.FmcDomainUid)
+	if err != nil {
+		return nil, err
+	}
+
+	createUrl := url.CreateFmcObject(client.BaseUrl(), domainUid, string(createInp.Kind))
+	createBody := createInp.Object
+	createBody.Type = createInp.Kind.Type()
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var outp CreateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,166 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	hostToCreate := fmcobject.Object{
+		Name:        hostName,
+		Description: hostDescription,
+		Value:       hostValue,
+	}
+
+	securityZoneToCreate := fmcobject.Object{
+		Name:          "unit-test-security-zone",
+		InterfaceMode: "ROUTED",
+	}
+	validSecurityZone := securityZoneToCreate
+	validSecurityZone.Id = "unit-test-security-zone-uid"
+	validSecurityZone.Type = fmcobject.SecurityZoneType
+
+	testCases := []struct {
+		testName   string
+		input      fmcobjects.CreateInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcobjects.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates host object",
+			input:    fmcobjects.NewCreateInput(fmcHostname, fmcDomainUid, fmcobject.Hosts, hostToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcObject(baseUrl, fmcDomainUid, string(fmcobject.Hosts)),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[fmcobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						expectedBody := hostToCreate
+						expectedBody.Type = fmcobject.HostType
+						assert.Equal(t, expectedBody, *body)
+						return httpmock.NewJsonResponse(http.StatusCreated, validHost)
+					},
+				)
+			},
+			assertFunc: func(output *fmcobjects.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validHost, *output)
+			},
+		},
+		{
+			testName: "successfully creates security zone",
+			input:    fmcobjects.NewCreateInput(fmcHostname, fmcDomainUid, fmcobject.SecurityZones, securityZoneToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcObject(baseUrl, fmcDomainUid, string(fmcobject.SecurityZones)),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[fmcobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, fmcobject.SecurityZoneType, body.Type)
+						assert.Equal(t, "ROUTED", body.InterfaceMode)
+						return httpmock.NewJsonResponse(http.StatusCreated, validSecurityZone)
+					},
+				)
+			},
+			assertFunc: func(output *fmcobjects.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validSecurityZone, *output)
+			},
+		},
+		{
+			testName: "successfully creates object in the domain of the cdFMC when domain is not given",
+			input:    fmcobjects.NewCreateInput(fmcHostname, "", fmcobject.Hosts, hostToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllDevicesByType(baseUrl),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, []device.ReadOutput{validCloudFmc}),
+				)
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadSpecificDevice(baseUrl, fmcUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validCloudFmcSpecific),
+				)
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcObject(baseUrl, fmcDomainUid, string(fmcobject.Hosts)),
+					httpmock.NewJsonResponderOrPanic(http.StatusCreated, validHost),
+				)
+			},
+			assertFunc: func(output *fmcobjects.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validHost, *output)
+			},
+		},
+		{
+			testName: "returns error when there is no cdFMC to read the domain of",
+			input:    fmcobjects.NewCreateInput(fmcHostname, "", fmcobject.Hosts, hostToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllDevicesByType(baseUrl),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, []device.ReadOutput{}),
+				)
+			},
+			assertFunc: func(output *fmcobjects.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when create object error",
+			input:    fmcobjects.NewCreateInput(fmcHostname, fmcDomainUid, fmcobject.Hosts, hostToCreate),
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcObject(baseUrl, fmcDomainUid, string(fmcobject.Hosts)),
+					httpmock.NewStringResponder(http.StatusUnprocessableEntity, "invalid value"),
+				)
+			},
+			assertFunc: func(output *fmcobjects.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcobjects.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package fmcobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+type DeleteInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+	Uid          string
+}
+
+func NewDeleteInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind, uid string) DeleteInput {
+	return DeleteInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+		Uid:          uid,
+	}
+}
+
+type DeleteOutput struct {
+}
+
+func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*DeleteOutput, error) {
+
+	client.Logger.Println("deleting FMC object")
+
+	domainUid, err := readDomainUid(ctx, client, deleteInp.FmcDomainUid)
+	if err != nil {
+		return nil, err
+	}
+
+	deleteUrl := url.FmcObjectByUid(client.BaseUrl(), domainUid, string(deleteInp.Kind), deleteInp.Uid)
+
+	req := client.NewDelete(ctx, deleteUrl)
+	req.Header.Add("Fmc-Hostname", deleteInp.FmcHostname)
+
+	var outp DeleteOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,71 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcobjects.DeleteOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes host object",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.Hosts), hostUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validHost),
+				)
+			},
+			assertFunc: func(output *fmcobjects.DeleteOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when object is in use",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.Hosts), hostUid),
+					httpmock.NewStringResponder(http.StatusBadRequest, "object is in use"),
+				)
+			},
+			assertFunc: func(output *fmcobjects.DeleteOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcobjects.Delete(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcobjects.NewDeleteInput(fmcHostname, fmcDomainUid, fmcobject.Hosts, hostUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
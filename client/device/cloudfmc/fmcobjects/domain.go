@@ -0,0 +1,22 @@
+package fmcobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+)
+
+// readDomainUid returns the given FMC domain uid, or the uid of the domain of the cdFMC if none is given.
+func readDomainUid(ctx context.Context, client http.Client, fmcDomainUid string) (string, error) {
+	if fmcDomainUid != "" {
+		return fmcDomainUid, nil
+	}
+
+	readDomainUidOutp, err := cloudfmc.ReadDomainUid(ctx, client, cloudfmc.NewReadDomainUidInput(""))
+	if err != nil {
+		return "", err
+	}
+
+	return readDomainUidOutp.DomainUid, nil
+}
@@ -0,0 +1,52 @@
+package fmcobjects_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname  = "unit-test-fmc-hostname.net"
+	fmcDomainUid = "unit-test-fmc-domain-uid"
+	fmcUid       = "unit-test-fmc-uid"
+
+	hostUid         = "unit-test-host-uid"
+	hostName        = "unit-test-host-name"
+	hostDescription = "unit-test-host-description"
+	hostValue       = "10.10.10.10"
+
+	networkGroupUid  = "unit-test-network-group-uid"
+	networkGroupName = "unit-test-network-group-name"
+)
+
+var (
+	validHost = fmcobject.Object{
+		Id:          hostUid,
+		Type:        fmcobject.HostType,
+		Name:        hostName,
+		Description: hostDescription,
+		Value:       hostValue,
+	}
+
+	validNetworkGroup = fmcobject.Object{
+		Id:       networkGroupUid,
+		Type:     fmcobject.NetworkGroupType,
+		Name:     networkGroupName,
+		Objects:  []fmcobject.Reference{fmcobject.NewReference(hostUid, fmcobject.HostType)},
+		Literals: []fmcobject.Literal{fmcobject.NewLiteral(fmcobject.NetworkType, "10.10.0.0/16")},
+	}
+
+	validCloudFmc = device.NewReadOutputBuilder().
+			AsCloudFmc().
+			WithUid(fmcUid).
+			WithLocation(fmcHostname, 443).
+			Build()
+
+	validCloudFmcSpecific = cloudfmc.ReadSpecificOutput{
+		SpecificUid: "unit-test-fmc-specific-uid",
+		DomainUid:   fmcDomainUid,
+	}
+)
@@ -0,0 +1,49 @@
+package fmcobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+type ReadInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+	Uid          string
+}
+
+func NewReadInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind, uid string) ReadInput {
+	return ReadInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+		Uid:          uid,
+	}
+}
+
+type ReadOutput = fmcobject.Object
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading FMC object")
+
+	domainUid, err := readDomainUid(ctx, client, readInp.FmcDomainUid)
+	if err != nil {
+		return nil, err
+	}
+
+	readUrl := url.FmcObjectByUid(client.BaseUrl(), domainUid, string(readInp.Kind), readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
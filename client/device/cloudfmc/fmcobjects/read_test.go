@@ -0,0 +1,73 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRead(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcobjects.ReadOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads network group",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.NetworkGroups), networkGroupUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validNetworkGroup),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validNetworkGroup, *output)
+			},
+		},
+		{
+			testName: "returns not found error when object does not exist",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.NetworkGroups), networkGroupUid),
+					httpmock.NewStringResponder(http.StatusNotFound, "not found"),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcobjects.Read(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcobjects.NewReadInput(fmcHostname, fmcDomainUid, fmcobject.NetworkGroups, networkGroupUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
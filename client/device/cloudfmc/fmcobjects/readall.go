@@ -0,0 +1,64 @@
+package fmcobjects
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+// pageLimit is the maximum number of objects FMC returns in a page.
+const pageLimit = 1000
+
+type ReadAllInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+}
+
+func NewReadAllInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind) ReadAllInput {
+	return ReadAllInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+	}
+}
+
+type ReadAllOutput = []fmcobject.Object
+
+// ReadAll reads all objects of the given kind, following the pages of the FMC response.
+func ReadAll(ctx context.Context, client http.Client, readInp ReadAllInput) (*ReadAllOutput, error) {
+
+	client.Logger.Println("reading all FMC objects")
+
+	domainUid, err := readDomainUid(ctx, client, readInp.FmcDomainUid)
+	if err != nil {
+		return nil, err
+	}
+
+	readUrl := url.ReadAllFmcObjects(client.BaseUrl(), domainUid, string(readInp.Kind))
+
+	outp := ReadAllOutput{}
+	for offset := 0; ; {
+		req := client.NewGet(ctx, readUrl)
+		req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+		req.QueryParams.Add("expanded", "true")
+		req.QueryParams.Add("limit", strconv.Itoa(pageLimit))
+		req.QueryParams.Add("offset", strconv.Itoa(offset))
+
+		var page fmcobject.Objects
+		if err := req.Send(&page); err != nil {
+			return nil, err
+		}
+		outp = append(outp, page.Items...)
+
+		offset += len(page.Items)
+		if len(page.Items) == 0 || offset >= page.Paging.Count {
+			break
+		}
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,112 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	otherHost := validHost
+	otherHost.Id = "unit-test-other-host-uid"
+	otherHost.Name = "unit-test-other-host-name"
+
+	readAllUrl := url.ReadAllFmcObjects(baseUrl, fmcDomainUid, string(fmcobject.Hosts))
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *fmcobjects.ReadAllOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads all objects across pages",
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					readAllUrl,
+					"expanded=true&limit=1000&offset=0",
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcobject.Objects{
+						Items:  []fmcobject.Object{validHost},
+						Paging: fmcobject.NewPaging(2, 0, 1000, 2),
+					}),
+				)
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					readAllUrl,
+					"expanded=true&limit=1000&offset=1",
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcobject.Objects{
+						Items:  []fmcobject.Object{otherHost},
+						Paging: fmcobject.NewPaging(2, 1, 1000, 2),
+					}),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, fmcobjects.ReadAllOutput{validHost, otherHost}, *output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodGet, readAllUrl+"?expanded=true&limit=1000&offset=0", 1, t)
+				internalTesting.AssertEndpointCalledTimes(http.MethodGet, readAllUrl+"?expanded=true&limit=1000&offset=1", 1, t)
+			},
+		},
+		{
+			testName: "successfully reads no objects",
+			setupFunc: func() {
+				httpmock.RegisterResponderWithQuery(
+					http.MethodGet,
+					readAllUrl,
+					"expanded=true&limit=1000&offset=0",
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcobject.Objects{
+						Paging: fmcobject.NewPaging(0, 0, 1000, 0),
+					}),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadAllOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Empty(t, *output)
+			},
+		},
+		{
+			testName: "returns error when read page error",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					readAllUrl,
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadAllOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcobjects.ReadAll(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcobjects.NewReadAllInput(fmcHostname, fmcDomainUid, fmcobject.Hosts),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
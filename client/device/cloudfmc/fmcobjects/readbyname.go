@@ -0,0 +1,45 @@
+package fmcobjects
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+type ReadByNameInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+	Name         string
+}
+
+func NewReadByNameInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind, name string) ReadByNameInput {
+	return ReadByNameInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+		Name:         name,
+	}
+}
+
+type ReadByNameOutput = fmcobject.Object
+
+func ReadByName(ctx context.Context, client http.Client, readInp ReadByNameInput) (*ReadByNameOutput, error) {
+
+	client.Logger.Println("reading FMC object by name")
+
+	objects, err := ReadAll(ctx, client, NewReadAllInput(readInp.FmcHostname, readInp.FmcDomainUid, readInp.Kind))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, object := range *objects {
+		if object.Name == readInp.Name {
+			return &object, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: FMC %s object with name %s not found", http.NotFoundError, readInp.Kind, readInp.Name)
+}
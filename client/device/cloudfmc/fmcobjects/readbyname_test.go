@@ -0,0 +1,82 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadByName(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		name       string
+		setupFunc  func()
+		assertFunc func(output *fmcobjects.ReadByNameOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads object by name",
+			name:     hostName,
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllFmcObjects(baseUrl, fmcDomainUid, string(fmcobject.Hosts)),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcobject.Objects{
+						Items:  []fmcobject.Object{validHost},
+						Paging: fmcobject.NewPaging(1, 0, 1000, 1),
+					}),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadByNameOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validHost, *output)
+			},
+		},
+		{
+			testName: "returns not found error when no object has the name",
+			name:     "unit-test-unknown-name",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadAllFmcObjects(baseUrl, fmcDomainUid, string(fmcobject.Hosts)),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcobject.Objects{
+						Items:  []fmcobject.Object{validHost},
+						Paging: fmcobject.NewPaging(1, 0, 1000, 1),
+					}),
+				)
+			},
+			assertFunc: func(output *fmcobjects.ReadByNameOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcobjects.ReadByName(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcobjects.NewReadByNameInput(fmcHostname, fmcDomainUid, fmcobject.Hosts, testCase.name),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package fmcobjects
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+)
+
+type UpdateInput struct {
+	FmcHostname  string
+	FmcDomainUid string // optional, the domain of the cdFMC is used if empty
+	Kind         fmcobject.Kind
+	Uid          string
+	Object       fmcobject.Object
+}
+
+func NewUpdateInput(fmcHostname, fmcDomainUid string, kind fmcobject.Kind, uid string, object fmcobject.Object) UpdateInput {
+	return UpdateInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Kind:         kind,
+		Uid:          uid,
+		Object:       object,
+	}
+}
+
+type UpdateOutput = fmcobject.Object
+
+func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating FMC object")
+
+	domainUid, err := readDomainUid(ctx, client, updateInp.FmcDomainUid)
+	if err != nil {
+		return nil, err
+	}
+
+	updateUrl := url.FmcObjectByUid(client.BaseUrl(), domainUid, string(updateInp.Kind), updateInp.Uid)
+	updateBody := updateInp.Object
+	updateBody.Id = updateInp.Uid
+	updateBody.Type = updateInp.Kind.Type()
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
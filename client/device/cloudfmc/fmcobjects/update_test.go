@@ -0,0 +1,86 @@
+package fmcobjects_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	updatedHost := validHost
+	updatedHost.Value = "10.10.10.11"
+	hostToUpdate := updatedHost
+	hostToUpdate.Id = ""
+	hostToUpdate.Type = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcobjects.UpdateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates host object",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.Hosts), hostUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[fmcobject.Object](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, updatedHost, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, updatedHost)
+					},
+				)
+			},
+			assertFunc: func(output *fmcobjects.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, updatedHost, *output)
+			},
+		},
+		{
+			testName: "returns error when update object error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcObjectByUid(baseUrl, fmcDomainUid, string(fmcobject.Hosts), hostUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcobjects.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcobjects.Update(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcobjects.NewUpdateInput(fmcHostname, fmcDomainUid, fmcobject.Hosts, hostUid, hostToUpdate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
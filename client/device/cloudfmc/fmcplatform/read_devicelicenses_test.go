@@ -0,0 +1,98 @@
+package fmcplatform_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/devicelicense"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDeviceLicenses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	validItem := fmcplatform.NewReadDeviceLicensesOutputBuilder().
+		Id(uuid).
+		Type(type_).
+		LicenseTypes([]license.Type{license.Essentials, license.IPS}).
+		PerformanceTier(tier.FTDv).
+		Build()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcplatform.ReadDeviceLicensesOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads FMC device licenses",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						return httpmock.NewJsonResponse(http.StatusOK, devicelicense.NewDeviceLicense(devicelicense.NewLinks(links), devicelicense.NewPaging(1, 0, 1, 1), []devicelicense.Item{validItem}))
+					},
+				)
+			},
+			assertFunc: func(output *fmcplatform.ReadDeviceLicensesOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validItem, *output)
+			},
+		},
+		{
+			testName: "returns error when no device licenses are found",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, devicelicense.NewDeviceLicense(devicelicense.NewLinks(links), devicelicense.NewPaging(0, 0, 1, 0), []devicelicense.Item{})),
+				)
+			},
+			assertFunc: func(output *fmcplatform.ReadDeviceLicensesOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when read device licenses error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcplatform.ReadDeviceLicensesOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcplatform.ReadDeviceLicenses(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcplatform.NewReadDeviceLicensesInput(fmcHostname),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package fmcplatform
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcdomain"
+)
+
+type ReadDomainInput struct {
+	FmcHost string
+	Domain  string // the uuid or name of the domain
+}
+
+func NewReadDomainInput(fmcHost string, domain string) ReadDomainInput {
+	return ReadDomainInput{
+		FmcHost: fmcHost,
+		Domain:  domain,
+	}
+}
+
+type ReadDomainOutput = fmcdomain.Item
+
+// ReadDomain resolves a domain of the FMC by its uuid or name. The name can be the full name, e.g. Global/Customer1,
+// or only the last part of it, e.g. Customer1, if that is unique. Use cloudfmc.ReadDomainUid to default to the domain of the cdFMC.
+func ReadDomain(ctx context.Context, client http.Client, readInp ReadDomainInput) (*ReadDomainOutput, error) {
+
+	client.Logger.Println("reading FMC domain")
+
+	if readInp.Domain == "" {
+		return nil, fmt.Errorf("the uuid or name of the FMC domain to read is required")
+	}
+
+	domainInfo, err := ReadFmcDomainInfo(ctx, client, NewReadDomainInfoInput(readInp.FmcHost))
+	if err != nil {
+		return nil, err
+	}
+	if len(domainInfo.Items) == 0 {
+		return nil, fmt.Errorf("%w: fmc domain info not found", http.NotFoundError)
+	}
+	var leafNameMatches []fmcdomain.Item
+	for _, domain := range domainInfo.Items {
+		if domain.Uuid == readInp.Domain || domain.Name == readInp.Domain {
+			return &domain, nil
+		}
+		if domain.Name[strings.LastIndex(domain.Name, "/")+1:] == readInp.Domain {
+			leafNameMatches = append(leafNameMatches, domain)
+		}
+	}
+	if len(leafNameMatches) == 1 {
+		return &leafNameMatches[0], nil
+	}
+	if len(leafNameMatches) > 1 {
+		return nil, fmt.Errorf("more than one FMC domain is named %s, please use the full name or uuid of the domain", readInp.Domain)
+	}
+
+	return nil, fmt.Errorf("%w: FMC domain %s not found", http.NotFoundError, readInp.Domain)
+}
@@ -0,0 +1,139 @@
+package fmcplatform_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcdomain"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDomain(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	globalDomain := fmcdomain.NewItem("unit-test-global-uuid", "Global", "Domain")
+	customerDomain := fmcdomain.NewItem("unit-test-customer-uuid", "Global/Customer1", "Domain")
+	otherCustomerDomain := fmcdomain.NewItem("unit-test-other-customer-uuid", "Global/Region1/Customer1", "Domain")
+	regionDomain := fmcdomain.NewItem("unit-test-region-uuid", "Global/Region1", "Domain")
+
+	readDomainInfoIsSuccessful := func(items ...fmcdomain.Item) {
+		httpmock.RegisterResponder(
+			http.MethodGet,
+			url.ReadFmcDomainInfo(fmcHostname),
+			httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcdomain.NewInfoBuilder().Items(items).Build()),
+		)
+	}
+
+	testCases := []struct {
+		testName   string
+		domain     string
+		setupFunc  func()
+		assertFunc func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "returns error when no domain is given",
+			domain:   "",
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "FMC domain to read is required")
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()["GET "+url.ReadFmcDomainInfo(fmcHostname)])
+			},
+		},
+		{
+			testName: "successfully reads domain by uuid",
+			domain:   customerDomain.Uuid,
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.Equal(t, customerDomain, *output)
+			},
+		},
+		{
+			testName: "successfully reads domain by full name",
+			domain:   "Global/Region1/Customer1",
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain, regionDomain, otherCustomerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.Equal(t, otherCustomerDomain, *output)
+			},
+		},
+		{
+			testName: "successfully reads domain by unique last part of name",
+			domain:   "Region1",
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain, regionDomain, otherCustomerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.Equal(t, regionDomain, *output)
+			},
+		},
+		{
+			testName: "returns error when last part of name is ambiguous",
+			domain:   "Customer1",
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain, regionDomain, otherCustomerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns not found error when domain does not exist",
+			domain:   "Customer2",
+			setupFunc: func() {
+				readDomainInfoIsSuccessful(globalDomain, customerDomain)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when read domain info error",
+			domain:   "",
+			setupFunc: func() {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDomainInfo(fmcHostname),
+					httpmock.NewJsonResponderOrPanic(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcplatform.ReadDomainOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := fmcplatform.ReadDomain(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcplatform.NewReadDomainInput(fmcHostname, testCase.domain),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,129 @@
+package fmcplatform_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/devicelicense"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateDeviceLicenses(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	currentItem := fmcplatform.NewReadDeviceLicensesOutputBuilder().
+		Id(uuid).
+		Type(type_).
+		LicenseTypes([]license.Type{license.Essentials}).
+		PerformanceTier(tier.FTDv).
+		Build()
+	updatedItem := fmcplatform.NewUpdateDeviceLicensesOutputBuilder().
+		Id(uuid).
+		Type(type_).
+		LicenseTypes([]license.Type{license.Essentials, license.IPS}).
+		PerformanceTier(tier.FTDv).
+		Build()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcplatform.UpdateDeviceLicensesOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates FMC device licenses with FMC license terms",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, devicelicense.NewDeviceLicense(devicelicense.NewLinks(links), devicelicense.NewPaging(1, 0, 1, 1), []devicelicense.Item{currentItem})),
+				)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateFmcDeviceLicenses(baseUrl, uuid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						bodyBytes, err := io.ReadAll(r.Body)
+						if err != nil {
+							return nil, err
+						}
+						var body map[string]any
+						if err := json.Unmarshal(bodyBytes, &body); err != nil {
+							return nil, err
+						}
+						assert.Equal(t, uuid, body["id"])
+						assert.Equal(t, []any{string(license.Essentials), string(license.IPS)}, body["licenseTypes"])
+						return httpmock.NewJsonResponse(http.StatusOK, updatedItem)
+					},
+				)
+			},
+			assertFunc: func(output *fmcplatform.UpdateDeviceLicensesOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, updatedItem, *output)
+			},
+		},
+		{
+			testName: "returns error when read device licenses error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcplatform.UpdateDeviceLicensesOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when update device licenses error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDeviceLicenses(baseUrl),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, devicelicense.NewDeviceLicense(devicelicense.NewLinks(links), devicelicense.NewPaging(1, 0, 1, 1), []devicelicense.Item{currentItem})),
+				)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateFmcDeviceLicenses(baseUrl, uuid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcplatform.UpdateDeviceLicensesOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcplatform.UpdateDeviceLicenses(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcplatform.NewUpdateDeviceLicensesInputBuilder().
+					FmcHost(fmcHostname).
+					LicenseTypes([]license.Type{license.Base, license.Threat}).
+					Build(),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package fmcroute
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+)
+
+type CreateInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Route           fmcroute.Ipv4StaticRoute
+}
+
+func NewCreateInput(fmcHostname, fmcDomainUid, deviceRecordUid string, route fmcroute.Ipv4StaticRoute) CreateInput {
+	return CreateInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Route:           route,
+	}
+}
+
+type CreateOutput = fmcroute.Ipv4StaticRoute
+
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+
+	client.Logger.Println("creating FMC IPv4 static route")
+
+	createUrl := url.CreateFmcIpv4StaticRoute(client.BaseUrl(), createInp.FmcDomainUid, createInp.DeviceRecordUid)
+	createBody := createInp.Route
+	createBody.Type = fmcroute.Ipv4StaticRouteType
+
+	req := client.NewPost(ctx, createUrl, createBody)
+	req.Header.Add("Fmc-Hostname", createInp.FmcHostname)
+
+	var outp CreateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,86 @@
+package fmcroute_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcroute"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelRoute "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	routeToCreate := validRoute
+	routeToCreate.Id = ""
+	routeToCreate.Type = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcroute.CreateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully creates static route",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcIpv4StaticRoute(baseUrl, fmcDomainUid, deviceRecordUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[modelRoute.Ipv4StaticRoute](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, modelRoute.Ipv4StaticRouteType, body.Type)
+						assert.Equal(t, routeToCreate.InterfaceName, body.InterfaceName)
+						assert.Empty(t, body.Id)
+						return httpmock.NewJsonResponse(http.StatusCreated, validRoute)
+					},
+				)
+			},
+			assertFunc: func(output *fmcroute.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validRoute, *output)
+			},
+		},
+		{
+			testName: "returns error when create static route error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFmcIpv4StaticRoute(baseUrl, fmcDomainUid, deviceRecordUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcroute.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcroute.Create(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcroute.NewCreateInput(fmcHostname, fmcDomainUid, deviceRecordUid, routeToCreate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package fmcroute
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type DeleteInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+}
+
+func NewDeleteInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string) DeleteInput {
+	return DeleteInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+	}
+}
+
+type DeleteOutput struct {
+}
+
+func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*DeleteOutput, error) {
+
+	client.Logger.Println("deleting FMC IPv4 static route")
+
+	deleteUrl := url.FmcIpv4StaticRouteByUid(client.BaseUrl(), deleteInp.FmcDomainUid, deleteInp.DeviceRecordUid, deleteInp.Uid)
+
+	req := client.NewDelete(ctx, deleteUrl)
+	req.Header.Add("Fmc-Hostname", deleteInp.FmcHostname)
+
+	var outp DeleteOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
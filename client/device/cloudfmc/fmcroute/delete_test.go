@@ -0,0 +1,73 @@
+package fmcroute_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcroute"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDelete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcroute.DeleteOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully deletes static route",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcIpv4StaticRouteByUid(baseUrl, fmcDomainUid, deviceRecordUid, routeUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						return httpmock.NewJsonResponse(http.StatusOK, validRoute)
+					},
+				)
+			},
+			assertFunc: func(output *fmcroute.DeleteOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "returns error when delete static route error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodDelete,
+					url.FmcIpv4StaticRouteByUid(baseUrl, fmcDomainUid, deviceRecordUid, routeUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcroute.DeleteOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcroute.Delete(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcroute.NewDeleteInput(fmcHostname, fmcDomainUid, deviceRecordUid, routeUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package fmcroute_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+)
+
+const (
+	baseUrl = "https://unit-test.net"
+
+	fmcHostname     = "unit-test-fmc-hostname.net"
+	fmcDomainUid    = "unit-test-fmc-domain-uid"
+	deviceRecordUid = "unit-test-device-record-uid"
+	routeUid        = "unit-test-route-uid"
+	networkUid      = "unit-test-network-uid"
+)
+
+var (
+	validRoute = fmcroute.Ipv4StaticRoute{
+		Id:               routeUid,
+		Type:             fmcroute.Ipv4StaticRouteType,
+		InterfaceName:    "outside",
+		SelectedNetworks: []fmcroute.Reference{fmcroute.NewReference(networkUid, fmcroute.NetworkType)},
+		Gateway:          fmcroute.NewLiteralGateway("10.10.10.254"),
+		MetricValue:      1,
+	}
+)
@@ -0,0 +1,44 @@
+package fmcroute
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+)
+
+type ReadInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+}
+
+func NewReadInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string) ReadInput {
+	return ReadInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+	}
+}
+
+type ReadOutput = fmcroute.Ipv4StaticRoute
+
+func Read(ctx context.Context, client http.Client, readInp ReadInput) (*ReadOutput, error) {
+
+	client.Logger.Println("reading FMC IPv4 static route")
+
+	readUrl := url.FmcIpv4StaticRouteByUid(client.BaseUrl(), readInp.FmcDomainUid, readInp.DeviceRecordUid, readInp.Uid)
+
+	req := client.NewGet(ctx, readUrl)
+	req.Header.Add("Fmc-Hostname", readInp.FmcHostname)
+
+	var outp ReadOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
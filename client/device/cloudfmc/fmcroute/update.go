@@ -0,0 +1,49 @@
+package fmcroute
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+)
+
+type UpdateInput struct {
+	FmcHostname     string
+	FmcDomainUid    string
+	DeviceRecordUid string
+	Uid             string
+	Route           fmcroute.Ipv4StaticRoute
+}
+
+func NewUpdateInput(fmcHostname, fmcDomainUid, deviceRecordUid, uid string, route fmcroute.Ipv4StaticRoute) UpdateInput {
+	return UpdateInput{
+		FmcHostname:     fmcHostname,
+		FmcDomainUid:    fmcDomainUid,
+		DeviceRecordUid: deviceRecordUid,
+		Uid:             uid,
+		Route:           route,
+	}
+}
+
+type UpdateOutput = fmcroute.Ipv4StaticRoute
+
+func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating FMC IPv4 static route")
+
+	updateUrl := url.FmcIpv4StaticRouteByUid(client.BaseUrl(), updateInp.FmcDomainUid, updateInp.DeviceRecordUid, updateInp.Uid)
+	updateBody := updateInp.Route
+	updateBody.Id = updateInp.Uid
+	updateBody.Type = fmcroute.Ipv4StaticRouteType
+
+	req := client.NewPut(ctx, updateUrl, updateBody)
+	req.Header.Add("Fmc-Hostname", updateInp.FmcHostname)
+
+	var outp UpdateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,84 @@
+package fmcroute_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcroute"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	modelRoute "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	routeToUpdate := validRoute
+	routeToUpdate.Id = ""
+	routeToUpdate.Type = ""
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *fmcroute.UpdateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully updates static route",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcIpv4StaticRouteByUid(baseUrl, fmcDomainUid, deviceRecordUid, routeUid),
+					func(r *http.Request) (*http.Response, error) {
+						assert.Equal(t, fmcHostname, r.Header.Get("Fmc-Hostname"))
+						body, err := internalHttp.ReadRequestBody[modelRoute.Ipv4StaticRoute](r)
+						if err != nil {
+							return nil, err
+						}
+						assert.Equal(t, validRoute, *body)
+						return httpmock.NewJsonResponse(http.StatusOK, validRoute)
+					},
+				)
+			},
+			assertFunc: func(output *fmcroute.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validRoute, *output)
+			},
+		},
+		{
+			testName: "returns error when update static route error",
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.FmcIpv4StaticRouteByUid(baseUrl, fmcDomainUid, deviceRecordUid, routeUid),
+					httpmock.NewStringResponder(http.StatusInternalServerError, "internal server error"),
+				)
+			},
+			assertFunc: func(output *fmcroute.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := fmcroute.Update(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				fmcroute.NewUpdateInput(fmcHostname, fmcDomainUid, deviceRecordUid, routeUid, routeToUpdate),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package cloudfmc
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+)
+
+type ReadDomainUidInput struct {
+	Domain string // the uuid or name of the domain, the domain of the cdFMC is used if empty
+}
+
+func NewReadDomainUidInput(domain string) ReadDomainUidInput {
+	return ReadDomainUidInput{
+		Domain: domain,
+	}
+}
+
+type ReadDomainUidOutput struct {
+	FmcHost   string
+	DomainUid string
+}
+
+// ReadDomainUid reads the host of the cdFMC and the uuid of one of its domains. It is where the domain defaults to the
+// domain of the cdFMC, so that everything that reads the cdFMC without a domain ends up in the same one.
+func ReadDomainUid(ctx context.Context, client http.Client, readInp ReadDomainUidInput) (*ReadDomainUidOutput, error) {
+
+	client.Logger.Println("reading Cloud FMC domain uid")
+
+	readOutp, err := Read(ctx, client, NewReadInput())
+	if err != nil {
+		return nil, err
+	}
+
+	if readInp.Domain == "" {
+		readSpecificOutp, err := ReadSpecific(ctx, client, NewReadSpecificInput(readOutp.Uid))
+		if err != nil {
+			return nil, err
+		}
+		return &ReadDomainUidOutput{
+			FmcHost:   readOutp.Host,
+			DomainUid: readSpecificOutp.DomainUid,
+		}, nil
+	}
+
+	readDomainOutp, err := fmcplatform.ReadDomain(ctx, client, fmcplatform.NewReadDomainInput(readOutp.Host, readInp.Domain))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReadDomainUidOutput{
+		FmcHost:   readOutp.Host,
+		DomainUid: readDomainOutp.Uuid,
+	}, nil
+}
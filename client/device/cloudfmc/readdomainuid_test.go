@@ -0,0 +1,126 @@
+package cloudfmc_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcdomain"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDomainUidCloudFmc(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	validCloudFmc := device.NewReadOutputBuilder().
+		AsCloudFmc().
+		WithName(deviceName).
+		WithUid(fmcUid).
+		WithLocation("fmc-hostname.unit-test.net", devicePort).
+		Build()
+	validReadSpecificOutput := cloudfmc.ReadSpecificOutput{
+		SpecificUid: specificDeviceUid,
+		DomainUid:   domainUid,
+	}
+	subdomain := fmcdomain.NewItem("unit-test-subdomain-uid", "Global/Customer1", "Domain")
+	// the first domain of the FMC differs from the domain of the cdFMC, so that defaulting to it fails the test
+	validDomainInfo := fmcdomain.NewInfoBuilder().
+		Items([]fmcdomain.Item{fmcdomain.NewItem("unit-test-first-domain-uid", "Global", "Domain"), subdomain}).
+		Build()
+
+	readCloudFmcIsSuccessful := func() {
+		httpmock.RegisterResponder(http.MethodGet, url.ReadAllDevicesByType(baseUrl), httpmock.NewJsonResponderOrPanic(http.StatusOK, []device.ReadOutput{validCloudFmc}))
+	}
+
+	testCases := []struct {
+		testName   string
+		domain     string
+		setupFunc  func()
+		assertFunc func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads the domain of the cdFMC when no domain is given",
+			domain:   "",
+			setupFunc: func() {
+				readCloudFmcIsSuccessful()
+				httpmock.RegisterResponder(http.MethodGet, url.ReadSpecificDevice(baseUrl, fmcUid), httpmock.NewJsonResponderOrPanic(http.StatusOK, validReadSpecificOutput))
+				httpmock.RegisterResponder(http.MethodGet, url.ReadFmcDomainInfo(validCloudFmc.Host), httpmock.NewJsonResponderOrPanic(http.StatusOK, validDomainInfo))
+			},
+			assertFunc: func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.Equal(t, cloudfmc.ReadDomainUidOutput{FmcHost: validCloudFmc.Host, DomainUid: domainUid}, *output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[http.MethodGet+" "+url.ReadFmcDomainInfo(validCloudFmc.Host)])
+			},
+		},
+		{
+			testName: "successfully reads the given domain",
+			domain:   "Customer1",
+			setupFunc: func() {
+				readCloudFmcIsSuccessful()
+				httpmock.RegisterResponder(http.MethodGet, url.ReadFmcDomainInfo(validCloudFmc.Host), httpmock.NewJsonResponderOrPanic(http.StatusOK, validDomainInfo))
+			},
+			assertFunc: func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.Equal(t, cloudfmc.ReadDomainUidOutput{FmcHost: validCloudFmc.Host, DomainUid: subdomain.Uuid}, *output)
+			},
+		},
+		{
+			testName: "error when the given domain is not found",
+			domain:   "Customer2",
+			setupFunc: func() {
+				readCloudFmcIsSuccessful()
+				httpmock.RegisterResponder(http.MethodGet, url.ReadFmcDomainInfo(validCloudFmc.Host), httpmock.NewJsonResponderOrPanic(http.StatusOK, validDomainInfo))
+			},
+			assertFunc: func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+			},
+		},
+		{
+			testName: "error when failed to read Cloud FMC specific device",
+			domain:   "",
+			setupFunc: func() {
+				readCloudFmcIsSuccessful()
+				httpmock.RegisterResponder(http.MethodGet, url.ReadSpecificDevice(baseUrl, fmcUid), httpmock.NewJsonResponderOrPanic(http.StatusInternalServerError, "internal server error"))
+			},
+			assertFunc: func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "error when failed to read Cloud FMC",
+			domain:   "",
+			setupFunc: func() {
+				httpmock.RegisterResponder(http.MethodGet, url.ReadAllDevicesByType(baseUrl), httpmock.NewJsonResponderOrPanic(http.StatusInternalServerError, "internal server error"))
+			},
+			assertFunc: func(output *cloudfmc.ReadDomainUidOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := cloudfmc.ReadDomainUid(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				cloudfmc.NewReadDomainUidInput(testCase.domain),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
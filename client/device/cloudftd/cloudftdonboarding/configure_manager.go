@@ -0,0 +1,202 @@
+package cloudftdonboarding
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	// a freshly deployed virtual FTD takes a while before its SSH server is reachable
+	sshConnectTimeout = 15 * time.Minute
+	sshConnectDelay   = 10 * time.Second
+	sshDialTimeout    = 30 * time.Second
+
+	// this is printed by the FTD CLI when the registration command is accepted
+	configureManagerSuccessMessage = "successfully configured"
+)
+
+type ConfigureManagerInput struct {
+	FtdUid   string
+	Host     string
+	Port     int
+	Username string
+	Password string
+	// HostKey is the public key of the FTD in authorized keys format, it is required unless InsecureSkipHostKeyVerification is set.
+	HostKey string
+	// InsecureSkipHostKeyVerification sends the password to the FTD without verifying its host key, it must be opted in to explicitly.
+	InsecureSkipHostKeyVerification bool
+}
+
+func NewConfigureManagerInput(ftdUid, host string, port int, username, password, hostKey string, insecureSkipHostKeyVerification bool) ConfigureManagerInput {
+	return ConfigureManagerInput{
+		FtdUid:                          ftdUid,
+		Host:                            host,
+		Port:                            port,
+		Username:                        username,
+		Password:                        password,
+		HostKey:                         hostKey,
+		InsecureSkipHostKeyVerification: insecureSkipHostKeyVerification,
+	}
+}
+
+type ConfigureManagerOutput struct {
+	CliOutput string
+}
+
+// ConfigureManager runs the generated `configure manager add` command of the FTD in its CLI over SSH, so that it can be onboarded using Create.
+func ConfigureManager(ctx context.Context, client http.Client, configureInp ConfigureManagerInput) (*ConfigureManagerOutput, error) {
+
+	client.Logger.Println("configuring manager of cloud ftd over ssh")
+
+	ftd, err := cloudftd.ReadByUid(ctx, client, cloudftd.NewReadByUidInput(configureInp.FtdUid))
+	if err != nil {
+		return nil, err
+	}
+	if ftd.Metadata.GeneratedCommand == "" {
+		return nil, fmt.Errorf("FTD %s has no generated command to configure its manager, it may already be onboarded", configureInp.FtdUid)
+	}
+
+	sshConfig, err := newSshClientConfig(configureInp)
+	if err != nil {
+		return nil, err
+	}
+	address := net.JoinHostPort(configureInp.Host, strconv.Itoa(configureInp.Port))
+
+	var sshClient *ssh.Client
+	var lastDialErr error
+	err = retry.Do(
+		ctx,
+		func() (bool, error) {
+			var dialErr error
+			sshClient, dialErr = ssh.Dial("tcp", address, sshConfig)
+			if dialErr != nil {
+				// a wrong password or host key does not get any better by retrying, unlike an FTD that is still booting
+				if !isNetworkError(dialErr) {
+					return false, fmt.Errorf("failed to connect to FTD at %s over ssh, cause=%w", address, dialErr)
+				}
+				lastDialErr = dialErr
+				client.Logger.Printf("ftd at %s is not reachable over ssh yet, cause=%s\n", address, dialErr)
+				return false, nil
+			}
+			return true, nil
+		},
+		retry.NewOptionsBuilder().
+			Message("Waiting for FTD to be reachable over SSH...").
+			Retries(-1).
+			Timeout(sshConnectTimeout).
+			Delay(sshConnectDelay).
+			Logger(client.Logger).
+			EarlyExitOnError(true).
+			Build(),
+	)
+	if err != nil {
+		return nil, errors.Join(err, lastDialErr)
+	}
+	defer sshClient.Close()
+
+	cliOutput, err := runInShell(ctx, sshClient, ftd.Metadata.GeneratedCommand)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.Contains(cliOutput, configureManagerSuccessMessage) {
+		return nil, fmt.Errorf("failed to configure manager of FTD %s, CLI output: %s", configureInp.FtdUid, cliOutput)
+	}
+
+	return &ConfigureManagerOutput{
+		CliOutput: cliOutput,
+	}, nil
+}
+
+// isNetworkError returns whether the SSH connection failed because the FTD could not be reached, or closed the
+// connection before the handshake finished, rather than because it rejected the credentials or host key.
+func isNetworkError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) || errors.Is(err, io.EOF)
+}
+
+func newSshClientConfig(configureInp ConfigureManagerInput) (*ssh.ClientConfig, error) {
+	if configureInp.HostKey == "" && !configureInp.InsecureSkipHostKeyVerification {
+		return nil, fmt.Errorf("the host key of the FTD is required to verify it before sending it the password, or host key verification must be skipped explicitly")
+	}
+
+	hostKeyCallback := ssh.InsecureIgnoreHostKey()
+	if configureInp.HostKey != "" {
+		hostKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(configureInp.HostKey))
+		if err != nil {
+			return nil, fmt.Errorf("invalid FTD host key: %w", err)
+		}
+		hostKeyCallback = ssh.FixedHostKey(hostKey)
+	}
+
+	return &ssh.ClientConfig{
+		User: configureInp.Username,
+		Auth: []ssh.AuthMethod{
+			ssh.Password(configureInp.Password),
+			// the FTD CLI login prompts for the password interactively
+			ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range answers {
+					answers[i] = configureInp.Password
+				}
+				return answers, nil
+			}),
+		},
+		HostKeyCallback: hostKeyCallback,
+		Timeout:         sshDialTimeout,
+	}, nil
+}
+
+// runInShell runs the command in an interactive shell, the FTD CLI does not support executing commands directly.
+func runInShell(ctx context.Context, sshClient *ssh.Client, command string) (string, error) {
+	session, err := sshClient.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	if err := session.RequestPty("vt100", 80, 200, ssh.TerminalModes{ssh.ECHO: 0}); err != nil {
+		return "", err
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return "", err
+	}
+	// the FTD CLI writes everything to stdout when a pty is requested
+	var output bytes.Buffer
+	session.Stdout = &output
+
+	if err := session.Shell(); err != nil {
+		return "", err
+	}
+	if _, err := io.WriteString(stdin, command+"\nexit\n"); err != nil {
+		return "", err
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Wait()
+	}()
+	select {
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case err := <-done:
+		var exitMissingError *ssh.ExitMissingError
+		if err != nil && !errors.As(err, &exitMissingError) {
+			return output.String(), err
+		}
+	}
+
+	return output.String(), nil
+}
@@ -0,0 +1,260 @@
+package cloudftdonboarding_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd/cloudftdonboarding"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	sshUsername = "admin"
+	sshPassword = "unit-test-password"
+
+	generatedCommand = "configure manager add unit-test-host unit-test-reg-key unit-test-nat-id"
+)
+
+// startFtdCliServer starts an SSH server that answers the lines written to its shell like the FTD CLI, it returns the host and port of the server and its host key.
+func startFtdCliServer(t *testing.T, answer func(line string) string) (string, int, string) {
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	assert.Nil(t, err)
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	assert.Nil(t, err)
+
+	serverConfig := &ssh.ServerConfig{
+		PasswordCallback: func(conn ssh.ConnMetadata, password []byte) (*ssh.Permissions, error) {
+			if conn.User() == sshUsername && string(password) == sshPassword {
+				return nil, nil
+			}
+			return nil, assert.AnError
+		},
+	}
+	serverConfig.AddHostKey(signer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.Nil(t, err)
+	t.Cleanup(func() { _ = listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go serveFtdCli(conn, serverConfig, answer)
+		}
+	}()
+
+	host, port, err := net.SplitHostPort(listener.Addr().String())
+	assert.Nil(t, err)
+	portNumber, err := strconv.Atoi(port)
+	assert.Nil(t, err)
+
+	return host, portNumber, string(ssh.MarshalAuthorizedKey(signer.PublicKey()))
+}
+
+func serveFtdCli(conn net.Conn, serverConfig *ssh.ServerConfig, answer func(line string) string) {
+	serverConn, channels, requests, err := ssh.NewServerConn(conn, serverConfig)
+	if err != nil {
+		return
+	}
+	defer serverConn.Close()
+	go ssh.DiscardRequests(requests)
+
+	for newChannel := range channels {
+		channel, channelRequests, err := newChannel.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			for req := range channelRequests {
+				_ = req.Reply(req.Type == "pty-req" || req.Type == "shell", nil)
+			}
+		}()
+
+		scanner := bufio.NewScanner(channel)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "exit" {
+				break
+			}
+			_, _ = channel.Write([]byte(answer(line) + "\n> "))
+		}
+		_, _ = channel.SendRequest("exit-status", false, ssh.Marshal(struct{ Status uint32 }{0}))
+		_ = channel.Close()
+	}
+}
+
+func TestCloudFtdOnboardingConfigureManager(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+
+	ftdReadOutput := testModel.FtdReadOutput()
+	ftdReadOutput.Metadata.GeneratedCommand = generatedCommand
+
+	ftdReadOutputWithoutCommand := testModel.FtdReadOutput()
+	ftdReadOutputWithoutCommand.Metadata.GeneratedCommand = ""
+
+	ftdCli := func(line string) string {
+		if line == generatedCommand {
+			return "Manager unit-test-host successfully configured."
+		}
+		return "Syntax error: Illegal parameter"
+	}
+	ftdCliAlreadyConfigured := func(line string) string {
+		return "Error: Manager is already configured"
+	}
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func() cloudftdonboarding.ConfigureManagerInput
+		assertFunc func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully configures manager over ssh",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, _ := startFtdCliServer(t, ftdCli)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, "", true)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Contains(t, output.CliOutput, "successfully configured")
+			},
+		},
+		{
+			testName: "successfully configures manager over ssh with verified host key",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, hostKey := startFtdCliServer(t, ftdCli)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, hostKey, false)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+			},
+		},
+		{
+			testName: "fails to configure manager if FTD CLI rejects the command",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, _ := startFtdCliServer(t, ftdCliAlreadyConfigured)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, "", true)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "Manager is already configured")
+			},
+		},
+		{
+			testName: "fails to configure manager if host key is invalid",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, _ := startFtdCliServer(t, ftdCli)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, "not-a-host-key", false)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "invalid FTD host key")
+			},
+		},
+		{
+			testName: "fails to configure manager without retrying if password is wrong",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, hostKey := startFtdCliServer(t, ftdCli)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, "wrong-password", hostKey, false)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "unable to authenticate")
+			},
+		},
+		{
+			testName: "fails to configure manager without retrying if host key does not match",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, _ := startFtdCliServer(t, ftdCli)
+				otherPublicKey, _, err := ed25519.GenerateKey(rand.Reader)
+				assert.Nil(t, err)
+				otherHostKey, err := ssh.NewPublicKey(otherPublicKey)
+				assert.Nil(t, err)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, string(ssh.MarshalAuthorizedKey(otherHostKey)), false)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "host key mismatch")
+			},
+		},
+		{
+			testName: "fails to configure manager if host key is not set and verification is not skipped",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+				host, port, _ := startFtdCliServer(t, ftdCli)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, host, port, sshUsername, sshPassword, "", false)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "host key of the FTD is required")
+			},
+		},
+		{
+			testName: "fails to configure manager if FTD has no generated command",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutputWithoutCommand.Uid), ftdReadOutputWithoutCommand)
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutputWithoutCommand.Uid, "127.0.0.1", 22, sshUsername, sshPassword, "", true)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "no generated command")
+			},
+		},
+		{
+			testName: "fails to configure manager if FTD is not found",
+			setupFunc: func() cloudftdonboarding.ConfigureManagerInput {
+				internalTesting.MockGetError(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), "not found")
+				return cloudftdonboarding.NewConfigureManagerInput(ftdReadOutput.Uid, "127.0.0.1", 22, sshUsername, sshPassword, "", true)
+			},
+			assertFunc: func(output *cloudftdonboarding.ConfigureManagerOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			input := testCase.setupFunc()
+
+			output, err := cloudftdonboarding.ConfigureManager(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
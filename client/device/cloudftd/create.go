@@ -8,7 +8,6 @@ import (
 	"fmt"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
-	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/cdo"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
@@ -27,6 +26,7 @@ type CreateInput struct {
 	Virtual          bool
 	Licenses         *[]license.Type
 	Labels           publicapilabels.Type
+	FmcDomain        string // optional, the uuid or name of the FMC domain to register the FTD in, the default domain is used if empty
 }
 
 type CreateOutput struct {
@@ -58,6 +58,7 @@ func NewCreateInput(
 	virtual bool,
 	licenses *[]license.Type,
 	labels publicapilabels.Type,
+	fmcDomain string,
 ) CreateInput {
 	return CreateInput{
 		Name:             name,
@@ -66,6 +67,7 @@ func NewCreateInput(
 		Virtual:          virtual,
 		Licenses:         licenses,
 		Labels:           labels,
+		FmcDomain:        fmcDomain,
 	}
 }
 
@@ -77,6 +79,7 @@ type createRequestBody struct {
 	Virtual            bool                 `json:"virtual"`
 	Licenses           *[]license.Type      `json:"licenses"`
 	Labels             publicapilabels.Type `json:"labels"`
+	FmcDomainUid       string               `json:"fmcDomainUid,omitempty"`
 }
 
 func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
@@ -85,10 +88,14 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 
 	createUrl := url.CreateFtd(client.BaseUrl())
 
-	selectedPolicy, err := readPolicyUidFromPolicyName(ctx, client, createInp.AccessPolicyName)
+	fmcDomainUid, selectedPolicy, err := readPolicyUidFromPolicyName(ctx, client, createInp.FmcDomain, createInp.AccessPolicyName)
 	if err != nil {
 		return nil, err
 	}
+	if createInp.FmcDomain == "" {
+		// leave it to CDO to pick the default domain
+		fmcDomainUid = ""
+	}
 
 	transaction, err := publicapi.TriggerTransaction(
 		ctx,
@@ -102,6 +109,7 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 			Virtual:            createInp.Virtual,
 			Labels:             createInp.Labels,
 			Licenses:           createInp.Licenses,
+			FmcDomainUid:       fmcDomainUid,
 		},
 	)
 	if err != nil {
@@ -128,39 +136,32 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 	return FromDeviceReadOutput(cloudFtdReadOutput), nil
 }
 
-func readPolicyUidFromPolicyName(ctx context.Context, client http.Client, accessPolicyName string) (accesspolicies.Item, error) {
-	// 1. read Cloud FMC
-	fmcRes, err := cloudfmc.Read(ctx, client, cloudfmc.NewReadInput())
+// readPolicyUidFromPolicyName finds the access policy in the FMC domain, it returns the uuid of the domain together with the policy.
+func readPolicyUidFromPolicyName(ctx context.Context, client http.Client, fmcDomain string, accessPolicyName string) (string, accesspolicies.Item, error) {
+	// 1. read Cloud FMC and the uid of the FMC domain
+	readDomainUidRes, err := cloudfmc.ReadDomainUid(ctx, client, cloudfmc.NewReadDomainUidInput(fmcDomain))
 	if err != nil {
-		return accesspolicies.Item{}, err
-	}
-
-	// 2. get FMC domain uid by reading Cloud FMC domain info
-	readFmcDomainRes, err := fmcplatform.ReadFmcDomainInfo(ctx, client, fmcplatform.NewReadDomainInfoInput(fmcRes.Host))
-	if err != nil {
-		return accesspolicies.Item{}, err
-	}
-	if len(readFmcDomainRes.Items) == 0 {
-		return accesspolicies.Item{}, fmt.Errorf("%w: fmc domain info not found", http.NotFoundError)
+		return "", accesspolicies.Item{}, err
 	}
 
+	// 2. read the access policies in the FMC domain
 	accessPoliciesRes, err := cloudfmc.ReadAccessPolicies(
 		ctx,
 		client,
-		cloudfmc.NewReadAccessPoliciesInput(fmcRes.Host, readFmcDomainRes.Items[0].Uuid, 1000), // 1000 is what CDO UI uses
+		cloudfmc.NewReadAccessPoliciesInput(readDomainUidRes.FmcHost, readDomainUidRes.DomainUid, 1000), // 1000 is what CDO UI uses
 	)
 	if err != nil {
-		return accesspolicies.Item{}, err
+		return "", accesspolicies.Item{}, err
 	}
 
 	selectedPolicy, ok := accessPoliciesRes.Find(accessPolicyName)
 	if !ok {
-		return accesspolicies.Item{}, fmt.Errorf(
+		return "", accesspolicies.Item{}, fmt.Errorf(
 			`access policy: "%s" not found, available policies: %s. In rare cases where you have more than 1000 access policies, please raise an issue at: %s`,
 			accessPolicyName,
 			accessPoliciesRes.Items,
 			cdo.TerraformProviderCDOIssuesUrl,
 		)
 	}
-	return selectedPolicy, nil
+	return readDomainUidRes.DomainUid, selectedPolicy, nil
 }
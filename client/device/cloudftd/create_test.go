@@ -2,6 +2,7 @@ package cloudftd_test
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"testing"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
 	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcdomain"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,11 +27,19 @@ func TestCreateCloudFtd(t *testing.T) {
 	ftdCreateInput := testModel.FtdCreateInput()
 	ftdReadOutput := testModel.FtdReadOutput()
 	fmcReadOutput := testModel.FmcReadOutput()
+	fmcReadSpecificOutput := testModel.FmcReadSpecificOutput()
 	fmcDomainInfo := testModel.FmcDomainInfo()
 	accessPolicy := testModel.ReadAccessPolicies()
 	doneTransaction := testModel.CreateDoneTransaction(ftdReadOutput.Uid, transactiontype.CREATE_FTD)
 	errorTransaction := testModel.CreateErrorTransaction(ftdReadOutput.Uid, transactiontype.CREATE_FTD)
 
+	subdomainUid := "unit-test-subdomain-uid"
+	subdomainFtdCreateInput := testModel.FtdCreateInput()
+	subdomainFtdCreateInput.FmcDomain = "Customer1"
+	subdomainFmcDomainInfo := testModel.FmcDomainInfo()
+	subdomainFmcDomainInfo.Items = append(subdomainFmcDomainInfo.Items, fmcdomain.NewItem(subdomainUid, "Global/Customer1", "Domain"))
+	var createBody map[string]any
+
 	testCases := []struct {
 		testName   string
 		input      cloudftd.CreateInput
@@ -41,7 +51,7 @@ func TestCreateCloudFtd(t *testing.T) {
 			input:    ftdCreateInput,
 			setupFunc: func(createInp cloudftd.CreateInput) {
 				internalTesting.MockGetOk(url.ReadAllDevicesByType(testModel.BaseUrl), []cloudfmc.ReadOutput{fmcReadOutput})
-				internalTesting.MockGetOk(url.ReadFmcDomainInfo(testModel.FmcHost), fmcDomainInfo)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, fmcReadOutput.Uid), fmcReadSpecificOutput)
 				internalTesting.MockGetOk(url.ReadAccessPolicies(testModel.BaseUrl, testModel.FmcDomainUuid.String()), accessPolicy)
 				internalTesting.MockPostAccepted(url.CreateFtd(testModel.BaseUrl), doneTransaction)
 				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
@@ -52,12 +62,51 @@ func TestCreateCloudFtd(t *testing.T) {
 				assert.Equal(t, output, cloudftd.FromDeviceReadOutput(&ftdReadOutput))
 			},
 		},
+		{
+			testName: "successful ftd creation in FMC subdomain",
+			input:    subdomainFtdCreateInput,
+			setupFunc: func(createInp cloudftd.CreateInput) {
+				internalTesting.MockGetOk(url.ReadAllDevicesByType(testModel.BaseUrl), []cloudfmc.ReadOutput{fmcReadOutput})
+				internalTesting.MockGetOk(url.ReadFmcDomainInfo(testModel.FmcHost), subdomainFmcDomainInfo)
+				internalTesting.MockGetOk(url.ReadAccessPolicies(testModel.BaseUrl, subdomainUid), accessPolicy)
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.CreateFtd(testModel.BaseUrl),
+					func(req *http.Request) (*http.Response, error) {
+						if err := json.NewDecoder(req.Body).Decode(&createBody); err != nil {
+							return nil, err
+						}
+						return httpmock.NewJsonResponse(http.StatusAccepted, doneTransaction)
+					},
+				)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
+			},
+			assertFunc: func(output *cloudftd.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, subdomainUid, createBody["fmcDomainUid"])
+			},
+		},
+		{
+			testName: "fails ftd creation when FMC domain is not found",
+			input:    subdomainFtdCreateInput,
+			setupFunc: func(createInp cloudftd.CreateInput) {
+				internalTesting.MockGetOk(url.ReadAllDevicesByType(testModel.BaseUrl), []cloudfmc.ReadOutput{fmcReadOutput})
+				internalTesting.MockGetOk(url.ReadFmcDomainInfo(testModel.FmcHost), fmcDomainInfo)
+				internalTesting.MockPostAccepted(url.CreateFtd(testModel.BaseUrl), doneTransaction)
+			},
+			assertFunc: func(output *cloudftd.CreateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[http.MethodPost+" "+url.CreateFtd(testModel.BaseUrl)])
+			},
+		},
 		{
 			testName: "fails ftd creation when transaction fails",
 			input:    ftdCreateInput,
 			setupFunc: func(createInp cloudftd.CreateInput) {
 				internalTesting.MockGetOk(url.ReadAllDevicesByType(testModel.BaseUrl), []cloudfmc.ReadOutput{fmcReadOutput})
-				internalTesting.MockGetOk(url.ReadFmcDomainInfo(testModel.FmcHost), fmcDomainInfo)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(testModel.BaseUrl, fmcReadOutput.Uid), fmcReadSpecificOutput)
 				internalTesting.MockGetOk(url.ReadAccessPolicies(testModel.BaseUrl, testModel.FmcDomainUuid.String()), accessPolicy)
 				internalTesting.MockPostError(url.CreateFtd(testModel.BaseUrl), errorTransaction)
 				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, ftdReadOutput.Uid), ftdReadOutput)
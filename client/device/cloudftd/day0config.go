@@ -0,0 +1,124 @@
+package cloudftd
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd"
+)
+
+const (
+	day0FirewallModeRouted = "routed"
+	day0ManageLocallyNo    = "No"
+	day0EulaAccept         = "accept"
+)
+
+// Day0Platform is the platform the FTDv is deployed on, the day-0 configuration differs between them.
+type Day0Platform string
+
+const (
+	// Day0PlatformAws and Day0PlatformAzure take the day-0 configuration as the user data / custom data of the instance.
+	Day0PlatformAws   Day0Platform = "AWS"
+	Day0PlatformAzure Day0Platform = "AZURE"
+	// Day0PlatformKvm takes the day-0 configuration as the day0-config file of a disk attached to the FTDv, which must accept the EULA.
+	Day0PlatformKvm Day0Platform = "KVM"
+)
+
+var Day0Platforms = []Day0Platform{Day0PlatformAws, Day0PlatformAzure, Day0PlatformKvm}
+
+// the cdFMC can only manage FTDs from this version onward
+var day0MinimumVersion, _ = ftd.NewVersion("7.0.3")
+
+// Day0ConfigUnavailableError is returned when the FTD has no registration key or NAT ID to build the day-0 configuration from, which CDO clears once the FTD is onboarded.
+var Day0ConfigUnavailableError = errors.New("FTD has no registration key, NAT ID or cloud manager domain, it may already be onboarded")
+
+// Day0Config is the day-0 configuration of an FTDv that registers it with the cdFMC on first boot, it is passed to the FTDv as user data when it is deployed.
+type Day0Config struct {
+	Eula          string `json:"EULA,omitempty"`
+	Hostname      string `json:"Hostname"`
+	AdminPassword string `json:"AdminPassword"`
+	FirewallMode  string `json:"FirewallMode"`
+	ManageLocally string `json:"ManageLocally"`
+	FmcIp         string `json:"FmcIp"`
+	FmcRegKey     string `json:"FmcRegKey"`
+	FmcNatId      string `json:"FmcNatId"`
+}
+
+type Day0ConfigInput struct {
+	Metadata      Metadata
+	Hostname      string
+	AdminPassword string
+	// Version is the software version of the FTDv, it is not checked if it is empty. The day-0 configuration has the
+	// same keys in all the versions the cdFMC can manage, so the version is only checked to be one of them.
+	Version string
+	// Platform is the platform the FTDv is deployed on, AWS if it is empty.
+	Platform Day0Platform
+}
+
+func NewDay0ConfigInput(metadata Metadata, hostname, adminPassword, version string, platform Day0Platform) Day0ConfigInput {
+	return Day0ConfigInput{
+		Metadata:      metadata,
+		Hostname:      hostname,
+		AdminPassword: adminPassword,
+		Version:       version,
+		Platform:      platform,
+	}
+}
+
+// NewDay0Config builds the day-0 configuration of an FTDv from the registration key, NAT ID and cloud manager domain in the metadata of the FTD.
+func NewDay0Config(inp Day0ConfigInput) (*Day0Config, error) {
+	if inp.Metadata.RegKey == "" || inp.Metadata.NatID == "" || inp.Metadata.CloudManagerDomain == "" {
+		return nil, Day0ConfigUnavailableError
+	}
+
+	if inp.Version != "" {
+		version, err := ftd.NewVersion(inp.Version)
+		if err != nil {
+			return nil, err
+		}
+		if version.LessThan(day0MinimumVersion) {
+			return nil, fmt.Errorf("FTD version %s is not supported, the cdFMC manages FTD version %s and later", inp.Version, day0MinimumVersion)
+		}
+	}
+
+	var eula string
+	switch inp.Platform {
+	case "", Day0PlatformAws, Day0PlatformAzure:
+		// the EULA is accepted when the instance is launched from the marketplace image
+	case Day0PlatformKvm:
+		eula = day0EulaAccept
+	default:
+		return nil, fmt.Errorf("FTDv platform %s is not supported, supported platforms are %v", inp.Platform, Day0Platforms)
+	}
+
+	return &Day0Config{
+		Eula:          eula,
+		Hostname:      inp.Hostname,
+		AdminPassword: inp.AdminPassword,
+		FirewallMode:  day0FirewallModeRouted,
+		ManageLocally: day0ManageLocallyNo,
+		FmcIp:         inp.Metadata.CloudManagerDomain,
+		FmcRegKey:     inp.Metadata.RegKey,
+		FmcNatId:      inp.Metadata.NatID,
+	}, nil
+}
+
+// Json returns the day-0 configuration as the JSON document read by the FTDv.
+func (c Day0Config) Json() (string, error) {
+	bytes, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// UserData returns the day-0 configuration base64 encoded, as the user data of cloud providers is usually given.
+func (c Day0Config) UserData() (string, error) {
+	jsonString, err := c.Json()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString([]byte(jsonString)), nil
+}
@@ -0,0 +1,147 @@
+package cloudftd_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	day0Hostname      = "unit-test-day0-hostname"
+	day0AdminPassword = "unit-test-day0-admin-password"
+)
+
+func TestNewDay0Config(t *testing.T) {
+
+	validMetadata := cloudftd.NewMetadataBuilder().
+		CloudManagerDomain(ftdCloudManagerDomain).
+		NatID(ftdNatID).
+		RegKey(ftdRegKey).
+		Build()
+
+	expectedCloudConfig := map[string]string{
+		"Hostname":      day0Hostname,
+		"AdminPassword": day0AdminPassword,
+		"FirewallMode":  "routed",
+		"ManageLocally": "No",
+		"FmcIp":         ftdCloudManagerDomain,
+		"FmcRegKey":     ftdRegKey,
+		"FmcNatId":      ftdNatID,
+	}
+	expectedKvmConfig := map[string]string{
+		"EULA":          "accept",
+		"Hostname":      day0Hostname,
+		"AdminPassword": day0AdminPassword,
+		"FirewallMode":  "routed",
+		"ManageLocally": "No",
+		"FmcIp":         ftdCloudManagerDomain,
+		"FmcRegKey":     ftdRegKey,
+		"FmcNatId":      ftdNatID,
+	}
+
+	assertDay0Config := func(expected map[string]string) func(output *cloudftd.Day0Config, err error, t *testing.T) {
+		return func(output *cloudftd.Day0Config, err error, t *testing.T) {
+			assert.Nil(t, err)
+			assert.NotNil(t, output)
+
+			jsonString, err := output.Json()
+			assert.Nil(t, err)
+			var actual map[string]string
+			assert.Nil(t, json.Unmarshal([]byte(jsonString), &actual))
+			assert.Equal(t, expected, actual)
+
+			userData, err := output.UserData()
+			assert.Nil(t, err)
+			decoded, err := base64.StdEncoding.DecodeString(userData)
+			assert.Nil(t, err)
+			assert.Equal(t, jsonString, string(decoded))
+		}
+	}
+	assertUnsupportedVersion := func(output *cloudftd.Day0Config, err error, t *testing.T) {
+		assert.Nil(t, output)
+		assert.ErrorContains(t, err, "not supported")
+	}
+
+	testCases := []struct {
+		testName   string
+		input      cloudftd.Day0ConfigInput
+		assertFunc func(output *cloudftd.Day0Config, err error, t *testing.T)
+	}{
+		{
+			testName:   "successfully builds day-0 config without version and platform",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "", ""),
+			assertFunc: assertDay0Config(expectedCloudConfig),
+		},
+		{
+			testName:   "successfully builds day-0 config for the minimum version on AWS",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.0.3", cloudftd.Day0PlatformAws),
+			assertFunc: assertDay0Config(expectedCloudConfig),
+		},
+		{
+			testName:   "successfully builds day-0 config for version 7.2 on Azure",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.2.5-208", cloudftd.Day0PlatformAzure),
+			assertFunc: assertDay0Config(expectedCloudConfig),
+		},
+		{
+			testName:   "successfully builds day-0 config for version 7.4 on AWS",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.4.1-172", cloudftd.Day0PlatformAws),
+			assertFunc: assertDay0Config(expectedCloudConfig),
+		},
+		{
+			testName:   "successfully builds day-0 config accepting the EULA for version 7.0 on KVM",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.0.6", cloudftd.Day0PlatformKvm),
+			assertFunc: assertDay0Config(expectedKvmConfig),
+		},
+		{
+			testName:   "successfully builds day-0 config accepting the EULA for version 7.4 on KVM",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.4.1", cloudftd.Day0PlatformKvm),
+			assertFunc: assertDay0Config(expectedKvmConfig),
+		},
+		{
+			testName:   "fails to build day-0 config for the version before the minimum version",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.0.2", cloudftd.Day0PlatformAws),
+			assertFunc: assertUnsupportedVersion,
+		},
+		{
+			testName:   "fails to build day-0 config for unsupported version",
+			input:      cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "6.7.0", cloudftd.Day0PlatformKvm),
+			assertFunc: assertUnsupportedVersion,
+		},
+		{
+			testName: "fails to build day-0 config for invalid version",
+			input:    cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "not-a-version", ""),
+			assertFunc: func(output *cloudftd.Day0Config, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "fails to build day-0 config for unsupported platform",
+			input:    cloudftd.NewDay0ConfigInput(validMetadata, day0Hostname, day0AdminPassword, "7.4.1", "VMWARE"),
+			assertFunc: func(output *cloudftd.Day0Config, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.ErrorContains(t, err, "platform VMWARE is not supported")
+			},
+		},
+		{
+			testName: "fails to build day-0 config if FTD has no registration key",
+			input:    cloudftd.NewDay0ConfigInput(cloudftd.NewMetadataBuilder().CloudManagerDomain(ftdCloudManagerDomain).Build(), day0Hostname, day0AdminPassword, "", ""),
+			assertFunc: func(output *cloudftd.Day0Config, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.ErrorIs(t, err, cloudftd.Day0ConfigUnavailableError)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			output, err := cloudftd.NewDay0Config(testCase.input)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -3,26 +3,25 @@ package cloudftd
 import (
 	"context"
 	"errors"
-	"fmt"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcappliance"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
-	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/statemachine"
-	"strings"
 	"time"
 )
 
 type DeleteInput struct {
-	Uid string
+	Uid       string
+	FmcDomain string // optional, the uuid or name of the FMC domain the FTD is registered in, the default domain is used if empty
 }
 
-func NewDeleteInput(uid string) DeleteInput {
+func NewDeleteInput(uid string, fmcDomain string) DeleteInput {
 	return DeleteInput{
-		Uid: uid,
+		Uid:       uid,
+		FmcDomain: fmcDomain,
 	}
 }
 
@@ -44,15 +43,12 @@ func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*De
 
 	// 2.5 wait for any FTD deployment to finish, otherwise backend fmceDeleteFtdcStateMachine will fail
 	// in order to check for FTD deployment status, we need to read FMC host and domainUid
-	// 2.5.2 read fmc domain info in its specific/appliance device, fmcDomainUid is in the domain info
-	readFmcDomainRes, err := fmcplatform.ReadFmcDomainInfo(ctx, client, fmcplatform.NewReadDomainInfoInput(fmcReadRes.Host))
+	// 2.5.2 read the uid of the fmc domain the FTD is registered in
+	readDomainUidRes, err := cloudfmc.ReadDomainUid(ctx, client, cloudfmc.NewReadDomainUidInput(deleteInp.FmcDomain))
 	if err != nil {
 		return nil, err
 	}
-	if len(readFmcDomainRes.Items) == 0 {
-		return nil, fmt.Errorf("%w: failed to read fmc domain uid, fmc domain info not found", http.NotFoundError)
-	}
-	fmcDomainUid := readFmcDomainRes.Items[0].Uuid
+	fmcDomainUid := readDomainUidRes.DomainUid
 
 	// now we find the FTD device record, to do that we need to find all device records
 	// then we find the FTD device record with the same name as the CDO FTD
@@ -61,31 +57,12 @@ func Delete(ctx context.Context, client http.Client, deleteInp DeleteInput) (*De
 	if err != nil {
 		return nil, err
 	}
-	// 2.5.2 read all device records
-	allDeviceRecords, err := fmcconfig.ReadAllDeviceRecords(ctx, client, fmcconfig.NewReadAllDeviceRecordsInput(fmcDomainUid, fmcReadRes.Host))
+	// 2.5.2 find the FTD device record among all device records
+	client.Logger.Printf("looking for existing FTD with id=%s and name=%s\n", deleteInp.Uid, fmcReadRes.Name)
+	ftdRecordId, err := readFtdDeviceRecordUid(ctx, client, fmcDomainUid, fmcReadRes.Host, readFtdOutp.Name)
 	if err != nil {
 		return nil, err
 	}
-	var ftdRecordId string
-	// 2.5.3 check if FTD name is present in device records, logic: same name + both are FTDs = found
-	client.Logger.Printf("looking for existing FTD with id=%s and name=%s\n", deleteInp.Uid, fmcReadRes.Name)
-	for _, record := range allDeviceRecords.Items {
-		if record.Name != readFtdOutp.Name {
-			// different name, ignore
-			continue
-		}
-		// the allDeviceRecords only contains the name, so we need to make another call to retrieve the details of the device to check whether this is a FTD
-		// potentially we will be making a lot of network calls and cause this loop to run for long time if
-		// we have many device records with the same name, I suppose that rarely happens
-		deviceRecord, err := fmcconfig.ReadDeviceRecord(ctx, client, fmcconfig.NewReadDeviceRecordInput(fmcDomainUid, fmcReadRes.Host, record.Id))
-		if err != nil {
-			return nil, err
-		}
-		if strings.Contains(deviceRecord.Model, "Firepower Threat Defense") { // Question: is there a better way to check? Does this check cover all cases?
-			// found
-			ftdRecordId = record.Id
-		} // else not a FTD, just some other device with the same name, ignore
-	}
 
 	if ftdRecordId != "" {
 		// FTD record found in the cdFMC device records
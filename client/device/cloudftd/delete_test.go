@@ -25,7 +25,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 	}{
 		{
 			testName: "successfully delete Cloud FTD, and waited for delete state machine",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -43,9 +43,29 @@ func TestDeleteCloudFtd(t *testing.T) {
 				assert.Equal(t, validDeleteOutput, *output)
 			},
 		},
+		{
+			testName: "successfully delete Cloud FTD registered in a subdomain",
+			input:    cloudftd.NewDeleteInput(ftdUid, fmcSubdomainName),
+			setupFunc: func() {
+				readFmcIsSuccessful(true)
+				readFmcSpecificIsSuccessful(true)
+				readFmcSubdomainInfoIsSuccessful()
+				readFtdIsSuccessful(true)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+				triggerFtdDeleteOnFmcIsSuccessful(true)
+				waitForFtdDeleteStateMachineEndedSuccessful(true)
+				deleteFtdIsSuccessful(true)
+			},
+			assertFunc: func(output *cloudftd.DeleteOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[http.MethodGet+" "+url.ReadFmcAllDeviceRecords(baseUrl, fmcDomainUid)])
+			},
+		},
 		{
 			testName: "error when failed to read FMC",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(false)
 				readFmcSpecificIsSuccessful(true)
@@ -64,7 +84,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to read FMC specific",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(false)
@@ -83,7 +103,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to trigger delete FTD state machine",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -102,7 +122,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to wait for FTD delete state machine starts",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -121,7 +141,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to read FMC domain info",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, fmcSubdomainName),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -140,7 +160,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to read FTD",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -159,7 +179,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to read FMC device records",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -178,7 +198,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to read FTD device record in FMC",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -197,7 +217,7 @@ func TestDeleteCloudFtd(t *testing.T) {
 		},
 		{
 			testName: "error when failed to delete FTD in CDO",
-			input:    cloudftd.NewDeleteInput(ftdUid),
+			input:    cloudftd.NewDeleteInput(ftdUid, ""),
 			setupFunc: func() {
 				readFmcIsSuccessful(true)
 				readFmcSpecificIsSuccessful(true)
@@ -233,6 +253,14 @@ func TestDeleteCloudFtd(t *testing.T) {
 	}
 }
 
+func readFmcSubdomainInfoIsSuccessful() {
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		url.ReadFmcDomainInfo(fmcHost),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, validReadFmcSubdomainInfoOutput),
+	)
+}
+
 func deleteFtdIsSuccessful(success bool) {
 	if success {
 		httpmock.RegisterResponder(
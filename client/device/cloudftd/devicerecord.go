@@ -0,0 +1,38 @@
+package cloudftd
+
+import (
+	"context"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+)
+
+// readFtdDeviceRecordUid finds the device record of the FTD in the FMC, it has the same name as the FTD in CDO.
+// The returned uid is empty if the FTD has no device record, i.e. it is not registered with the FMC yet.
+func readFtdDeviceRecordUid(ctx context.Context, client http.Client, fmcDomainUid, fmcHostname, ftdName string) (string, error) {
+	allDeviceRecords, err := fmcconfig.ReadAllDeviceRecords(ctx, client, fmcconfig.NewReadAllDeviceRecordsInput(fmcDomainUid, fmcHostname))
+	if err != nil {
+		return "", err
+	}
+	var ftdRecordId string
+	// check if FTD name is present in device records, logic: same name + both are FTDs = found
+	for _, record := range allDeviceRecords.Items {
+		if record.Name != ftdName {
+			// different name, ignore
+			continue
+		}
+		// the allDeviceRecords only contains the name, so we need to make another call to retrieve the details of the device to check whether this is a FTD
+		// potentially we will be making a lot of network calls and cause this loop to run for long time if
+		// we have many device records with the same name, I suppose that rarely happens
+		deviceRecord, err := fmcconfig.ReadDeviceRecord(ctx, client, fmcconfig.NewReadDeviceRecordInput(fmcDomainUid, fmcHostname, record.Id))
+		if err != nil {
+			return "", err
+		}
+		if strings.Contains(deviceRecord.Model, "Firepower Threat Defense") { // Question: is there a better way to check? Does this check cover all cases?
+			// found
+			ftdRecordId = record.Id
+		} // else not a FTD, just some other device with the same name, ignore
+	}
+	return ftdRecordId, nil
+}
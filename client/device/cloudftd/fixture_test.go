@@ -33,6 +33,9 @@ const (
 	fmcDomainItemType = "unit-test-fmcDomainItemType"
 	fmcDomainItemUid  = fmcDomainUid
 
+	fmcGlobalDomainUid = "unit-test-global-domain-uid"
+	fmcSubdomainName   = "Customer1"
+
 	fmcAccessPolicyPages    = 123
 	fmcAccessPolicyCount    = 123
 	fmcAccessPolicyOffset   = 123
@@ -91,6 +94,16 @@ var (
 					Items([]fmcdomain.Item{validFmcDomainItem}).
 					Build()
 
+	// the FTD is registered in the Customer1 subdomain, which has the domain uid the FTD device records are read with
+	validReadFmcSubdomainInfoOutput = fmcdomain.NewInfoBuilder().
+					Links(fmcdomain.NewLinks(fmcLink)).
+					Paging(fmcdomain.NewPaging(fmcDomainCount, fmcDomainOffset, fmcDomainLimit, fmcDomainPages)).
+					Items([]fmcdomain.Item{
+			fmcdomain.NewItem(fmcGlobalDomainUid, "Global", fmcDomainItemType),
+			fmcdomain.NewItem(fmcDomainUid, "Global/"+fmcSubdomainName, fmcDomainItemType),
+		}).
+		Build()
+
 	validReadAccessPoliciesOutput = accesspolicies.Builder().
 					Links(accesspolicies.NewLinks(fmcLink)).
 					Paging(accesspolicies.NewPaging(
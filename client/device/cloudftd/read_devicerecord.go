@@ -0,0 +1,46 @@
+package cloudftd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+)
+
+type ReadDeviceRecordInput struct {
+	FmcHostname  string
+	FmcDomainUid string
+	Uid          string // uid of the FTD in CDO
+}
+
+func NewReadDeviceRecordInput(fmcHostname, fmcDomainUid, uid string) ReadDeviceRecordInput {
+	return ReadDeviceRecordInput{
+		FmcHostname:  fmcHostname,
+		FmcDomainUid: fmcDomainUid,
+		Uid:          uid,
+	}
+}
+
+type ReadDeviceRecordOutput = fmcconfig.ReadDeviceRecordOutput
+
+// ReadDeviceRecord reads the FMC device record of the FTD, it returns http.NotFoundError if the FTD is not registered with the FMC yet.
+func ReadDeviceRecord(ctx context.Context, client http.Client, readInp ReadDeviceRecordInput) (*ReadDeviceRecordOutput, error) {
+
+	client.Logger.Println("reading FMC device record of FTD")
+
+	ftd, err := ReadByUid(ctx, client, NewReadByUidInput(readInp.Uid))
+	if err != nil {
+		return nil, err
+	}
+
+	ftdRecordId, err := readFtdDeviceRecordUid(ctx, client, readInp.FmcDomainUid, readInp.FmcHostname, ftd.Name)
+	if err != nil {
+		return nil, err
+	}
+	if ftdRecordId == "" {
+		return nil, fmt.Errorf("%w: FTD %s is not registered with the FMC", http.NotFoundError, ftd.Name)
+	}
+
+	return fmcconfig.ReadDeviceRecord(ctx, client, fmcconfig.NewReadDeviceRecordInput(readInp.FmcDomainUid, readInp.FmcHostname, ftdRecordId))
+}
@@ -0,0 +1,89 @@
+package cloudftd_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadDeviceRecord(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *cloudftd.ReadDeviceRecordOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads FMC device record of FTD",
+			setupFunc: func() {
+				readFtdIsSuccessful(true)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+			},
+			assertFunc: func(output *cloudftd.ReadDeviceRecordOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, validReadFtdDeviceRecordOutput, *output)
+			},
+		},
+		{
+			testName: "returns not found error when FTD is not registered with the FMC",
+			setupFunc: func() {
+				readFtdIsSuccessful(true)
+				readNoFmcDeviceRecords(baseUrl)
+			},
+			assertFunc: func(output *cloudftd.ReadDeviceRecordOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.ErrorIs(t, err, internalHttp.NotFoundError)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when failed to read FTD",
+			setupFunc: func() {
+				readFtdIsSuccessful(false)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+			},
+			assertFunc: func(output *cloudftd.ReadDeviceRecordOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+		{
+			testName: "returns error when failed to read device records",
+			setupFunc: func() {
+				readFtdIsSuccessful(true)
+				readFmcDeviceRecordsIsSuccessful(false)
+				readFtdDeviceRecordIsSuccessful(true)
+			},
+			assertFunc: func(output *cloudftd.ReadDeviceRecordOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := cloudftd.ReadDeviceRecord(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				cloudftd.NewReadDeviceRecordInput(fmcHost, fmcDomainUid, ftdUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -2,31 +2,39 @@ package cloudftd
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcappliance"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcconfig"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/sliceutil"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
 )
 
 type UpdateInput struct {
-	Uid      string
-	Name     string
-	Tags     tags.Type
-	Licenses []license.Type
+	Uid             string
+	Name            string
+	Tags            tags.Type
+	Licenses        []license.Type
+	PerformanceTier *tier.Type // nil for physical FTDs
+	FmcDomain       string     // optional, the uuid or name of the FMC domain the FTD is registered in, the default domain is used if empty
 }
 
-func NewUpdateInput(uid, name string, tags tags.Type, licenses []license.Type) UpdateInput {
+func NewUpdateInput(uid, name string, tags tags.Type, licenses []license.Type, performanceTier *tier.Type, fmcDomain string) UpdateInput {
 	return UpdateInput{
-		Uid:      uid,
-		Name:     name,
-		Tags:     tags,
-		Licenses: licenses,
+		Uid:             uid,
+		Name:            name,
+		Tags:            tags,
+		Licenses:        licenses,
+		PerformanceTier: performanceTier,
+		FmcDomain:       fmcDomain,
 	}
 }
 
@@ -43,6 +51,21 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 
 	client.Logger.Println("updating FTD")
 
+	// read the current licenses and performance tier, so that we only change them in the FMC when they are different
+	currentFtd, err := ReadByUid(ctx, client, NewReadByUidInput(updateInp.Uid))
+	if err != nil {
+		return nil, err
+	}
+	currentLicenses, err := license.StringToCdoLicenses(currentFtd.Metadata.LicenseCaps)
+	if err != nil {
+		return nil, err
+	}
+	licensesChanged := !sameLicenses(currentLicenses, license.LicensesToCdoLicenses(updateInp.Licenses))
+	performanceTierChanged := updateInp.PerformanceTier != nil && (currentFtd.Metadata.PerformanceTier == nil || *currentFtd.Metadata.PerformanceTier != *updateInp.PerformanceTier)
+	if performanceTierChanged && currentFtd.Metadata.PerformanceTier == nil {
+		return nil, fmt.Errorf("cannot set the performance tier of FTD %s, performance tiers only apply to virtual FTDs", currentFtd.Name)
+	}
+
 	client.Logger.Println("updating CDO settings")
 
 	// update CDO settings
@@ -64,18 +87,20 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 		return nil, err
 	}
 
-	// update FTD license through FMC api
-	client.Logger.Println("updating FTD licenses")
-	_, err = fmcplatform.UpdateDeviceLicenses(
-		ctx,
-		client,
-		fmcplatform.NewUpdateDeviceLicensesInputBuilder().
-			FmcHost(fmcRes.Host).
-			LicenseTypes(updateInp.Licenses).
-			Build(),
-	)
-	if err != nil {
-		return nil, err
+	if licensesChanged {
+		// update FTD license through FMC api
+		client.Logger.Println("updating FTD licenses")
+		_, err = fmcplatform.UpdateDeviceLicenses(
+			ctx,
+			client,
+			fmcplatform.NewUpdateDeviceLicensesInputBuilder().
+				FmcHost(fmcRes.Host).
+				LicenseTypes(updateInp.Licenses).
+				Build(),
+		)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	// trigger oob detection in cdo to sync license changes
@@ -92,6 +117,19 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 		return nil, err
 	}
 
+	if licensesChanged || performanceTierChanged {
+		// apply the license and performance tier changes to the FTD device record, so that they take effect on the registered device
+		// the device record is in the domain the FTD is registered in
+		readDomainUidRes, err := cloudfmc.ReadDomainUid(ctx, client, cloudfmc.NewReadDomainUidInput(updateInp.FmcDomain))
+		if err != nil {
+			return nil, err
+		}
+		err = updateDeviceRecord(ctx, client, readDomainUidRes.DomainUid, readDomainUidRes.FmcHost, currentFtd.Name, updateInp, licensesChanged, performanceTierChanged)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// trigger oob detection
 	_, err = fmcappliance.Update(
 		ctx,
@@ -132,3 +170,43 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 
 	return ftdReadRes, nil
 }
+
+func updateDeviceRecord(ctx context.Context, client http.Client, fmcDomainUid, fmcHostname, ftdName string, updateInp UpdateInput, licensesChanged, performanceTierChanged bool) error {
+	ftdRecordId, err := readFtdDeviceRecordUid(ctx, client, fmcDomainUid, fmcHostname, ftdName)
+	if err != nil {
+		return err
+	}
+	if ftdRecordId == "" {
+		if performanceTierChanged {
+			return fmt.Errorf("%w: cannot change the performance tier of FTD %s, it is not registered with the FMC yet", http.NotFoundError, ftdName)
+		}
+		// the licenses apply when the FTD registers with the FMC
+		client.Logger.Println("FTD is not registered with the FMC yet, skip updating its device record")
+		return nil
+	}
+
+	var licenseCaps *[]license.Type
+	if licensesChanged {
+		licenseCaps = &updateInp.Licenses
+	}
+	var performanceTier *tier.Type
+	if performanceTierChanged {
+		performanceTier = updateInp.PerformanceTier
+	}
+
+	client.Logger.Println("updating FTD device record")
+	_, err = fmcconfig.UpdateDeviceRecord(ctx, client, fmcconfig.NewUpdateDeviceRecordInput(fmcDomainUid, fmcHostname, ftdRecordId, updateInp.Name, licenseCaps, performanceTier))
+	return err
+}
+
+func sameLicenses(a, b []license.Type) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for _, l := range a {
+		if !sliceutil.Contains(b, l) {
+			return false
+		}
+	}
+	return true
+}
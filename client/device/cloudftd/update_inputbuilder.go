@@ -3,6 +3,7 @@ package cloudftd
 import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
 )
 
 type UpdateInputBuilder struct {
@@ -35,6 +36,16 @@ func (b *UpdateInputBuilder) Licenses(licenses []license.Type) *UpdateInputBuild
 	return b
 }
 
+func (b *UpdateInputBuilder) PerformanceTier(performanceTier *tier.Type) *UpdateInputBuilder {
+	b.updateInput.PerformanceTier = performanceTier
+	return b
+}
+
+func (b *UpdateInputBuilder) FmcDomain(fmcDomain string) *UpdateInputBuilder {
+	b.updateInput.FmcDomain = fmcDomain
+	return b
+}
+
 func (b *UpdateInputBuilder) Build() UpdateInput {
 	return *b.updateInput
 }
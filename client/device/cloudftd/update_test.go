@@ -13,7 +13,9 @@ import (
 	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/devicelicense"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/assert"
@@ -29,16 +31,49 @@ func TestUpdateCloudFtd(t *testing.T) {
 	testCloudFtdInput := cloudftd.NewUpdateInputBuilder().
 		Uid("test-uid").
 		Licenses([]license.Type{license.Essentials}).
-		Name("test-name").
+		Name(ftdName).
 		Tags(internalTesting.NewTestingTags()).
 		Build()
 
+	testVirtualFtdInput := cloudftd.NewUpdateInputBuilder().
+		Uid("test-uid").
+		Licenses([]license.Type{license.Essentials}).
+		PerformanceTier(&ftdPerformanceTier).
+		Name(ftdName).
+		Tags(internalTesting.NewTestingTags()).
+		Build()
+
+	testSubdomainFtdInput := cloudftd.NewUpdateInputBuilder().
+		Uid("test-uid").
+		Licenses([]license.Type{license.Essentials}).
+		Name(ftdName).
+		Tags(internalTesting.NewTestingTags()).
+		FmcDomain(fmcSubdomainName).
+		Build()
+
 	testMetadata := cloudftd.NewMetadataBuilder().
 		LicenseCaps(&[]license.Type{}).
 		Build()
 
+	testUnchangedMetadata := cloudftd.NewMetadataBuilder().
+		LicenseCaps(&[]license.Type{license.Base}).
+		Build()
+
+	otherPerformanceTier := tier.FTDv50
+	testVirtualMetadata := cloudftd.NewMetadataBuilder().
+		LicenseCaps(&[]license.Type{license.Base}).
+		PerformanceTier(&otherPerformanceTier).
+		Build()
+
 	successFmcSpecificOutput := cloudfmc.NewReadSpecificOutputBuilder().
 		SpecificUid("test-specific-uid").
+		DomainUid(fmcDomainUid).
+		State(state.DONE).
+		Build()
+
+	globalDomainFmcSpecificOutput := cloudfmc.NewReadSpecificOutputBuilder().
+		SpecificUid("test-specific-uid").
+		DomainUid(fmcGlobalDomainUid).
 		State(state.DONE).
 		Build()
 
@@ -48,6 +83,18 @@ func TestUpdateCloudFtd(t *testing.T) {
 		Name(testCloudFtdInput.Name).
 		Build()
 
+	unchangedCloudFtdOutput := cloudftd.NewUpdateOutputBuilder().
+		Uid(testCloudFtdInput.Uid).
+		Metadata(testUnchangedMetadata).
+		Name(testCloudFtdInput.Name).
+		Build()
+
+	virtualCloudFtdOutput := cloudftd.NewUpdateOutputBuilder().
+		Uid(testCloudFtdInput.Uid).
+		Metadata(testVirtualMetadata).
+		Name(testCloudFtdInput.Name).
+		Build()
+
 	successCloudFmcOutput := cloudfmc.NewReadOutputBuilder().
 		WithUid(successFmcSpecificOutput.SpecificUid).
 		WithLocation(testHost, testPort).
@@ -65,6 +112,10 @@ func TestUpdateCloudFtd(t *testing.T) {
 		Uid(successFmcSpecificOutput.SpecificUid).
 		Build()
 
+	updateDeviceLicensesCall := http.MethodPut + " " + url.UpdateFmcDeviceLicenses(baseUrl, successUpdateDeviceLicenseOutput.Id)
+	updateDeviceRecordCall := http.MethodPut + " " + url.UpdateFmcDeviceRecord(baseUrl, fmcDomainUid, ftdDeviceRecordId)
+	updateCdoSettingsCall := http.MethodPut + " " + url.UpdateDevice(baseUrl, testCloudFtdInput.Uid)
+
 	testCases := []struct {
 		testName   string
 		input      cloudftd.UpdateInput
@@ -80,6 +131,9 @@ func TestUpdateCloudFtd(t *testing.T) {
 				readFtdDeviceLicense(baseUrl, successReadDeviceLicenseOutput)
 				updateFtdDeviceLicense(baseUrl, successUpdateDeviceLicenseOutput)
 				readCloudFmcSpecific(baseUrl, successFmcSpecificOutput)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+				updateFtdDeviceRecord(baseUrl)
 				updateCloudFmcAppliance(baseUrl, successFmcApplianceOutput)
 				readFtd(baseUrl, successCloudFtdOutput)
 			},
@@ -87,6 +141,108 @@ func TestUpdateCloudFtd(t *testing.T) {
 				assert.Nil(t, err)
 				assert.NotNil(t, output)
 				assert.Equal(t, successCloudFtdOutput, *output)
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[updateDeviceLicensesCall])
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[updateDeviceRecordCall])
+			},
+		},
+		{
+			testName: "successfully update Cloud FTD registered in a subdomain",
+			input:    testSubdomainFtdInput,
+			setupFunc: func() {
+				updateCdoFtdSettings(baseUrl, successCloudFtdOutput)
+				readCloudFmc(baseUrl, successCloudFmcOutput)
+				readFtdDeviceLicense(baseUrl, successReadDeviceLicenseOutput)
+				updateFtdDeviceLicense(baseUrl, successUpdateDeviceLicenseOutput)
+				readCloudFmcSpecific(baseUrl, globalDomainFmcSpecificOutput)
+				httpmock.RegisterResponder(
+					http.MethodGet,
+					url.ReadFmcDomainInfo(testHost),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, validReadFmcSubdomainInfoOutput),
+				)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+				updateFtdDeviceRecord(baseUrl)
+				updateCloudFmcAppliance(baseUrl, successFmcApplianceOutput)
+				readFtd(baseUrl, successCloudFtdOutput)
+			},
+			assertFunc: func(output *cloudftd.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[updateDeviceRecordCall])
+			},
+		},
+		{
+			testName: "successfully update performance tier of virtual Cloud FTD without updating unchanged licenses",
+			input:    testVirtualFtdInput,
+			setupFunc: func() {
+				updateCdoFtdSettings(baseUrl, virtualCloudFtdOutput)
+				readCloudFmc(baseUrl, successCloudFmcOutput)
+				readFtdDeviceLicense(baseUrl, successReadDeviceLicenseOutput)
+				updateFtdDeviceLicense(baseUrl, successUpdateDeviceLicenseOutput)
+				readCloudFmcSpecific(baseUrl, successFmcSpecificOutput)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+				updateFtdDeviceRecord(baseUrl)
+				updateCloudFmcAppliance(baseUrl, successFmcApplianceOutput)
+				readFtd(baseUrl, virtualCloudFtdOutput)
+			},
+			assertFunc: func(output *cloudftd.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[updateDeviceLicensesCall])
+				assert.Equal(t, 1, httpmock.GetCallCountInfo()[updateDeviceRecordCall])
+			},
+		},
+		{
+			testName: "successfully update Cloud FTD without updating unchanged licenses",
+			input:    testCloudFtdInput,
+			setupFunc: func() {
+				updateCdoFtdSettings(baseUrl, unchangedCloudFtdOutput)
+				readCloudFmc(baseUrl, successCloudFmcOutput)
+				readFtdDeviceLicense(baseUrl, successReadDeviceLicenseOutput)
+				updateFtdDeviceLicense(baseUrl, successUpdateDeviceLicenseOutput)
+				readCloudFmcSpecific(baseUrl, successFmcSpecificOutput)
+				readFmcDeviceRecordsIsSuccessful(true)
+				readFtdDeviceRecordIsSuccessful(true)
+				updateFtdDeviceRecord(baseUrl)
+				updateCloudFmcAppliance(baseUrl, successFmcApplianceOutput)
+				readFtd(baseUrl, unchangedCloudFtdOutput)
+			},
+			assertFunc: func(output *cloudftd.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, unchangedCloudFtdOutput, *output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[updateDeviceLicensesCall])
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[updateDeviceRecordCall])
+			},
+		},
+		{
+			testName: "error when setting performance tier of physical Cloud FTD",
+			input:    testVirtualFtdInput,
+			setupFunc: func() {
+				updateCdoFtdSettings(baseUrl, unchangedCloudFtdOutput)
+				readFtd(baseUrl, unchangedCloudFtdOutput)
+			},
+			assertFunc: func(output *cloudftd.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[updateCdoSettingsCall])
+			},
+		},
+		{
+			testName: "error when changing performance tier of Cloud FTD not registered with the FMC",
+			input:    testVirtualFtdInput,
+			setupFunc: func() {
+				updateCdoFtdSettings(baseUrl, virtualCloudFtdOutput)
+				readCloudFmc(baseUrl, successCloudFmcOutput)
+				readCloudFmcSpecific(baseUrl, successFmcSpecificOutput)
+				readNoFmcDeviceRecords(baseUrl)
+				readFtd(baseUrl, virtualCloudFtdOutput)
+			},
+			assertFunc: func(output *cloudftd.UpdateOutput, err error, t *testing.T) {
+				assert.NotNil(t, err)
+				assert.Nil(t, output)
+				assert.Equal(t, 0, httpmock.GetCallCountInfo()[updateDeviceRecordCall])
 			},
 		},
 	}
@@ -163,3 +319,19 @@ func readFtd(baseUrl string, output cloudftd.ReadOutput) {
 		httpmock.NewJsonResponderOrPanic(http.StatusOK, output),
 	)
 }
+
+func updateFtdDeviceRecord(baseUrl string) {
+	httpmock.RegisterResponder(
+		http.MethodPut,
+		url.UpdateFmcDeviceRecord(baseUrl, fmcDomainUid, ftdDeviceRecordId),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, validReadFtdDeviceRecordOutput),
+	)
+}
+
+func readNoFmcDeviceRecords(baseUrl string) {
+	httpmock.RegisterResponder(
+		http.MethodGet,
+		url.ReadFmcAllDeviceRecords(baseUrl, fmcDomainUid),
+		httpmock.NewJsonResponderOrPanic(http.StatusOK, fmcconfig.NewAllDeviceRecordsBuilder().Items([]fmcconfig.Item{}).Build()),
+	)
+}
@@ -0,0 +1,113 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios/iosconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
+)
+
+type UpdateInput struct {
+	DeviceUid string
+	Username  string
+	Password  string
+}
+
+type UpdateOutput = device.ReadOutput
+
+func NewUpdateInput(deviceUid string, username string, password string) UpdateInput {
+	return UpdateInput{
+		DeviceUid: deviceUid,
+		Username:  username,
+		Password:  password,
+	}
+}
+
+// Update rotates the credentials CDO uses to connect to an onboarded ASA or IOS device. For devices onboarded
+// using an SDC, the credentials are encrypted with the public key of the SDC before they leave the client.
+// It returns once the state machine of the device has applied the new credentials.
+func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating device credentials")
+
+	if updateInp.Username == "" || updateInp.Password == "" {
+		return nil, fmt.Errorf("username and password are required to update the credentials of a device")
+	}
+
+	deviceReadOutp, err := device.ReadByUid(ctx, client, *device.NewReadByUidInput(updateInp.DeviceUid))
+	if err != nil {
+		return nil, err
+	}
+
+	if deviceReadOutp.DeviceType != devicetype.Asa && deviceReadOutp.DeviceType != devicetype.Ios {
+		return nil, fmt.Errorf("updating credentials is not supported for devices of type %s", deviceReadOutp.DeviceType)
+	}
+
+	var publicKey *model.PublicKey
+	if strings.EqualFold(deviceReadOutp.ConnectorType, "SDC") {
+		if deviceReadOutp.ConnectorUid == "" {
+			return nil, fmt.Errorf("connector uid not found")
+		}
+
+		connectorReadOutp, err := connector.ReadByUid(ctx, client, *connector.NewReadByUidInput(deviceReadOutp.ConnectorUid))
+		if err != nil {
+			return nil, err
+		}
+		publicKey = &connectorReadOutp.PublicKey
+	}
+
+	readSpecificOutp, err := device.ReadSpecific(ctx, client, *device.NewReadSpecificInput(updateInp.DeviceUid))
+	if err != nil {
+		return nil, err
+	}
+
+	var untilCredentialsUpdated retry.Func
+	switch deviceReadOutp.DeviceType {
+	case devicetype.Asa:
+		_, err = asaconfig.UpdateCredentials(ctx, client, *asaconfig.NewUpdateInput(
+			readSpecificOutp.SpecificUid,
+			updateInp.Username,
+			updateInp.Password,
+			publicKey,
+			readSpecificOutp.State,
+		))
+		untilCredentialsUpdated = asaconfig.UntilStateDone(ctx, client, readSpecificOutp.SpecificUid)
+	case devicetype.Ios:
+		_, err = iosconfig.Update(ctx, client, *iosconfig.NewUpdateInput(
+			readSpecificOutp.SpecificUid,
+			updateInp.Username,
+			updateInp.Password,
+			publicKey,
+		))
+		untilCredentialsUpdated = iosconfig.UntilState(ctx, client, readSpecificOutp.SpecificUid, state.DONE)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := retry.Do(
+		ctx,
+		untilCredentialsUpdated,
+		retry.NewOptionsBuilder().
+			Message(fmt.Sprintf("Waiting for credentials of %s device to be updated on CDO...", deviceReadOutp.DeviceType)).
+			Retries(retry.DefaultRetries).
+			Logger(client.Logger).
+			Delay(retry.DefaultDelay).
+			Timeout(retry.DefaultTimeout).
+			EarlyExitOnError(true).
+			Build(),
+	); err != nil {
+		return nil, err
+	}
+
+	return device.ReadByUid(ctx, client, *device.NewReadByUidInput(updateInp.DeviceUid))
+}
@@ -0,0 +1,215 @@
+package credentials_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/credentials"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios/iosconfig"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/jsonutil"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	baseUrl     = "https://unittest.cdo.cisco.com"
+	deviceUid   = "11111111-1111-1111-1111-111111111111"
+	specificUid = "22222222-2222-2222-2222-222222222222"
+	username    = "rotated-username"
+	password    = "rotated-password"
+)
+
+func TestUpdate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	onPremConnector := connector.NewConnectorOutputBuilder().
+		AsOnPremConnector().
+		WithUid("00000000-0000-0000-0000-000000000000").
+		WithName("MyOnPremConnector").
+		Build()
+
+	asaDevice := device.NewReadOutputBuilder().
+		AsAsa().
+		WithUid(deviceUid).
+		WithName("my-asa").
+		OnboardedUsingOnPremConnector(onPremConnector.Uid).
+		Build()
+
+	iosDevice := device.NewReadOutputBuilder().
+		AsIos().
+		WithUid(deviceUid).
+		WithName("my-ios").
+		OnboardedUsingOnPremConnector(onPremConnector.Uid).
+		Build()
+
+	fmcDevice := device.NewReadOutputBuilder().
+		AsCloudFmc().
+		WithUid(deviceUid).
+		WithName("my-fmc").
+		Build()
+
+	specificDevice := device.ReadSpecificOutput{
+		SpecificUid: specificUid,
+		State:       state.DONE,
+	}
+
+	assertEncryptedCredentials := func(rawCredentials string, t *testing.T) {
+		creds, err := jsonutil.UnmarshalStruct[model.Credentials]([]byte(rawCredentials))
+		assert.Nil(t, err)
+		assert.Equal(t, onPremConnector.PublicKey.KeyId, creds.KeyId)
+		assert.NotEqual(t, username, creds.Username)
+		assert.NotEqual(t, password, creds.Password)
+	}
+
+	testCases := []struct {
+		testName   string
+		input      credentials.UpdateInput
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *credentials.UpdateOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully rotates encrypted credentials of ASA device",
+			input:    credentials.NewUpdateInput(deviceUid, username, password),
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadDevice(baseUrl, deviceUid), asaDevice)
+				internalTesting.MockGetOk(url.ReadConnectorByUid(baseUrl, onPremConnector.Uid), onPremConnector)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(baseUrl, deviceUid), specificDevice)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateAsaConfig(baseUrl, specificUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[asaconfig.UpdateCredentialsBodyWithState](r)
+						assert.Nil(t, err)
+						assert.Equal(t, "WAIT_FOR_USER_TO_UPDATE_CREDS", body.QueueTriggerState)
+						assertEncryptedCredentials(body.SmContext.Credentials, t)
+						return httpmock.NewJsonResponse(http.StatusOK, asaconfig.UpdateOutput{Uid: specificUid})
+					},
+				)
+				internalTesting.MockGetOk(url.ReadAsaConfig(baseUrl, specificUid), asaconfig.ReadOutput{Uid: specificUid, State: state.DONE})
+			},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, asaDevice, *output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPut, url.UpdateAsaConfig(baseUrl, specificUid), 1, t)
+			},
+		},
+		{
+			testName: "successfully rotates encrypted credentials of IOS device",
+			input:    credentials.NewUpdateInput(deviceUid, username, password),
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadDevice(baseUrl, deviceUid), iosDevice)
+				internalTesting.MockGetOk(url.ReadConnectorByUid(baseUrl, onPremConnector.Uid), onPremConnector)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(baseUrl, deviceUid), specificDevice)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateDevice(baseUrl, specificUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[iosconfig.UpdateBody](r)
+						assert.Nil(t, err)
+						assertEncryptedCredentials(body.Credentials, t)
+						return httpmock.NewJsonResponse(http.StatusOK, iosconfig.UpdateOutput{Uid: specificUid})
+					},
+				)
+				internalTesting.MockGetOk(url.ReadDevice(baseUrl, specificUid), iosconfig.ReadOutput{Uid: specificUid, State: state.DONE})
+			},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, iosDevice, *output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPut, url.UpdateDevice(baseUrl, specificUid), 1, t)
+			},
+		},
+		{
+			testName: "returns error when the new credentials are rejected by the ASA device",
+			input:    credentials.NewUpdateInput(deviceUid, username, password),
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadDevice(baseUrl, deviceUid), asaDevice)
+				internalTesting.MockGetOk(url.ReadConnectorByUid(baseUrl, onPremConnector.Uid), onPremConnector)
+				internalTesting.MockGetOk(url.ReadSpecificDevice(baseUrl, deviceUid), specificDevice)
+				httpmock.RegisterResponder(
+					http.MethodPut,
+					url.UpdateAsaConfig(baseUrl, specificUid),
+					httpmock.NewJsonResponderOrPanic(http.StatusOK, asaconfig.UpdateOutput{Uid: specificUid}),
+				)
+				internalTesting.MockGetOk(url.ReadAsaConfig(baseUrl, specificUid), asaconfig.ReadOutput{Uid: specificUid, State: state.BAD_CREDENTIALS})
+			},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, "Bad Credentials")
+			},
+		},
+		{
+			testName: "returns error when the device type does not support updating credentials",
+			input:    credentials.NewUpdateInput(deviceUid, username, password),
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadDevice(baseUrl, deviceUid), fmcDevice)
+			},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, "not supported")
+			},
+		},
+		{
+			testName: "returns error when the password is not provided",
+			input:    credentials.NewUpdateInput(deviceUid, username, ""),
+
+			setupFunc: func(t *testing.T) {},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+		{
+			testName: "returns error when reading the device fails",
+			input:    credentials.NewUpdateInput(deviceUid, username, password),
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetError(url.ReadDevice(baseUrl, deviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *credentials.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := credentials.Update(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(baseUrl, "a_valid_token", 0, 0, time.Minute),
+				testCase.input,
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -22,6 +22,7 @@ func NewReadByUidInput(uid string) ReadByUidInput {
 type ReadOutput struct {
 	Uid   string     `json:"uid"`
 	Name  string     `json:"name"`
+	Host  string     `json:"host"`
 	State state.Type `json:"state"`
 	Tags  tags.Type  `json:"tags"`
 }
@@ -2,6 +2,7 @@ package ios
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
@@ -21,6 +22,9 @@ type CreateInput struct {
 	Password string
 
 	IgnoreCertificate bool
+
+	// SoftwareVersion is optional, if set the onboarded device must run this software version
+	SoftwareVersion string
 }
 
 type CreateOutput = ReadOutput
@@ -60,7 +64,7 @@ func NewCreateRequestInput(name, connectorUid, connectorType, socketAddress, use
 	}
 }
 
-func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, error) {
+func Create(ctx context.Context, client http.Client, createInp CreateInput) (*CreateOutput, *CreateError) {
 
 	client.Logger.Println("creating ios device")
 
@@ -68,7 +72,7 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 
 	conn, err := connector.ReadByUid(ctx, client, *connector.NewReadByUidInput(createInp.ConnectorUid))
 	if err != nil {
-		return nil, err
+		return nil, &CreateError{Err: err}
 	}
 
 	transaction, err := publicapi.TriggerTransaction(
@@ -88,7 +92,7 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 	)
 	if err != nil {
 		_, _ = Delete(ctx, client, *NewDeleteInput(transaction.EntityUid))
-		return nil, err
+		return nil, &CreateError{Err: err}
 	}
 	transaction, err = publicapi.WaitForTransactionToFinishWithDefaults(
 		ctx,
@@ -98,8 +102,20 @@ func Create(ctx context.Context, client http.Client, createInp CreateInput) (*Cr
 	)
 	if err != nil {
 		_, _ = Delete(ctx, client, *NewDeleteInput(transaction.EntityUid))
-		return nil, err
+		return nil, &CreateError{Err: err}
+	}
+
+	readOutp, err := Read(ctx, client, *NewReadInput(transaction.EntityUid))
+	if err != nil {
+		return nil, &CreateError{Err: err, CreatedResourceId: &transaction.EntityUid}
+	}
+
+	if createInp.SoftwareVersion != "" && createInp.SoftwareVersion != readOutp.SoftwareVersion {
+		return nil, &CreateError{
+			Err:               fmt.Errorf("software version mismatch, the software version of the IOS device is %s, but %s was expected. Update the software version after the device is onboarded to upgrade it", readOutp.SoftwareVersion, createInp.SoftwareVersion),
+			CreatedResourceId: &readOutp.Uid,
+		}
 	}
 
-	return Read(ctx, client, *NewReadInput(transaction.EntityUid))
+	return readOutp, nil
 }
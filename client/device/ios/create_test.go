@@ -22,6 +22,8 @@ func TestIosCreate(t *testing.T) {
 	testModel := internalTesting.NewRandomModel()
 
 	createInput := testModel.CreateIosInput()
+	createInputWithSoftwareVersion := testModel.CreateIosInput()
+	createInputWithSoftwareVersion.SoftwareVersion = "not-the-device-version"
 	readOutput := testModel.ReadIosOutput()
 	doneTransaction := testModel.CreateDoneTransaction(readOutput.Uid, transactiontype.ONBOARD_IOS)
 	errorTransaction := testModel.CreateErrorTransaction(readOutput.Uid, transactiontype.ONBOARD_IOS)
@@ -64,6 +66,24 @@ func TestIosCreate(t *testing.T) {
 				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
 			},
 		},
+		{
+			testName: "fails onboards IOS if software version is not the expected one",
+			input:    createInputWithSoftwareVersion,
+
+			setupFunc: func(input ios.CreateInput) {
+				internalTesting.MockPostAccepted(url.CreateIos(testModel.BaseUrl), doneTransaction)
+				internalTesting.MockGetOk(url.ReadConnectorByUid(testModel.BaseUrl, testModel.CdgUid.String()), readOutput)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, readOutput.Uid), readOutput)
+			},
+
+			assertFunc: func(actualOutput *ios.CreateOutput, err error, t *testing.T) {
+				assert.Nil(t, actualOutput)
+				assert.ErrorContains(t, err, "software version mismatch")
+				var createErr *ios.CreateError
+				assert.ErrorAs(t, err, &createErr)
+				assert.Equal(t, readOutput.Uid, *createErr.CreatedResourceId)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
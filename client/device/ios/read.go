@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
@@ -19,11 +20,16 @@ type ReadOutput struct {
 	ConnectorUid    string          `json:"larUid"`
 	ConnectorType   string          `json:"larType"`
 	SocketAddress   string          `json:"ipv4"`
+	SoftwareVersion string          `json:"softwareVersion"`
 	Port            string          `json:"port"`
 	Host            string          `json:"host"`
 	Tags            tags.Type       `json:"tags"`
 
-	IgnoreCertificate bool `json:"ignoreCertificate"`
+	IgnoreCertificate bool       `json:"ignoreCertificate"`
+	ConnectivityState int        `json:"connectivityState,omitempty"`
+	ConnectivityError string     `json:"connectivityError,omitempty"`
+	State             state.Type `json:"state"`
+	Status            string     `json:"status"`
 }
 
 func NewReadInput(uid string) *ReadInput {
@@ -0,0 +1,45 @@
+package ios
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+)
+
+// Image is a software image an IOS device can be upgraded to. A staged image has already been copied to the
+// flash of the device, so that upgrading to it only requires a reload.
+type Image struct {
+	Uid             string `json:"uid"`
+	SoftwareVersion string `json:"softwareVersion"`
+	FileName        string `json:"fileName"`
+	Staged          bool   `json:"staged"`
+}
+
+type ReadImagesInput struct {
+	DeviceUid string
+}
+
+type ReadImagesOutput = model.CdoListResponse[Image]
+
+func NewReadImagesInput(deviceUid string) ReadImagesInput {
+	return ReadImagesInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// ReadImages reads the software images available to upgrade the IOS device to.
+func ReadImages(ctx context.Context, client http.Client, readInp ReadImagesInput) (*ReadImagesOutput, error) {
+
+	client.Logger.Println("reading ios images")
+
+	req := client.NewGet(ctx, url.ReadIosImages(client.BaseUrl(), readInp.DeviceUid))
+
+	var outp ReadImagesOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,102 @@
+package ios_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+var iosImages = ios.ReadImagesOutput{
+	Count: 2,
+	Items: []ios.Image{
+		{
+			Uid:             "77777777-7777-7777-7777-777777777777",
+			SoftwareVersion: "17.9.4a",
+			FileName:        "c8000v-universalk9.17.09.04a.SPA.bin",
+			Staged:          true,
+		},
+		{
+			Uid:             "88888888-8888-8888-8888-888888888888",
+			SoftwareVersion: "17.12.2",
+			FileName:        "c8000v-universalk9.17.12.02.SPA.bin",
+			Staged:          false,
+		},
+	},
+}
+
+func TestReadImages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.IosUid.String()
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func()
+		assertFunc func(output *ios.ReadImagesOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully reads images of IOS device",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+			},
+
+			assertFunc: func(output *ios.ReadImagesOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, iosImages, *output)
+			},
+		},
+		{
+			testName: "successfully reads no images of IOS device",
+
+			setupFunc: func() {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), model.CdoListResponse[ios.Image]{Count: 0, Items: []ios.Image{}})
+			},
+
+			assertFunc: func(output *ios.ReadImagesOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Empty(t, output.Items)
+			},
+		},
+		{
+			testName: "returns error when reading images fails",
+
+			setupFunc: func() {
+				internalTesting.MockGetError(url.ReadIosImages(testModel.BaseUrl, deviceUid), "internal server error")
+			},
+
+			assertFunc: func(output *ios.ReadImagesOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc()
+
+			output, err := ios.ReadImages(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				ios.NewReadImagesInput(deviceUid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package ios
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/devicecli"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cli"
+)
+
+type ReadRunningConfigInput struct {
+	DeviceUid string
+}
+
+type ReadRunningConfigOutput = cli.RunningConfig
+
+func NewReadRunningConfigInput(deviceUid string) ReadRunningConfigInput {
+	return ReadRunningConfigInput{
+		DeviceUid: deviceUid,
+	}
+}
+
+// ReadRunningConfig reads the running config from the IOS device through its connector, and waits for the read to finish.
+func ReadRunningConfig(ctx context.Context, client http.Client, readInp ReadRunningConfigInput) (*ReadRunningConfigOutput, error) {
+
+	client.Logger.Println("reading running config of ios")
+
+	return devicecli.ReadRunningConfig(
+		ctx,
+		client,
+		url.ReadRunningConfigFromIos(client.BaseUrl(), readInp.DeviceUid),
+		url.IosRunningConfig(client.BaseUrl(), readInp.DeviceUid),
+	)
+}
@@ -0,0 +1,62 @@
+package ios
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type StageImageInput struct {
+	DeviceUid string `json:"-"`
+	ImageUid  string `json:"imageUid"`
+}
+
+type StageImageOutput = Image
+
+func NewStageImageInput(deviceUid string, imageUid string) StageImageInput {
+	return StageImageInput{
+		DeviceUid: deviceUid,
+		ImageUid:  imageUid,
+	}
+}
+
+// StageImage copies a software image to the flash of the IOS device, without reloading it.
+func StageImage(ctx context.Context, client http.Client, stageInp StageImageInput) (*StageImageOutput, error) {
+
+	client.Logger.Println("staging ios image")
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.StageIosImage(client.BaseUrl(), stageInp.DeviceUid), stageInp)
+	if err != nil {
+		return nil, err
+	}
+
+	// poll every 15 seconds for up to 30 minutes, copying the image can take a while on slow links
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(30*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(fmt.Sprintf("Staging image %s on IOS device %s", stageInp.ImageUid, stageInp.DeviceUid)).
+		Delay(15*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	readImagesOutp, err := ReadImages(ctx, client, NewReadImagesInput(stageInp.DeviceUid))
+	if err != nil {
+		return nil, err
+	}
+	for _, image := range readImagesOutp.Items {
+		if image.Uid == stageInp.ImageUid {
+			return &image, nil
+		}
+	}
+
+	return nil, fmt.Errorf("image %s not found on IOS device %s after staging it", stageInp.ImageUid, stageInp.DeviceUid)
+}
@@ -0,0 +1,107 @@
+package ios_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStageImage(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.IosUid.String()
+	imageToStage := iosImages.Items[1]
+
+	stagedImages := ios.ReadImagesOutput{
+		Count: iosImages.Count,
+		Items: []ios.Image{iosImages.Items[0], imageToStage},
+	}
+	stagedImages.Items[1].Staged = true
+
+	doneTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.STAGE_IOS_IMAGE)
+	errorTransaction := testModel.CreateErrorTransaction(deviceUid, transactiontype.STAGE_IOS_IMAGE)
+
+	testCases := []struct {
+		testName   string
+		setupFunc  func(t *testing.T)
+		assertFunc func(output *ios.StageImageOutput, err error, t *testing.T)
+	}{
+		{
+			testName: "successfully stages image on IOS device",
+
+			setupFunc: func(t *testing.T) {
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.StageIosImage(testModel.BaseUrl, deviceUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[ios.StageImageInput](r)
+						assert.Nil(t, err)
+						assert.Equal(t, imageToStage.Uid, body.ImageUid)
+						return httpmock.NewJsonResponse(http.StatusAccepted, doneTransaction)
+					},
+				)
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), stagedImages)
+			},
+
+			assertFunc: func(output *ios.StageImageOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, stagedImages.Items[1], *output)
+				assert.True(t, output.Staged)
+			},
+		},
+		{
+			testName: "returns error when staging transaction fails",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostAccepted(url.StageIosImage(testModel.BaseUrl, deviceUid), errorTransaction)
+			},
+
+			assertFunc: func(output *ios.StageImageOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName: "returns error when staged image is not found",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockPostAccepted(url.StageIosImage(testModel.BaseUrl, deviceUid), doneTransaction)
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), ios.ReadImagesOutput{Count: 1, Items: iosImages.Items[:1]})
+			},
+
+			assertFunc: func(output *ios.StageImageOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := ios.StageImage(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				ios.NewStageImageInput(deviceUid, imageToStage.Uid),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
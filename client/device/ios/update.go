@@ -2,8 +2,13 @@ package ios
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios/iosconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
@@ -11,9 +16,13 @@ import (
 )
 
 type UpdateInput struct {
-	Uid  string    `json:"-"`
-	Name string    `json:"name"`
-	Tags tags.Type `json:"tags"`
+	Uid             string    `json:"-"`
+	Name            string    `json:"name"`
+	Tags            tags.Type `json:"tags"`
+	ConnectorUid    string    `json:"-"`
+	Username        string    `json:"-"`
+	Password        string    `json:"-"`
+	SoftwareVersion string    `json:"-"`
 }
 
 type UpdateOutput = device.UpdateOutput
@@ -28,6 +37,19 @@ func NewUpdateInput(uid string, name string, tags tags.Type) *UpdateInput {
 
 func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*UpdateOutput, error) {
 
+	if updateInp.SoftwareVersion != "" {
+		if _, err := Upgrade(ctx, client, NewUpgradeInput(updateInp.Uid, updateInp.SoftwareVersion)); err != nil {
+			client.Logger.Println("Failed to upgrade IOS")
+			return nil, err
+		}
+	}
+
+	if updateInp.ConnectorUid != "" {
+		if err := updateConnector(ctx, client, updateInp); err != nil {
+			return nil, err
+		}
+	}
+
 	client.Logger.Println("updating ios device")
 
 	url := url.UpdateDevice(client.BaseUrl(), updateInp.Uid)
@@ -41,3 +63,50 @@ func Update(ctx context.Context, client http.Client, updateInp UpdateInput) (*Up
 
 	return &outp, nil
 }
+
+// updateConnector moves the device to another SDC, and re-sends its credentials encrypted with the public key of that SDC.
+func updateConnector(ctx context.Context, client http.Client, updateInp UpdateInput) error {
+
+	if updateInp.Username == "" || updateInp.Password == "" {
+		return fmt.Errorf("username and password are required to move the IOS device to another connector, as the credentials are encrypted with the public key of the connector")
+	}
+
+	client.Logger.Printf("moving ios device to connector %s\n", updateInp.ConnectorUid)
+
+	conn, err := connector.ReadByUid(ctx, client, *connector.NewReadByUidInput(updateInp.ConnectorUid))
+	if err != nil {
+		return err
+	}
+
+	_, err = device.UpdateConnector(ctx, client, *device.NewUpdateConnectorInput(updateInp.Uid, conn.Uid, "SDC"))
+	if err != nil {
+		return err
+	}
+
+	readSpecificOutp, err := device.ReadSpecific(ctx, client, *device.NewReadSpecificInput(updateInp.Uid))
+	if err != nil {
+		return err
+	}
+
+	_, err = iosconfig.Update(ctx, client, *iosconfig.NewUpdateInput(
+		readSpecificOutp.SpecificUid,
+		updateInp.Username,
+		updateInp.Password,
+		&conn.PublicKey,
+	))
+	if err != nil {
+		return err
+	}
+
+	return retry.Do(
+		ctx,
+		iosconfig.UntilState(ctx, client, readSpecificOutp.SpecificUid, state.DONE),
+		retry.NewOptionsBuilder().
+			Message("Waiting for IOS credentials to be updated on CDO...").
+			Retries(retry.DefaultRetries).
+			Delay(retry.DefaultDelay).
+			Timeout(retry.DefaultTimeout).
+			EarlyExitOnError(true).
+			Build(),
+	)
+}
@@ -7,7 +7,12 @@ import (
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios/iosconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 	"github.com/stretchr/testify/assert"
+	netHttp "net/http"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
@@ -35,6 +40,18 @@ func TestIosUpdate(t *testing.T) {
 		WithTags(internalTesting.NewTestingTags()).
 		Build()
 
+	newOnPremConnector := connector.NewConnectorOutputBuilder().
+		AsOnPremConnector().
+		WithUid("11111111-1111-1111-1111-111111111111").
+		WithName("MyNewOnPremConnector").
+		WithTenantUid("66666666-6666-6666-6666-6666666666666").
+		Build()
+
+	iosConfig := device.ReadSpecificOutput{
+		SpecificUid: "44444444-4444-4444-4444-444444444444",
+		State:       state.DONE,
+	}
+
 	testCases := []struct {
 		testName   string
 		input      ios.UpdateInput
@@ -64,6 +81,113 @@ func TestIosUpdate(t *testing.T) {
 			},
 		},
 
+		{
+			testName: "successfully upgrades iOS before updating it",
+			input: ios.UpdateInput{
+				Uid:             iosDevice.Uid,
+				Name:            iosDevice.Name,
+				SoftwareVersion: iosImages.Items[0].SoftwareVersion,
+			},
+
+			setupFunc: func(input ios.UpdateInput) {
+				configureDeviceUpdateToRespondSuccessfully(iosDevice)
+				internalTesting.MockGetOk(url.ReadIosImages(baseUrl, iosDevice.Uid), iosImages)
+				internalTesting.MockPostAccepted(url.UpgradeIos(baseUrl, iosDevice.Uid), internalTesting.NewRandomModel().CreateDoneTransaction(iosDevice.Uid, transactiontype.UPGRADE_IOS))
+				configureDeviceReadToRespondSuccessfully(device.ReadOutput{
+					Uid:               iosDevice.Uid,
+					SoftwareVersion:   input.SoftwareVersion,
+					ConnectivityState: 1,
+					State:             state.DONE,
+				})
+			},
+
+			assertFunc: func(input ios.UpdateInput, output *ios.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPost, url.UpgradeIos(baseUrl, iosDevice.Uid), 1, t)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(iosDevice.Uid), 1, t)
+			},
+		},
+
+		{
+			testName: "successfully moves iOS to another OnPrem Connector",
+			input: ios.UpdateInput{
+				Uid:          iosDevice.Uid,
+				Name:         iosDevice.Name,
+				ConnectorUid: newOnPremConnector.Uid,
+				Username:     "lockhart",
+				Password:     "not a valid password",
+			},
+
+			setupFunc: func(input ios.UpdateInput) {
+				movedDevice := iosDevice
+				movedDevice.ConnectorUid = newOnPremConnector.Uid
+				configureDeviceUpdateToRespondSuccessfully(movedDevice)
+				configureSdcReadToRespondSuccessfully(newOnPremConnector)
+				configureDeviceReadSpecificToRespondSuccessfully(iosDevice.Uid, iosConfig)
+				httpmock.RegisterResponder(
+					netHttp.MethodPut,
+					buildDevicePath(iosConfig.SpecificUid),
+					httpmock.NewJsonResponderOrPanic(200, iosconfig.UpdateOutput{Uid: iosConfig.SpecificUid}),
+				)
+				configureIosConfigReadToSucceedWithSubsequentCalls(iosConfig.SpecificUid, []httpmock.Responder{
+					httpmock.NewJsonResponderOrPanic(200, iosconfig.ReadOutput{Uid: iosConfig.SpecificUid, State: state.DONE}),
+				})
+			},
+
+			assertFunc: func(input ios.UpdateInput, output *ios.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, newOnPremConnector.Uid, output.ConnectorUid)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(iosDevice.Uid), 2, t)
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(iosConfig.SpecificUid), 1, t)
+				assertSdcReadByUidWasCalledOnce(newOnPremConnector.Uid, t)
+			},
+		},
+
+		{
+			testName: "returns error when moving iOS to another connector without credentials",
+			input: ios.UpdateInput{
+				Uid:          iosDevice.Uid,
+				Name:         iosDevice.Name,
+				ConnectorUid: newOnPremConnector.Uid,
+			},
+
+			setupFunc: func(input ios.UpdateInput) {
+				configureDeviceUpdateToRespondSuccessfully(iosDevice)
+			},
+
+			assertFunc: func(input ios.UpdateInput, output *ios.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+
+				internalTesting.AssertEndpointCalledTimes(netHttp.MethodPut, buildDevicePath(iosDevice.Uid), 0, t)
+			},
+		},
+
+		{
+			testName: "returns error when sdc read call encounters an issue while moving iOS to another connector",
+			input: ios.UpdateInput{
+				Uid:          iosDevice.Uid,
+				Name:         iosDevice.Name,
+				ConnectorUid: newOnPremConnector.Uid,
+				Username:     "lockhart",
+				Password:     "not a valid password",
+			},
+
+			setupFunc: func(input ios.UpdateInput) {
+				configureDeviceUpdateToRespondSuccessfully(iosDevice)
+				configureSdcReadToRespondWithError(newOnPremConnector.Uid)
+			},
+
+			assertFunc: func(input ios.UpdateInput, output *ios.UpdateOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+			},
+		},
+
 		{
 			testName: "returns error when device update call encounters an issue",
 			input: ios.UpdateInput{
@@ -0,0 +1,128 @@
+package ios
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
+)
+
+type UpgradeInput struct {
+	DeviceUid       string
+	SoftwareVersion string
+}
+
+type UpgradeOutput = ReadOutput
+
+func NewUpgradeInput(deviceUid string, softwareVersion string) UpgradeInput {
+	return UpgradeInput{
+		DeviceUid:       deviceUid,
+		SoftwareVersion: softwareVersion,
+	}
+}
+
+type upgradeIosInput struct {
+	ImageUid string `json:"imageUid"`
+}
+
+// ValidateSoftwareVersion returns the image of the given software version if it is available to upgrade the IOS device to.
+func ValidateSoftwareVersion(ctx context.Context, client http.Client, deviceUid string, softwareVersion string) (*Image, error) {
+	readImagesOutp, err := ReadImages(ctx, client, NewReadImagesInput(deviceUid))
+	if err != nil {
+		return nil, err
+	}
+
+	availableVersions := make([]string, len(readImagesOutp.Items))
+	for i, image := range readImagesOutp.Items {
+		if image.SoftwareVersion == strings.TrimSpace(softwareVersion) {
+			return &image, nil
+		}
+		availableVersions[i] = image.SoftwareVersion
+	}
+
+	return nil, fmt.Errorf("IOS device cannot be upgraded to software version %s.\nAvailable IOS versions: %s", softwareVersion, strings.Join(availableVersions, ", "))
+}
+
+// Upgrade upgrades the IOS device to the image of the given software version, staging the image first if needed.
+// The device reloads to boot the new image, Upgrade returns once it is back online on the new software version.
+func Upgrade(ctx context.Context, client http.Client, upgradeInp UpgradeInput) (*UpgradeOutput, error) {
+
+	client.Logger.Printf("upgrading ios device to software version %s\n", upgradeInp.SoftwareVersion)
+
+	image, err := ValidateSoftwareVersion(ctx, client, upgradeInp.DeviceUid, upgradeInp.SoftwareVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	if !image.Staged {
+		image, err = StageImage(ctx, client, NewStageImageInput(upgradeInp.DeviceUid, image.Uid))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, url.UpgradeIos(client.BaseUrl(), upgradeInp.DeviceUid), upgradeIosInput{
+		ImageUid: image.Uid,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// poll every 30 seconds for up to 60 minutes
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(60*time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(fmt.Sprintf("Upgrading IOS device to %s", image.SoftwareVersion)).
+		Delay(30*time.Second).
+		Build())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := retry.Do(
+		ctx,
+		untilReloadedOnSoftwareVersion(ctx, client, upgradeInp.DeviceUid, image.SoftwareVersion),
+		retry.NewOptionsBuilder().
+			Logger(client.Logger).
+			Message(fmt.Sprintf("Waiting for IOS device to reload on software version %s", image.SoftwareVersion)).
+			Retries(-1).
+			Delay(30*time.Second).
+			Timeout(30*time.Minute).
+			EarlyExitOnError(true).
+			Build(),
+	); err != nil {
+		return nil, err
+	}
+
+	client.Logger.Println("IOS upgrade successful!")
+
+	return Read(ctx, client, *NewReadInput(upgradeInp.DeviceUid))
+}
+
+// untilReloadedOnSoftwareVersion waits for the IOS device to be reachable again after the reload, and for CDO to
+// have read the new software version from it.
+func untilReloadedOnSoftwareVersion(ctx context.Context, client http.Client, deviceUid string, softwareVersion string) retry.Func {
+
+	return func() (bool, error) {
+		readOutp, err := Read(ctx, client, *NewReadInput(deviceUid))
+		if err != nil {
+			return false, err
+		}
+
+		client.Logger.Printf("ios device state=%s, connectivity=%d, software version=%s\n", readOutp.State, readOutp.ConnectivityState, readOutp.SoftwareVersion)
+
+		if readOutp.State == state.ERROR {
+			return false, fmt.Errorf("IOS device failed to reconnect after the upgrade: %s", readOutp.ConnectivityError)
+		}
+
+		return readOutp.State == state.DONE && readOutp.ConnectivityState > 0 && readOutp.SoftwareVersion == softwareVersion, nil
+	}
+}
@@ -0,0 +1,159 @@
+package ios_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
+	internalHttp "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi/transaction/transactiontype"
+	internalTesting "github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/testing"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpgrade(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	testModel := internalTesting.NewRandomModel()
+	deviceUid := testModel.IosUid.String()
+	stagedImage := iosImages.Items[0]
+	unstagedImage := iosImages.Items[1]
+
+	upgradedDevice := func(softwareVersion string) ios.ReadOutput {
+		return ios.ReadOutput{
+			Uid:               deviceUid,
+			Name:              testModel.IosName,
+			SoftwareVersion:   softwareVersion,
+			ConnectivityState: 1,
+			State:             state.DONE,
+		}
+	}
+
+	doneStageTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.STAGE_IOS_IMAGE)
+	doneUpgradeTransaction := testModel.CreateDoneTransaction(deviceUid, transactiontype.UPGRADE_IOS)
+	errorUpgradeTransaction := testModel.CreateErrorTransaction(deviceUid, transactiontype.UPGRADE_IOS)
+
+	testCases := []struct {
+		testName        string
+		softwareVersion string
+		setupFunc       func(t *testing.T)
+		assertFunc      func(output *ios.UpgradeOutput, err error, t *testing.T)
+	}{
+		{
+			testName:        "successfully upgrades IOS device to staged image",
+			softwareVersion: stagedImage.SoftwareVersion,
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+				httpmock.RegisterResponder(
+					http.MethodPost,
+					url.UpgradeIos(testModel.BaseUrl, deviceUid),
+					func(r *http.Request) (*http.Response, error) {
+						body, err := internalHttp.ReadRequestBody[map[string]string](r)
+						assert.Nil(t, err)
+						assert.Equal(t, stagedImage.Uid, (*body)["imageUid"])
+						return httpmock.NewJsonResponse(http.StatusAccepted, doneUpgradeTransaction)
+					},
+				)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, deviceUid), upgradedDevice(stagedImage.SoftwareVersion))
+			},
+
+			assertFunc: func(output *ios.UpgradeOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, upgradedDevice(stagedImage.SoftwareVersion), *output)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPost, url.UpgradeIos(testModel.BaseUrl, deviceUid), 1, t)
+			},
+		},
+		{
+			testName:        "successfully stages image before upgrading IOS device to it",
+			softwareVersion: unstagedImage.SoftwareVersion,
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+				internalTesting.MockPostAccepted(url.StageIosImage(testModel.BaseUrl, deviceUid), doneStageTransaction)
+				internalTesting.MockPostAccepted(url.UpgradeIos(testModel.BaseUrl, deviceUid), doneUpgradeTransaction)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, deviceUid), upgradedDevice(unstagedImage.SoftwareVersion))
+			},
+
+			assertFunc: func(output *ios.UpgradeOutput, err error, t *testing.T) {
+				assert.Nil(t, err)
+				assert.NotNil(t, output)
+				assert.Equal(t, unstagedImage.SoftwareVersion, output.SoftwareVersion)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPost, url.StageIosImage(testModel.BaseUrl, deviceUid), 1, t)
+				internalTesting.AssertEndpointCalledTimes(http.MethodPost, url.UpgradeIos(testModel.BaseUrl, deviceUid), 1, t)
+			},
+		},
+		{
+			testName:        "returns error when software version is not available for IOS device",
+			softwareVersion: "15.2.7",
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+			},
+
+			assertFunc: func(output *ios.UpgradeOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, "Available IOS versions: 17.9.4a, 17.12.2")
+			},
+		},
+		{
+			testName:        "returns error when upgrade transaction fails",
+			softwareVersion: stagedImage.SoftwareVersion,
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+				internalTesting.MockPostAccepted(url.UpgradeIos(testModel.BaseUrl, deviceUid), errorUpgradeTransaction)
+			},
+
+			assertFunc: func(output *ios.UpgradeOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, errorUpgradeTransaction.ErrorMessage)
+			},
+		},
+		{
+			testName:        "returns error when IOS device fails to reconnect after reload",
+			softwareVersion: stagedImage.SoftwareVersion,
+
+			setupFunc: func(t *testing.T) {
+				internalTesting.MockGetOk(url.ReadIosImages(testModel.BaseUrl, deviceUid), iosImages)
+				internalTesting.MockPostAccepted(url.UpgradeIos(testModel.BaseUrl, deviceUid), doneUpgradeTransaction)
+				internalTesting.MockGetOk(url.ReadDevice(testModel.BaseUrl, deviceUid), ios.ReadOutput{
+					Uid:               deviceUid,
+					State:             state.ERROR,
+					ConnectivityError: "device unreachable",
+				})
+			},
+
+			assertFunc: func(output *ios.UpgradeOutput, err error, t *testing.T) {
+				assert.Nil(t, output)
+				assert.NotNil(t, err)
+				assert.ErrorContains(t, err, "device unreachable")
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.testName, func(t *testing.T) {
+			httpmock.Reset()
+
+			testCase.setupFunc(t)
+
+			output, err := ios.Upgrade(
+				context.Background(),
+				*internalHttp.MustNewWithConfig(testModel.BaseUrl, "a_valid_token", 0, 0, time.Minute),
+				ios.NewUpgradeInput(deviceUid, testCase.softwareVersion),
+			)
+
+			testCase.assertFunc(output, err, t)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package device
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type UpdateConnectorInput struct {
+	Uid string `json:"-"`
+
+	ConnectorUid  string `json:"larUid"`
+	ConnectorType string `json:"larType"`
+}
+
+func NewUpdateConnectorInput(uid string, connectorUid string, connectorType string) *UpdateConnectorInput {
+	return &UpdateConnectorInput{
+		Uid:           uid,
+		ConnectorUid:  connectorUid,
+		ConnectorType: connectorType,
+	}
+}
+
+// UpdateConnector moves the device to another connector. The credentials of the device are encrypted with the public key
+// of the connector, so they have to be updated afterward for CDO to be able to communicate with the device again.
+func UpdateConnector(ctx context.Context, client http.Client, updateInp UpdateConnectorInput) (*UpdateOutput, error) {
+
+	client.Logger.Println("updating device connector")
+
+	url := url.UpdateDevice(client.BaseUrl(), updateInp.Uid)
+
+	req := client.NewPut(ctx, url, updateInp)
+
+	var outp UpdateOutput
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
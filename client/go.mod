@@ -6,6 +6,7 @@ require (
 	github.com/google/uuid v1.6.0
 	github.com/jarcoal/httpmock v1.3.0
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/crypto v0.17.0
 	golang.org/x/exp v0.0.0-20240205201215-2c58cdc269a3
 	gopkg.in/errgo.v2 v2.1.0
 )
@@ -0,0 +1,77 @@
+// Package devicecli reads the running config of and executes CLI commands on devices that CDO manages through the CLI, i.e. ASAs and IOS devices.
+package devicecli
+
+import (
+	"context"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/publicapi"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/retry"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cli"
+)
+
+type executeRequestBody struct {
+	Commands []string `json:"commands"`
+}
+
+// ReadRunningConfig triggers a read of the running config from the device, waits for it to finish, and returns the config that was read.
+func ReadRunningConfig(ctx context.Context, client http.Client, triggerUrl string, runningConfigUrl string) (*cli.RunningConfig, error) {
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, triggerUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, options(client, "Waiting for the running config to be read from the device..."))
+	if err != nil {
+		return nil, err
+	}
+
+	req := client.NewGet(ctx, runningConfigUrl)
+
+	var outp cli.RunningConfig
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
+
+// Execute executes the commands on the device in order, waits for them to finish, and returns their output.
+func Execute(ctx context.Context, client http.Client, executeUrl string, commands []string) (*cli.Result, error) {
+
+	transaction, err := publicapi.TriggerTransaction(ctx, client, executeUrl, executeRequestBody{
+		Commands: commands,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err = publicapi.WaitForTransactionToFinish(ctx, client, transaction, options(client, "Waiting for the CLI commands to be executed on the device..."))
+	if err != nil {
+		return nil, err
+	}
+
+	// the entity of the transaction is the result of the commands
+	req := client.NewGet(ctx, url.CliResult(client.BaseUrl(), transaction.EntityUid))
+
+	var outp cli.Result
+	if err := req.Send(&outp); err != nil {
+		return nil, err
+	}
+
+	return &outp, nil
+}
+
+func options(client http.Client, msg string) retry.Options {
+	return retry.NewOptionsBuilder().
+		Logger(client.Logger).
+		Timeout(5 * time.Minute).
+		Retries(-1).
+		EarlyExitOnError(true).
+		Message(msg).
+		Delay(2 * time.Second).
+		Build()
+}
@@ -19,4 +19,15 @@ const (
 	MSP_DELETE_USER_GROUPS_FROM_TENANT Type = "MSP_DELETE_USER_GROUPS_FROM_TENANT"
 	UPGRADE_ASA                        Type = "UPGRADE_ASA"
 	UPGRADE_FTD                        Type = "UPGRADE_FTD"
+	READ_RUNNING_CONFIG                Type = "READ_RUNNING_CONFIG"
+	EXECUTE_CLI                        Type = "EXECUTE_CLI"
+	BACKUP_DEVICE                      Type = "BACKUP_DEVICE"
+	RESTORE_DEVICE_BACKUP              Type = "RESTORE_DEVICE_BACKUP"
+	FAILOVER_ASA                       Type = "FAILOVER_ASA"
+	ONBOARD_ASA_CONTEXT                Type = "ONBOARD_ASA_CONTEXT"
+	ACCEPT_DEVICE_CONFLICT             Type = "ACCEPT_DEVICE_CONFLICT"
+	REJECT_DEVICE_CONFLICT             Type = "REJECT_DEVICE_CONFLICT"
+	STAGE_IOS_IMAGE                    Type = "STAGE_IOS_IMAGE"
+	UPGRADE_IOS                        Type = "UPGRADE_IOS"
+	ONBOARD_ASA_WITH_REGISTRATION_KEY  Type = "ONBOARD_ASA_WITH_REGISTRATION_KEY"
 )
@@ -55,3 +55,14 @@ func (m Model) AsaCreateInput() asa.CreateInput {
 		IgnoreCertificate: false,
 	}
 }
+
+func (m Model) AsaCreateWithRegistrationKeyInput() asa.CreateWithRegistrationKeyInput {
+	return asa.CreateWithRegistrationKeyInput{
+		Name:              m.AsaName,
+		ConnectorUid:      m.CdgUid.String(),
+		ConnectorType:     "CDG",
+		SocketAddress:     fmt.Sprintf("%s:%s", m.AsaHost, m.AsaPort),
+		Labels:            publicapilabels.Empty(),
+		IgnoreCertificate: false,
+	}
+}
@@ -36,3 +36,9 @@ func (m Model) ReadAccessPolicies() accesspolicies.AccessPolicies {
 		},
 	}
 }
+
+func (m Model) FmcReadSpecificOutput() cloudfmc.ReadSpecificOutput {
+	return cloudfmc.ReadSpecificOutput{
+		DomainUid: m.FmcDomainUuid.String(),
+	}
+}
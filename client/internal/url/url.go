@@ -3,6 +3,7 @@ package url
 
 import (
 	"fmt"
+	netUrl "net/url"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
 )
@@ -114,6 +115,10 @@ func ReadUserByUsername(baseUrl string) string {
 	return fmt.Sprintf("%s/anubis/rest/v1/users", baseUrl)
 }
 
+func ReadAllUsers(baseUrl string, limit int, offset int) string {
+	return fmt.Sprintf("%s/anubis/rest/v1/users?limit=%d&offset=%d", baseUrl, limit, offset)
+}
+
 func ReadOrUpdateUserByUid(baseUrl string, uid string) string {
 	return fmt.Sprintf("%s/anubis/rest/v1/users/%s", baseUrl, uid)
 }
@@ -150,6 +155,10 @@ func ReadFmcDeviceRecord(baseUrl string, fmcDomainId string, deviceUid string) s
 	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s", baseUrl, fmcDomainId, deviceUid)
 }
 
+func UpdateFmcDeviceRecord(baseUrl string, fmcDomainId string, deviceUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s", baseUrl, fmcDomainId, deviceUid)
+}
+
 func ReadFmcAllDeviceRecords(baseUrl string, fmcDomainId string) string {
 	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords", baseUrl, fmcDomainId)
 }
@@ -198,6 +207,10 @@ func CreateAsa(baseUrl string) string {
 	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas", baseUrl)
 }
 
+func CreateAsaWithRegistrationKey(baseUrl string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/registrationkey", baseUrl)
+}
+
 func CreateFtd(baseUrl string) string {
 	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ftds", baseUrl)
 }
@@ -222,6 +235,10 @@ func MspManagedTenantByUid(baseUrl string, tenantUid string) string {
 	return fmt.Sprintf("%s/api/rest/v1/msp/tenants/%s", baseUrl, tenantUid)
 }
 
+func ReadAllMspManagedTenants(baseUrl string, limit int, offset int) string {
+	return fmt.Sprintf("%s/api/rest/v1/msp/tenants?limit=%d&offset=%d", baseUrl, limit, offset)
+}
+
 func FindMspManagedTenantsByName(baseUrl string, tenantName string) string {
 	return fmt.Sprintf("%s/api/rest/v1/msp/tenants?q=name:%s", baseUrl, tenantName)
 }
@@ -262,6 +279,10 @@ func GetUpgradeAsaUrl(baseUrl string, deviceUid string) string {
 	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/upgrades/trigger", baseUrl, deviceUid)
 }
 
+func FailoverAsa(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/failover", baseUrl, deviceUid)
+}
+
 func GetFtdUpgradePackagesUrl(baseUrl string, deviceUid string) string {
 	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ftds/%s/upgrades/versions", baseUrl, deviceUid)
 }
@@ -269,3 +290,207 @@ func GetFtdUpgradePackagesUrl(baseUrl string, deviceUid string) string {
 func GetFtdUpgradeUrl(baseUrl string, deviceUid string) string {
 	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ftds/%s/upgrades/trigger", baseUrl, deviceUid)
 }
+
+func ReadInventoryDevice(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s", baseUrl, deviceUid)
+}
+
+func ReadDeviceConflict(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/conflict", baseUrl, deviceUid)
+}
+
+func AcceptDeviceConflict(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/conflict/accept", baseUrl, deviceUid)
+}
+
+func RejectDeviceConflict(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/conflict/reject", baseUrl, deviceUid)
+}
+
+func ReadAllConflictedDevices(baseUrl string, limit int, offset int) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices?q=configState:CONFLICT_DETECTED&limit=%d&offset=%d", baseUrl, limit, offset)
+}
+
+func ReadAllConflictedDevicesWithLabel(baseUrl string, label string, limit int, offset int) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices?q=configState:CONFLICT_DETECTED+AND+labels:%s&limit=%d&offset=%d", baseUrl, netUrl.QueryEscape(label), limit, offset)
+}
+
+func DeployAsaChanges(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/deploy", baseUrl, deviceUid)
+}
+
+func ReadConfigFromAsa(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/read", baseUrl, deviceUid)
+}
+
+func ReadRunningConfigFromAsa(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/runningconfig/read", baseUrl, deviceUid)
+}
+
+func AsaRunningConfig(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/runningconfig", baseUrl, deviceUid)
+}
+
+func ExecuteAsaCli(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/cli", baseUrl, deviceUid)
+}
+
+func ReadRunningConfigFromIos(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/runningconfig/read", baseUrl, deviceUid)
+}
+
+func IosRunningConfig(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/runningconfig", baseUrl, deviceUid)
+}
+
+func ExecuteIosCli(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/cli", baseUrl, deviceUid)
+}
+
+func ReadIosImages(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/upgrades/images", baseUrl, deviceUid)
+}
+
+func StageIosImage(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/upgrades/stage", baseUrl, deviceUid)
+}
+
+func UpgradeIos(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/ios/%s/upgrades/trigger", baseUrl, deviceUid)
+}
+
+func CliResult(baseUrl string, resultUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/cli/results/%s", baseUrl, resultUid)
+}
+
+func TriggerDeviceBackup(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups/trigger", baseUrl, deviceUid)
+}
+
+func ReadAllDeviceBackups(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups", baseUrl, deviceUid)
+}
+
+func ReadDeviceBackup(baseUrl string, deviceUid string, backupUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups/%s", baseUrl, deviceUid, backupUid)
+}
+
+func DownloadDeviceBackup(baseUrl string, deviceUid string, backupUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups/%s/download", baseUrl, deviceUid, backupUid)
+}
+
+func RestoreDeviceBackup(baseUrl string, deviceUid string, backupUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups/%s/restore", baseUrl, deviceUid, backupUid)
+}
+
+func DeviceBackupSchedule(baseUrl string, deviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/%s/backups/schedule", baseUrl, deviceUid)
+}
+
+func CreateFmcAccessPolicy(baseUrl string, fmcDomainUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/policy/accesspolicies", baseUrl, fmcDomainUid)
+}
+
+func FmcAccessPolicyByUid(baseUrl string, fmcDomainUid string, accessPolicyUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/policy/accesspolicies/%s", baseUrl, fmcDomainUid, accessPolicyUid)
+}
+
+func CreateFmcAccessRule(baseUrl string, fmcDomainUid string, accessPolicyUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/policy/accesspolicies/%s/accessrules", baseUrl, fmcDomainUid, accessPolicyUid)
+}
+
+func FmcAccessRuleByUid(baseUrl string, fmcDomainUid string, accessPolicyUid string, accessRuleUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/policy/accesspolicies/%s/accessrules/%s", baseUrl, fmcDomainUid, accessPolicyUid, accessRuleUid)
+}
+
+func CreateFmcObject(baseUrl string, fmcDomainUid string, objectKind string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/object/%s", baseUrl, fmcDomainUid, objectKind)
+}
+
+func ReadAllFmcObjects(baseUrl string, fmcDomainUid string, objectKind string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/object/%s", baseUrl, fmcDomainUid, objectKind)
+}
+
+func FmcObjectByUid(baseUrl string, fmcDomainUid string, objectKind string, objectUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/object/%s/%s", baseUrl, fmcDomainUid, objectKind, objectUid)
+}
+
+func ReadFmcDeployableDevices(baseUrl string, fmcDomainUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/deployment/deployabledevices", baseUrl, fmcDomainUid)
+}
+
+func CreateFmcDeploymentRequest(baseUrl string, fmcDomainUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/deployment/deploymentrequests", baseUrl, fmcDomainUid)
+}
+
+func ReadAllFmcPhysicalInterfaces(baseUrl string, fmcDomainUid string, deviceRecordUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/physicalinterfaces", baseUrl, fmcDomainUid, deviceRecordUid)
+}
+
+func CreateFmcFtdHaPair(baseUrl string, fmcDomainUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devicehapairs/ftddevicehapairs", baseUrl, fmcDomainUid)
+}
+
+func ReadAllFmcFtdHaPairs(baseUrl string, fmcDomainUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devicehapairs/ftddevicehapairs", baseUrl, fmcDomainUid)
+}
+
+func FmcFtdHaPairByUid(baseUrl string, fmcDomainUid string, haPairUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devicehapairs/ftddevicehapairs/%s", baseUrl, fmcDomainUid, haPairUid)
+}
+
+func FmcPhysicalInterfaceByUid(baseUrl string, fmcDomainUid string, deviceRecordUid string, interfaceUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/physicalinterfaces/%s", baseUrl, fmcDomainUid, deviceRecordUid, interfaceUid)
+}
+
+func CreateFmcSubInterface(baseUrl string, fmcDomainUid string, deviceRecordUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/subinterfaces", baseUrl, fmcDomainUid, deviceRecordUid)
+}
+
+func FmcSubInterfaceByUid(baseUrl string, fmcDomainUid string, deviceRecordUid string, subInterfaceUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/subinterfaces/%s", baseUrl, fmcDomainUid, deviceRecordUid, subInterfaceUid)
+}
+
+func CreateFmcIpv4StaticRoute(baseUrl string, fmcDomainUid string, deviceRecordUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/routing/ipv4staticroutes", baseUrl, fmcDomainUid, deviceRecordUid)
+}
+
+func FmcIpv4StaticRouteByUid(baseUrl string, fmcDomainUid string, deviceRecordUid string, routeUid string) string {
+	return fmt.Sprintf("%s/fmc/api/fmc_config/v1/domain/%s/devices/devicerecords/%s/routing/ipv4staticroutes/%s", baseUrl, fmcDomainUid, deviceRecordUid, routeUid)
+}
+
+func CreateAsaObject(baseUrl string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/targets/objects", baseUrl)
+}
+
+func AsaObjectByUid(baseUrl string, objectUid string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/targets/objects/%s", baseUrl, objectUid)
+}
+
+func CreateAsaAccessList(baseUrl string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/asa/accesslists", baseUrl)
+}
+
+func AsaAccessListByUid(baseUrl string, accessListUid string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/asa/accesslists/%s", baseUrl, accessListUid)
+}
+
+func CreateAsaAccessGroup(baseUrl string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/asa/accessgroups", baseUrl)
+}
+
+func AsaAccessGroupByUid(baseUrl string, accessGroupUid string) string {
+	return fmt.Sprintf("%s/aegis/rest/v1/services/asa/accessgroups/%s", baseUrl, accessGroupUid)
+}
+
+func ReadAsaContexts(baseUrl string, systemDeviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/contexts", baseUrl, systemDeviceUid)
+}
+
+func ReadAsaContext(baseUrl string, systemDeviceUid string, contextName string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/contexts/%s", baseUrl, systemDeviceUid, contextName)
+}
+
+func OnboardAsaContext(baseUrl string, systemDeviceUid string) string {
+	return fmt.Sprintf("%s/api/rest/v1/inventory/devices/asas/%s/contexts/onboard", baseUrl, systemDeviceUid)
+}
@@ -0,0 +1,18 @@
+package asaaccess
+
+type Direction string
+
+const (
+	In     Direction = "IN"
+	Out    Direction = "OUT"
+	Global Direction = "GLOBAL"
+)
+
+// AccessGroup binds an access list to the traffic of an interface of an ASA in one direction, or to the traffic of all interfaces if it is global.
+type AccessGroup struct {
+	Uid           string    `json:"uid,omitempty"`
+	DeviceUid     string    `json:"deviceUid"`
+	AccessListUid string    `json:"accessListUid"`
+	InterfaceName string    `json:"interfaceName,omitempty"`
+	Direction     Direction `json:"direction"`
+}
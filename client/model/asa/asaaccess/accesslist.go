@@ -0,0 +1,46 @@
+package asaaccess
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+
+type Action string
+
+const (
+	Permit Action = "PERMIT"
+	Deny   Action = "DENY"
+)
+
+// AccessList is an extended access list of an ASA, its entries are evaluated in the order of their position, and the first matching entry decides the action.
+type AccessList struct {
+	Uid       string  `json:"uid,omitempty"`
+	DeviceUid string  `json:"deviceUid"`
+	Name      string  `json:"name"`
+	Entries   []Entry `json:"entries"`
+}
+
+// Entry is an access control entry of an access list. A nil Source or Destination matches any address, and a nil Service matches any IP traffic.
+// HitCount is read only, it is the number of times the entry matched traffic on the ASA.
+type Entry struct {
+	Uid         string               `json:"uid,omitempty"`
+	Position    int                  `json:"position"`
+	Action      Action               `json:"action"`
+	Source      *asaobject.Reference `json:"source,omitempty"`
+	Destination *asaobject.Reference `json:"destination,omitempty"`
+	Service     *asaobject.Reference `json:"service,omitempty"`
+	Logging     *Logging             `json:"logging,omitempty"`
+	Remark      string               `json:"remark,omitempty"`
+	HitCount    int64                `json:"hitCount,omitempty"`
+}
+
+// Logging is the logging of the traffic matched by an entry, the ASA uses its default level and interval if they are not set.
+type Logging struct {
+	Level    string `json:"level,omitempty"`
+	Interval int    `json:"interval,omitempty"`
+}
+
+func NewReference(uid string) *asaobject.Reference {
+	if uid == "" {
+		return nil
+	}
+	reference := asaobject.NewReference(uid)
+	return &reference
+}
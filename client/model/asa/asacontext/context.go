@@ -0,0 +1,17 @@
+package asacontext
+
+type FirewallMode string
+
+const (
+	Routed      FirewallMode = "ROUTED"
+	Transparent FirewallMode = "TRANSPARENT"
+)
+
+// Context is a security context of an ASA running in multiple context mode. DeviceUid is the uid of the CDO device
+// the context is onboarded as, it is empty if the context has not been onboarded.
+type Context struct {
+	Name           string       `json:"name"`
+	FirewallMode   FirewallMode `json:"firewallMode"`
+	IsAdminContext bool         `json:"adminContext"`
+	DeviceUid      string       `json:"deviceUid,omitempty"`
+}
@@ -0,0 +1,104 @@
+package asaobject
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+
+// Type is the type of ASA object, it decides which contents and references the object can have.
+type Type string
+
+const (
+	NetworkObject Type = "NETWORK_OBJECT"
+	NetworkGroup  Type = "NETWORK_GROUP"
+	ServiceObject Type = "SERVICE_OBJECT"
+	ServiceGroup  Type = "SERVICE_GROUP"
+)
+
+// ContentType is the type of a value of an ASA object, as found in the @type field of the content.
+type ContentType string
+
+const (
+	NetworkContentType ContentType = "NetworkContent"
+	ServiceContentType ContentType = "ServiceContent"
+)
+
+// NetworkType is the notation of the value of a network content.
+type NetworkType string
+
+const (
+	HostNetwork   NetworkType = "Host"
+	SubnetNetwork NetworkType = "Network"
+	RangeNetwork  NetworkType = "Range"
+	FqdnNetwork   NetworkType = "FQDN"
+)
+
+// Object is an object managed by CDO on one or more ASAs, an object on more than one ASA is shared, and changing it changes it on all of them.
+// The fields used depend on the type of the object:
+//   - NETWORK_OBJECT, SERVICE_OBJECT: a single content
+//   - NETWORK_GROUP: network contents and references to network objects and groups
+//   - SERVICE_GROUP: references to service objects and groups
+type Object struct {
+	Uid         string          `json:"uid,omitempty"`
+	Name        string          `json:"name"`
+	Description string          `json:"description,omitempty"`
+	ObjectType  Type            `json:"objectType"`
+	DeviceType  devicetype.Type `json:"deviceType"`
+	Contents    []Content       `json:"contents,omitempty"`
+	References  []Reference     `json:"references,omitempty"`
+	Targets     []Target        `json:"targets"`
+}
+
+// Content is a value of an ASA object, e.g. the address of a network object or the protocol and ports of a service object.
+type Content struct {
+	Type            ContentType `json:"@type"`
+	NetworkType     NetworkType `json:"networkType,omitempty"`
+	Value           string      `json:"value,omitempty"`
+	Protocol        string      `json:"protocol,omitempty"`
+	SourcePort      string      `json:"sourcePort,omitempty"`
+	DestinationPort string      `json:"destinationPort,omitempty"`
+}
+
+func NewNetworkContent(networkType NetworkType, value string) Content {
+	return Content{
+		Type:        NetworkContentType,
+		NetworkType: networkType,
+		Value:       value,
+	}
+}
+
+func NewServiceContent(protocol, sourcePort, destinationPort string) Content {
+	return Content{
+		Type:            ServiceContentType,
+		Protocol:        protocol,
+		SourcePort:      sourcePort,
+		DestinationPort: destinationPort,
+	}
+}
+
+// Reference is a reference to another ASA object, e.g. a member of a group.
+type Reference struct {
+	Uid  string `json:"uid"`
+	Name string `json:"name,omitempty"`
+}
+
+func NewReference(uid string) Reference {
+	return Reference{
+		Uid: uid,
+	}
+}
+
+// Target is an ASA the object is on.
+type Target struct {
+	Uid        string          `json:"uid"`
+	DeviceType devicetype.Type `json:"deviceType"`
+}
+
+func NewTarget(deviceUid string) Target {
+	return Target{
+		Uid:        deviceUid,
+		DeviceType: devicetype.Asa,
+	}
+}
+
+// IsShared returns whether the object is on more than one ASA.
+func (o Object) IsShared() bool {
+	return len(o.Targets) > 1
+}
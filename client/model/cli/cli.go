@@ -0,0 +1,15 @@
+package cli
+
+// RunningConfig is the running configuration of a device, as read from the device by CDO.
+type RunningConfig struct {
+	DeviceUid string `json:"deviceUid"`
+	Config    string `json:"config"`
+}
+
+// Result is the output of CLI commands executed on a device, the commands are executed in order and their output is concatenated.
+type Result struct {
+	Uid       string   `json:"uid"`
+	DeviceUid string   `json:"deviceUid"`
+	Commands  []string `json:"commands"`
+	Output    string   `json:"output"`
+}
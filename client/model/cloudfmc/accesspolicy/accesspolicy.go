@@ -0,0 +1,53 @@
+package accesspolicy
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+
+const (
+	Type              = "AccessPolicy"
+	DefaultActionType = "AccessPolicyDefaultAction"
+)
+
+// AccessPolicy schema is from the policy tab of <fmc-url-here>/api/api-explorer/
+type AccessPolicy struct {
+	Id            string        `json:"id,omitempty"`
+	Type          string        `json:"type"`
+	Links         *Links        `json:"links,omitempty"`
+	Name          string        `json:"name"`
+	Description   string        `json:"description,omitempty"`
+	DefaultAction DefaultAction `json:"defaultAction"`
+}
+
+func New(id, name, description string, defaultAction DefaultAction) AccessPolicy {
+	return AccessPolicy{
+		Id:            id,
+		Type:          Type,
+		Name:          name,
+		Description:   description,
+		DefaultAction: defaultAction,
+	}
+}
+
+// DefaultAction is applied to the traffic that does not match any rule of the access policy.
+type DefaultAction struct {
+	Id              string `json:"id,omitempty"`
+	Type            string `json:"type,omitempty"`
+	Action          string `json:"action"`
+	LogBegin        bool   `json:"logBegin"`
+	LogEnd          bool   `json:"logEnd"`
+	SendEventsToFMC bool   `json:"sendEventsToFMC"`
+}
+
+func NewDefaultAction(id, action string, logBegin, logEnd, sendEventsToFMC bool) DefaultAction {
+	return DefaultAction{
+		Id:              id,
+		Type:            DefaultActionType,
+		Action:          action,
+		LogBegin:        logBegin,
+		LogEnd:          logEnd,
+		SendEventsToFMC: sendEventsToFMC,
+	}
+}
+
+type Links = internal.Links
+
+var NewLinks = internal.NewLinks
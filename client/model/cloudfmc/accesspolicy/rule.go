@@ -0,0 +1,76 @@
+package accesspolicy
+
+const (
+	RuleType         = "AccessRule"
+	SecurityZoneType = "SecurityZone"
+)
+
+// Rule schema is from the policy tab of <fmc-url-here>/api/api-explorer/, only the commonly used fields are mapped.
+type Rule struct {
+	Id                  string        `json:"id,omitempty"`
+	Type                string        `json:"type"`
+	Links               *Links        `json:"links,omitempty"`
+	Name                string        `json:"name"`
+	Action              string        `json:"action"`
+	Enabled             bool          `json:"enabled"`
+	SourceZones         *RuleEntities `json:"sourceZones,omitempty"`
+	DestinationZones    *RuleEntities `json:"destinationZones,omitempty"`
+	SourceNetworks      *RuleEntities `json:"sourceNetworks,omitempty"`
+	DestinationNetworks *RuleEntities `json:"destinationNetworks,omitempty"`
+	SourcePorts         *RuleEntities `json:"sourcePorts,omitempty"`
+	DestinationPorts    *RuleEntities `json:"destinationPorts,omitempty"`
+	LogBegin            bool          `json:"logBegin"`
+	LogEnd              bool          `json:"logEnd"`
+	SendEventsToFMC     bool          `json:"sendEventsToFMC"`
+	Metadata            *RuleMetadata `json:"metadata,omitempty"`
+}
+
+// RuleEntities is the set of objects and literals matched by a condition of an access rule, e.g. its source networks.
+// A nil RuleEntities matches any.
+type RuleEntities struct {
+	Objects  []RuleObject  `json:"objects,omitempty"`
+	Literals []RuleLiteral `json:"literals,omitempty"`
+}
+
+func NewRuleEntities(objects []RuleObject, literals []RuleLiteral) *RuleEntities {
+	if len(objects) == 0 && len(literals) == 0 {
+		return nil
+	}
+	return &RuleEntities{
+		Objects:  objects,
+		Literals: literals,
+	}
+}
+
+// RuleObject is a reference to an FMC object, e.g. a security zone, network object or port object.
+type RuleObject struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func NewRuleObject(id, type_ string) RuleObject {
+	return RuleObject{
+		Id:   id,
+		Type: type_,
+	}
+}
+
+// RuleLiteral is a value used directly in an access rule, e.g. a host IP address or a network in CIDR notation.
+type RuleLiteral struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func NewRuleLiteral(type_, value string) RuleLiteral {
+	return RuleLiteral{
+		Type:  type_,
+		Value: value,
+	}
+}
+
+type RuleMetadata struct {
+	RuleIndex int    `json:"ruleIndex"`
+	Section   string `json:"section"`
+	Category  string `json:"category"`
+}
@@ -0,0 +1,56 @@
+package deployment
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+)
+
+const (
+	DeployableDeviceType  = "DeployableDevice"
+	DeploymentRequestType = "DeploymentRequest"
+)
+
+// DeployableDevice schema is from the deployment tab of <fmc-url-here>/api/api-explorer/, it is a device with changes that are not yet deployed to it.
+type DeployableDevice struct {
+	Type            string `json:"type"`
+	Name            string `json:"name"`
+	Version         string `json:"version"`
+	UpToDateVersion string `json:"upToDateVersion"`
+	CanBeDeployed   bool   `json:"canBeDeployed"`
+	Device          Device `json:"device"`
+}
+
+type Device = internal.NoLinkItem
+
+var NewDevice = internal.NewNoLinkItem
+
+type DeployableDevices struct {
+	Items  []DeployableDevice `json:"items"`
+	Links  internal.Links     `json:"links"`
+	Paging internal.Paging    `json:"paging"`
+}
+
+// Request deploys the changes up to Version to the devices in DeviceList.
+type Request struct {
+	Type           string           `json:"type"`
+	Version        string           `json:"version"`
+	ForceDeploy    bool             `json:"forceDeploy"`
+	IgnoreWarning  bool             `json:"ignoreWarning"`
+	DeviceList     []string         `json:"deviceList"`
+	DeploymentNote string           `json:"deploymentNote,omitempty"`
+	Metadata       *RequestMetadata `json:"metadata,omitempty"`
+}
+
+func NewRequest(version string, deviceList []string, deploymentNote string) Request {
+	return Request{
+		Type:           DeploymentRequestType,
+		Version:        version,
+		IgnoreWarning:  true,
+		DeviceList:     deviceList,
+		DeploymentNote: deploymentNote,
+	}
+}
+
+type RequestMetadata struct {
+	Task fmcconfig.Task `json:"task"`
+}
@@ -0,0 +1,35 @@
+package fmcinterface
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+
+const PhysicalInterfaceType = "PhysicalInterface"
+
+// PhysicalInterface schema is from the device tab of <fmc-url-here>/api/api-explorer/, Name is the hardware name, e.g. GigabitEthernet0/1,
+// and IfName is the logical name given to it, e.g. outside.
+type PhysicalInterface struct {
+	Id           string         `json:"id"`
+	Type         string         `json:"type"`
+	Links        internal.Links `json:"links"`
+	Name         string         `json:"name"`
+	IfName       string         `json:"ifname,omitempty"`
+	Description  string         `json:"description,omitempty"`
+	Enabled      bool           `json:"enabled"`
+	Mode         string         `json:"mode,omitempty"`
+	MTU          int            `json:"MTU,omitempty"`
+	SecurityZone *Reference     `json:"securityZone,omitempty"`
+	Ipv4         *Ipv4          `json:"ipv4,omitempty"`
+}
+
+type PhysicalInterfaces struct {
+	Items  []PhysicalInterface `json:"items"`
+	Links  internal.Links      `json:"links"`
+	Paging internal.Paging     `json:"paging"`
+}
+
+type Links = internal.Links
+
+var NewLinks = internal.NewLinks
+
+type Paging = internal.Paging
+
+var NewPaging = internal.NewPaging
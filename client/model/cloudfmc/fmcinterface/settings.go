@@ -0,0 +1,57 @@
+package fmcinterface
+
+const (
+	SecurityZoneType = "SecurityZone"
+
+	// ModeNone is the mode of an interface that is not a passive, inline or switch port interface.
+	ModeNone = "NONE"
+)
+
+// Reference is a reference to another FMC object, e.g. the security zone of an interface.
+type Reference struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func NewSecurityZoneReference(id string) *Reference {
+	return &Reference{
+		Id:   id,
+		Type: SecurityZoneType,
+	}
+}
+
+// Ipv4 is the IPv4 addressing of an interface, either Static or Dhcp is set.
+type Ipv4 struct {
+	Static *Ipv4Static `json:"static,omitempty"`
+	Dhcp   *Ipv4Dhcp   `json:"dhcp,omitempty"`
+}
+
+// Ipv4Static is a static IPv4 address, Netmask is either a prefix length, e.g. 24, or a dotted netmask, e.g. 255.255.255.0.
+type Ipv4Static struct {
+	Address string `json:"address"`
+	Netmask string `json:"netmask"`
+}
+
+type Ipv4Dhcp struct {
+	EnableDefaultRouteDhcp bool `json:"enableDefaultRouteDHCP"`
+	DhcpRouteMetric        int  `json:"dhcpRouteMetric,omitempty"`
+}
+
+func NewStaticIpv4(address, netmask string) *Ipv4 {
+	return &Ipv4{
+		Static: &Ipv4Static{
+			Address: address,
+			Netmask: netmask,
+		},
+	}
+}
+
+func NewDhcpIpv4(enableDefaultRoute bool) *Ipv4 {
+	return &Ipv4{
+		Dhcp: &Ipv4Dhcp{
+			EnableDefaultRouteDhcp: enableDefaultRoute,
+			DhcpRouteMetric:        1,
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package fmcinterface
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+
+const SubInterfaceType = "SubInterface"
+
+// SubInterface schema is from the device tab of <fmc-url-here>/api/api-explorer/, Name is the hardware name of its parent physical interface,
+// e.g. GigabitEthernet0/1, and SubIntfId is the number appended to it, e.g. 100 for GigabitEthernet0/1.100.
+type SubInterface struct {
+	Id           string          `json:"id,omitempty"`
+	Type         string          `json:"type"`
+	Links        *internal.Links `json:"links,omitempty"`
+	Name         string          `json:"name"`
+	SubIntfId    int             `json:"subIntfId"`
+	VlanId       int             `json:"vlanId"`
+	IfName       string          `json:"ifname,omitempty"`
+	Description  string          `json:"description,omitempty"`
+	Enabled      bool            `json:"enabled"`
+	MTU          int             `json:"MTU,omitempty"`
+	SecurityZone *Reference      `json:"securityZone,omitempty"`
+	Ipv4         *Ipv4           `json:"ipv4,omitempty"`
+}
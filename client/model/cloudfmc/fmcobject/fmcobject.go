@@ -0,0 +1,125 @@
+package fmcobject
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+
+// Kind is the kind of FMC object, it is the path segment of the object in the FMC API, e.g. /object/hosts.
+type Kind string
+
+const (
+	Hosts               Kind = "hosts"
+	Networks            Kind = "networks"
+	Ranges              Kind = "ranges"
+	Fqdns               Kind = "fqdns"
+	NetworkGroups       Kind = "networkgroups"
+	ProtocolPortObjects Kind = "protocolportobjects"
+	PortObjectGroups    Kind = "portobjectgroups"
+	Urls                Kind = "urls"
+	SecurityZones       Kind = "securityzones"
+)
+
+// Type is the type of FMC object, as found in the type field of the object.
+type Type = string
+
+const (
+	HostType               Type = "Host"
+	NetworkType            Type = "Network"
+	RangeType              Type = "Range"
+	FqdnType               Type = "FQDN"
+	NetworkGroupType       Type = "NetworkGroup"
+	ProtocolPortObjectType Type = "ProtocolPortObject"
+	PortObjectGroupType    Type = "PortObjectGroup"
+	UrlType                Type = "Url"
+	SecurityZoneType       Type = "SecurityZone"
+)
+
+var kindToType = map[Kind]Type{
+	Hosts:               HostType,
+	Networks:            NetworkType,
+	Ranges:              RangeType,
+	Fqdns:               FqdnType,
+	NetworkGroups:       NetworkGroupType,
+	ProtocolPortObjects: ProtocolPortObjectType,
+	PortObjectGroups:    PortObjectGroupType,
+	Urls:                UrlType,
+	SecurityZones:       SecurityZoneType,
+}
+
+// Type returns the type of the objects of this kind.
+func (k Kind) Type() Type {
+	return kindToType[k]
+}
+
+// InterfaceMode is the mode of the interfaces a security zone can contain.
+const (
+	InterfaceModeRouted   = "ROUTED"
+	InterfaceModeSwitched = "SWITCHED"
+	InterfaceModeInline   = "INLINE"
+	InterfaceModePassive  = "PASSIVE"
+)
+
+var InterfaceModes = []string{InterfaceModeRouted, InterfaceModeSwitched, InterfaceModeInline, InterfaceModePassive}
+
+// Object schema is from the object tab of <fmc-url-here>/api/api-explorer/. The fields used depend on the type of the object:
+//   - Host, Network, Range: Value, e.g. 10.10.10.10, 10.10.0.0/16 and 10.10.10.1-10.10.10.9
+//   - FQDN: Value and DnsResolution
+//   - NetworkGroup: Objects and Literals
+//   - ProtocolPortObject: Protocol and Port
+//   - PortObjectGroup: Objects
+//   - Url: Url
+//   - SecurityZone: InterfaceMode
+type Object struct {
+	Id            string      `json:"id,omitempty"`
+	Type          Type        `json:"type"`
+	Links         *Links      `json:"links,omitempty"`
+	Name          string      `json:"name"`
+	Description   string      `json:"description,omitempty"`
+	Overridable   bool        `json:"overridable"`
+	Value         string      `json:"value,omitempty"`
+	DnsResolution string      `json:"dnsResolution,omitempty"`
+	Protocol      string      `json:"protocol,omitempty"`
+	Port          string      `json:"port,omitempty"`
+	Url           string      `json:"url,omitempty"`
+	InterfaceMode string      `json:"interfaceMode,omitempty"`
+	Objects       []Reference `json:"objects,omitempty"`
+	Literals      []Literal   `json:"literals,omitempty"`
+}
+
+// Reference is a reference to another FMC object, e.g. a member of a group.
+type Reference struct {
+	Id   string `json:"id"`
+	Type Type   `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func NewReference(id string, type_ Type) Reference {
+	return Reference{
+		Id:   id,
+		Type: type_,
+	}
+}
+
+// Literal is a value used directly in a group, e.g. a host IP address or a network in CIDR notation.
+type Literal struct {
+	Type  Type   `json:"type"`
+	Value string `json:"value"`
+}
+
+func NewLiteral(type_ Type, value string) Literal {
+	return Literal{
+		Type:  type_,
+		Value: value,
+	}
+}
+
+// Objects is a page of the FMC objects of one kind.
+type Objects struct {
+	Items  []Object `json:"items"`
+	Links  Links    `json:"links"`
+	Paging Paging   `json:"paging"`
+}
+
+type Links = internal.Links
+type Paging = internal.Paging
+
+var NewLinks = internal.NewLinks
+var NewPaging = internal.NewPaging
@@ -0,0 +1,66 @@
+package fmcroute
+
+import "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+
+const (
+	Ipv4StaticRouteType = "IPv4StaticRoute"
+
+	HostType    = "Host"
+	NetworkType = "Network"
+)
+
+// Ipv4StaticRoute schema is from the device tab of <fmc-url-here>/api/api-explorer/, InterfaceName is the logical name of the interface the traffic leaves from,
+// SelectedNetworks are the network objects of the destinations, and Gateway is the next hop.
+type Ipv4StaticRoute struct {
+	Id               string          `json:"id,omitempty"`
+	Type             string          `json:"type"`
+	Links            *internal.Links `json:"links,omitempty"`
+	InterfaceName    string          `json:"interfaceName"`
+	SelectedNetworks []Reference     `json:"selectedNetworks"`
+	Gateway          Gateway         `json:"gateway"`
+	MetricValue      int             `json:"metricValue"`
+	IsTunneled       bool            `json:"isTunneled"`
+}
+
+// Reference is a reference to a network object of the FMC.
+type Reference struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+	Name string `json:"name,omitempty"`
+}
+
+func NewReference(id, type_ string) Reference {
+	return Reference{
+		Id:   id,
+		Type: type_,
+	}
+}
+
+// Gateway is the next hop of a route, either a host object or a literal IP address.
+type Gateway struct {
+	Object  *Reference `json:"object,omitempty"`
+	Literal *Literal   `json:"literal,omitempty"`
+}
+
+type Literal struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+func NewLiteralGateway(ipAddress string) Gateway {
+	return Gateway{
+		Literal: &Literal{
+			Type:  HostType,
+			Value: ipAddress,
+		},
+	}
+}
+
+func NewObjectGateway(hostObjectId string) Gateway {
+	return Gateway{
+		Object: &Reference{
+			Id:   hostObjectId,
+			Type: HostType,
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package hapair
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcconfig"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/internal"
+)
+
+const (
+	HaPairType = "DeviceHAPair"
+
+	// ActionBreak and ActionSwitch are the actions that can be sent to an existing HA pair.
+	ActionBreak  = "HABREAK"
+	ActionSwitch = "SWITCH"
+
+	// EncKeyGenerationSchemeCustom means the failover traffic is encrypted with the shared key given by the user.
+	EncKeyGenerationSchemeCustom = "CUSTOM"
+
+	StatusActive  = "Active"
+	StatusStandby = "Standby"
+)
+
+// HaPair schema is from the devicehapairs tab of <fmc-url-here>/api/api-explorer/, Primary and Secondary are the FMC device records of the FTDs.
+type HaPair struct {
+	Id             string     `json:"id,omitempty"`
+	Type           string     `json:"type"`
+	Links          *Links     `json:"links,omitempty"`
+	Name           string     `json:"name"`
+	Primary        Device     `json:"primary"`
+	Secondary      Device     `json:"secondary"`
+	FtdHaBootstrap *Bootstrap `json:"ftdHABootstrap,omitempty"`
+	Metadata       *Metadata  `json:"metadata,omitempty"`
+}
+
+func New(name string, primaryUid, secondaryUid string, bootstrap Bootstrap) HaPair {
+	return HaPair{
+		Type:           HaPairType,
+		Name:           name,
+		Primary:        Device{Id: primaryUid},
+		Secondary:      Device{Id: secondaryUid},
+		FtdHaBootstrap: &bootstrap,
+	}
+}
+
+type Device = internal.NoLinkItem
+
+var NewDevice = internal.NewNoLinkItem
+
+type Links = internal.Links
+
+type Bootstrap struct {
+	IsEncryptionEnabled     bool          `json:"isEncryptionEnabled"`
+	EncKeyGenerationScheme  string        `json:"encKeyGenerationScheme,omitempty"`
+	SharedKey               string        `json:"sharedKey,omitempty"`
+	UseSameLinkForFailovers bool          `json:"useSameLinkForFailovers"`
+	LanFailover             FailoverLink  `json:"lanFailover"`
+	StatefulFailover        *FailoverLink `json:"statefulFailover,omitempty"`
+}
+
+// FailoverLink is the failover (LAN) link or the stateful failover (state) link between the FTDs of the pair.
+type FailoverLink struct {
+	UseIPv6Address  bool      `json:"useIPv6Address"`
+	SubnetMask      string    `json:"subnetMask"`
+	InterfaceObject Interface `json:"interfaceObject"`
+	LogicalName     string    `json:"logicalName"`
+	ActiveIp        string    `json:"activeIP"`
+	StandbyIp       string    `json:"standbyIP"`
+}
+
+type Interface = internal.NoLinkItem
+
+var NewInterface = internal.NewNoLinkItem
+
+type Metadata struct {
+	Task            *fmcconfig.Task `json:"task,omitempty"`
+	PrimaryStatus   *DeviceStatus   `json:"primaryStatus,omitempty"`
+	SecondaryStatus *DeviceStatus   `json:"secondaryStatus,omitempty"`
+}
+
+// DeviceStatus is the failover status of a device in the pair, CurrentStatus is StatusActive or StatusStandby.
+type DeviceStatus struct {
+	Device        Device `json:"device"`
+	CurrentStatus string `json:"currentStatus"`
+}
+
+type HaPairs struct {
+	Items  []HaPair        `json:"items"`
+	Links  internal.Links  `json:"links"`
+	Paging internal.Paging `json:"paging"`
+}
+
+// Action is the body to break the pair, or switch the active device of the pair.
+type Action struct {
+	Id         string `json:"id"`
+	Type       string `json:"type"`
+	Action     string `json:"action"`
+	ForceBreak *bool  `json:"forceBreak,omitempty"`
+}
+
+func NewBreakAction(id string, forceBreak bool) Action {
+	return Action{
+		Id:         id,
+		Type:       HaPairType,
+		Action:     ActionBreak,
+		ForceBreak: &forceBreak,
+	}
+}
+
+func NewSwitchAction(id string) Action {
+	return Action{
+		Id:     id,
+		Type:   HaPairType,
+		Action: ActionSwitch,
+	}
+}
+
+// ActiveDeviceUid returns the uid of the device record that is currently active in the pair, it is empty if the status is not known.
+func (p HaPair) ActiveDeviceUid() string {
+	if p.Metadata == nil {
+		return ""
+	}
+	if p.Metadata.PrimaryStatus != nil && p.Metadata.PrimaryStatus.CurrentStatus == StatusActive {
+		return p.Primary.Id
+	}
+	if p.Metadata.SecondaryStatus != nil && p.Metadata.SecondaryStatus.CurrentStatus == StatusActive {
+		return p.Secondary.Id
+	}
+	return ""
+}
+
+type Paging = internal.Paging
+
+var NewPaging = internal.NewPaging
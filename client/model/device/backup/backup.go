@@ -0,0 +1,15 @@
+package backup
+
+// Backup is a version of the configuration of a device, backed up by CDO. The versions of the backups of a device start at 1, and increase with every backup.
+type Backup struct {
+	Uid         string `json:"uid"`
+	DeviceUid   string `json:"deviceUid"`
+	Version     int64  `json:"version"`
+	CreatedDate int64  `json:"createdDate"`
+}
+
+// Content is the configuration of a device in a backup.
+type Content struct {
+	Uid    string `json:"uid"`
+	Config string `json:"config"`
+}
@@ -0,0 +1,20 @@
+package backup
+
+type Frequency string
+
+const (
+	Daily   Frequency = "DAILY"
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+)
+
+// Schedule is the schedule of the backups of a device, a device has at most one schedule. Time is the time of day in UTC, in the format `HH:MM`.
+// DayOfWeek is only set for weekly backups, and DayOfMonth only for monthly backups. CDO deletes the oldest backups of the device beyond RetentionCount.
+type Schedule struct {
+	DeviceUid      string    `json:"deviceUid"`
+	Frequency      Frequency `json:"frequency"`
+	Time           string    `json:"time"`
+	DayOfWeek      string    `json:"dayOfWeek,omitempty"`
+	DayOfMonth     int       `json:"dayOfMonth,omitempty"`
+	RetentionCount int       `json:"retentionCount"`
+}
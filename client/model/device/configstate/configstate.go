@@ -0,0 +1,10 @@
+package configstate
+
+// Type is the state of the configuration of a device on CDO, compared to the configuration on the device itself.
+type Type string
+
+const (
+	Synced           Type = "SYNCED"
+	NotSynced        Type = "NOT_SYNCED"
+	ConflictDetected Type = "CONFLICT_DETECTED"
+)
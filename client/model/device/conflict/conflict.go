@@ -0,0 +1,9 @@
+package conflict
+
+// Conflict is a change made to the configuration of a device outside CDO, that conflicts with CDO's copy of it.
+// Diff is a unified diff from CDO's copy of the configuration to the configuration on the device.
+type Conflict struct {
+	DeviceUid    string `json:"deviceUid"`
+	DetectedDate int64  `json:"detectedDate"`
+	Diff         string `json:"diff"`
+}
@@ -0,0 +1,25 @@
+package connectivity
+
+import "fmt"
+
+// State is the connectivity state CDO reports for a device. CDO uses positive values when it can reach the device,
+// and negative values for the different reasons it cannot; the reason itself is reported in the connectivity error of the device.
+type State int
+
+const (
+	Unknown State = 0
+	Online  State = 1
+)
+
+func (s State) String() string {
+	switch {
+	case s == Online:
+		return "ONLINE"
+	case s == Unknown:
+		return "UNKNOWN"
+	case s < Unknown:
+		return "OFFLINE"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", int(s))
+	}
+}
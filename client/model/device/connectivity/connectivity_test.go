@@ -0,0 +1,21 @@
+package connectivity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestString(t *testing.T) {
+	testCases := map[State]string{
+		Online:  "ONLINE",
+		Unknown: "UNKNOWN",
+		-1:      "OFFLINE",
+		-5:      "OFFLINE",
+		3:       "UNKNOWN(3)",
+	}
+
+	for connectivityState, expected := range testCases {
+		assert.Equal(t, expected, connectivityState.String())
+	}
+}
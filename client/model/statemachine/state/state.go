@@ -12,3 +12,8 @@ const (
 	PRE_WAIT_FOR_USER_TO_UPDATE_CREDS Type = "$PRE_WAIT_FOR_USER_TO_UPDATE_CREDS"
 	PRE_READ_METADATA                 Type = "$PRE_READ_METADATA"
 )
+
+// IsBadCredentials returns whether a device in this state is waiting for new credentials, because CDO could not log in to it.
+func IsBadCredentials(s Type) bool {
+	return s == BAD_CREDENTIALS || s == WAIT_FOR_USER_TO_UPDATE_CREDS || s == PRE_WAIT_FOR_USER_TO_UPDATE_CREDS
+}
@@ -0,0 +1,31 @@
+package tenants
+
+import (
+	"context"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+func ReadAll(ctx context.Context, client http.Client) (*[]MspTenantOutput, error) {
+	client.Logger.Println("reading all tenants managed by the MSP portal")
+
+	limit := 200
+	offset := 0
+	count := 1
+	var tenantPage MspTenantsOutput
+	readTenants := []MspTenantOutput{}
+
+	for count > offset {
+		client.Logger.Printf("Getting tenants from %d to %d\n", offset, offset+limit)
+		req := client.NewGet(ctx, url.ReadAllMspManagedTenants(client.BaseUrl(), limit, offset))
+		if err := req.Send(&tenantPage); err != nil {
+			return nil, err
+		}
+		readTenants = append(readTenants, tenantPage.Items...)
+
+		offset += limit
+		count = tenantPage.Count
+	}
+
+	return &readTenants, nil
+}
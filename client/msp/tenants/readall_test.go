@@ -0,0 +1,60 @@
+package tenants_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/tenants"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	netHttp "net/http"
+	"testing"
+	"time"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	t.Run("successfully read all tenants across pages", func(t *testing.T) {
+		httpmock.Reset()
+		firstPage := make([]tenants.MspTenantOutput, 200)
+		for i := range firstPage {
+			firstPage[i] = tenants.MspTenantOutput{Uid: uuid.New().String(), Name: fmt.Sprintf("tenant-%d", i), DisplayName: fmt.Sprintf("Tenant %d", i), Region: "STAGING"}
+		}
+		secondPage := []tenants.MspTenantOutput{
+			{Uid: uuid.New().String(), Name: "last-tenant", DisplayName: "Pineapple Crushers Inc", Region: "STAGING"},
+		}
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			"/api/rest/v1/msp/tenants?limit=200&offset=0",
+			httpmock.NewJsonResponderOrPanic(200, tenants.MspTenantsOutput{Count: 201, Limit: 200, Offset: 0, Items: firstPage}),
+		)
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			"/api/rest/v1/msp/tenants?limit=200&offset=200",
+			httpmock.NewJsonResponderOrPanic(200, tenants.MspTenantsOutput{Count: 201, Limit: 200, Offset: 200, Items: secondPage}),
+		)
+
+		actual, err := tenants.ReadAll(context.Background(), *http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute))
+
+		assert.NoError(t, err)
+		assert.Equal(t, 201, len(*actual))
+		assert.Equal(t, secondPage[0], (*actual)[200])
+	})
+
+	t.Run("fail to read all tenants", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			"/api/rest/v1/msp/tenants?limit=200&offset=0",
+			httpmock.NewJsonResponderOrPanic(403, "Forbidden"),
+		)
+
+		actual, err := tenants.ReadAll(context.Background(), *http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute))
+
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,32 @@
+package usergroups
+
+import (
+	"context"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+func ReadAllUserGroupsInTenant(ctx context.Context, client http.Client, tenantUid string) (*[]MspManagedUserGroup, error) {
+	client.Logger.Printf("Reading all user groups in tenant %s\n", tenantUid)
+
+	limit := 200
+	offset := 0
+	count := 1
+	var userGroupPage MspManagedUserGroupPage
+	readUserGroups := []MspManagedUserGroup{}
+
+	for count > offset {
+		client.Logger.Printf("Getting user groups from %d to %d\n", offset, offset+limit)
+		req := client.NewGet(ctx, url.GetUserGroupsInMspManagedTenant(client.BaseUrl(), tenantUid, limit, offset))
+		if err := req.Send(&userGroupPage); err != nil {
+			return nil, err
+		}
+		readUserGroups = append(readUserGroups, userGroupPage.Items...)
+
+		offset += limit
+		count = userGroupPage.Count
+		client.Logger.Printf("Got %d user groups in tenant %s\n", count, tenantUid)
+	}
+
+	return &readUserGroups, nil
+}
@@ -0,0 +1,36 @@
+package users
+
+import (
+	"context"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+)
+
+type MspReadAllUsersInput struct {
+	TenantUid string `json:"tenantUid"`
+}
+
+func ReadAllUsersInTenant(ctx context.Context, client http.Client, readInput MspReadAllUsersInput) (*[]UserDetails, error) {
+	client.Logger.Printf("Reading all users in tenant %s\n", readInput.TenantUid)
+
+	limit := 200
+	offset := 0
+	count := 1
+	var userPage UserPage
+	readUserDetails := []UserDetails{}
+
+	for count > offset {
+		client.Logger.Printf("Getting users from %d to %d\n", offset, offset+limit)
+		req := client.NewGet(ctx, url.GetUsersInMspManagedTenant(client.BaseUrl(), readInput.TenantUid, limit, offset))
+		if err := req.Send(&userPage); err != nil {
+			return nil, err
+		}
+		readUserDetails = append(readUserDetails, userPage.Items...)
+
+		offset += limit
+		count = userPage.Count
+		client.Logger.Printf("Got %d users in tenant %s\n", count, readInput.TenantUid)
+	}
+
+	return &readUserDetails, nil
+}
@@ -0,0 +1,68 @@
+package users_test
+
+import (
+	"context"
+	"fmt"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/users"
+	"github.com/google/uuid"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+	netHttp "net/http"
+	"testing"
+	"time"
+)
+
+func TestReadAllUsersInTenant(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	t.Run("successfully read all users in MSP-managed tenant across pages", func(t *testing.T) {
+		httpmock.Reset()
+		managedTenantUid := uuid.New().String()
+		firstPage := make([]users.UserDetails, 200)
+		for i := range firstPage {
+			firstPage[i] = users.UserDetails{Uid: uuid.New().String(), Username: fmt.Sprintf("user%d@example.com", i), Roles: []string{"ROLE_ADMIN"}}
+		}
+		secondPage := []users.UserDetails{
+			{Uid: uuid.New().String(), Username: "api-only-user", Roles: []string{"ROLE_SUPER_ADMIN"}, ApiOnlyUser: true},
+		}
+
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			fmt.Sprintf("/api/rest/v1/msp/tenants/%s/users?limit=200&offset=0", managedTenantUid),
+			httpmock.NewJsonResponderOrPanic(200, users.UserPage{Count: 201, Offset: 0, Limit: 200, Items: firstPage}),
+		)
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			fmt.Sprintf("/api/rest/v1/msp/tenants/%s/users?limit=200&offset=200", managedTenantUid),
+			httpmock.NewJsonResponderOrPanic(200, users.UserPage{Count: 201, Offset: 200, Limit: 200, Items: secondPage}),
+		)
+
+		actual, err := users.ReadAllUsersInTenant(context.Background(),
+			*http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute),
+			users.MspReadAllUsersInput{TenantUid: managedTenantUid})
+
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, 201, len(*actual))
+		assert.Equal(t, secondPage[0], (*actual)[200])
+	})
+
+	t.Run("return error when reading users fails", func(t *testing.T) {
+		httpmock.Reset()
+		managedTenantUid := uuid.New().String()
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			fmt.Sprintf("/api/rest/v1/msp/tenants/%s/users?limit=200&offset=0", managedTenantUid),
+			httpmock.NewJsonResponderOrPanic(500, nil),
+		)
+
+		actual, err := users.ReadAllUsersInTenant(context.Background(),
+			*http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute),
+			users.MspReadAllUsersInput{TenantUid: managedTenantUid})
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+}
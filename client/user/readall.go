@@ -0,0 +1,45 @@
+package user
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/url"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+)
+
+type ReadAllInput struct{}
+
+type ReadAllOutput = []model.UserDetails
+
+func NewReadAllInput() ReadAllInput {
+	return ReadAllInput{}
+}
+
+func ReadAll(ctx context.Context, client http.Client, readInp ReadAllInput) (*ReadAllOutput, error) {
+
+	client.Logger.Println("reading all users")
+
+	limit := 200
+	offset := 0
+	outp := ReadAllOutput{}
+
+	// the users are read page by page until a page is not full
+	for {
+		client.Logger.Printf("Getting users from %d to %d\n", offset, offset+limit)
+		req := client.NewGet(ctx, url.ReadAllUsers(client.BaseUrl(), limit, offset))
+
+		var userPage ReadAllOutput
+		if err := req.Send(&userPage); err != nil {
+			return nil, err
+		}
+		outp = append(outp, userPage...)
+
+		if len(userPage) < limit {
+			break
+		}
+		offset += limit
+	}
+
+	return &outp, nil
+}
@@ -0,0 +1,97 @@
+package user_test
+
+import (
+	"context"
+	"fmt"
+	netHttp "net/http"
+	"testing"
+	"time"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/internal/http"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/user"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAll(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	t.Run("Should read all users", func(t *testing.T) {
+		httpmock.Reset()
+		expected := []model.UserDetails{
+			{
+				Uid:         "11111111-1111-1111-1111-111111111111",
+				Name:        "barack@example.com",
+				ApiOnlyUser: false,
+				UserRoles:   []string{"ROLE_ADMIN"},
+			},
+			{
+				Uid:         "22222222-2222-2222-2222-222222222222",
+				Name:        "api-user@CDO_" + tenantName,
+				ApiOnlyUser: true,
+				UserRoles:   []string{"ROLE_SUPER_ADMIN"},
+			},
+		}
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			"/anubis/rest/v1/users",
+			httpmock.NewJsonResponderOrPanic(200, expected),
+		)
+		actual, err := user.ReadAll(context.Background(), *http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute), user.NewReadAllInput())
+		assert.NotNil(t, actual, "Read output should not be nil")
+		assert.Equal(t, expected, *actual)
+		assert.Nil(t, err, "error should be nil")
+	})
+
+	t.Run("Should read all users page by page until a page is not full", func(t *testing.T) {
+		httpmock.Reset()
+		firstPage := make([]model.UserDetails, 200)
+		for i := range firstPage {
+			firstPage[i] = model.UserDetails{
+				Uid:       fmt.Sprintf("00000000-0000-0000-0000-%012d", i),
+				Name:      fmt.Sprintf("user-%d@example.com", i),
+				UserRoles: []string{"ROLE_READ_ONLY"},
+			}
+		}
+		secondPage := []model.UserDetails{
+			{
+				Uid:       "11111111-1111-1111-1111-111111111111",
+				Name:      "barack@example.com",
+				UserRoles: []string{"ROLE_ADMIN"},
+			},
+		}
+		httpmock.RegisterResponderWithQuery(
+			netHttp.MethodGet,
+			"/anubis/rest/v1/users",
+			"limit=200&offset=0",
+			httpmock.NewJsonResponderOrPanic(200, firstPage),
+		)
+		httpmock.RegisterResponderWithQuery(
+			netHttp.MethodGet,
+			"/anubis/rest/v1/users",
+			"limit=200&offset=200",
+			httpmock.NewJsonResponderOrPanic(200, secondPage),
+		)
+
+		actual, err := user.ReadAll(context.Background(), *http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute), user.NewReadAllInput())
+		assert.Nil(t, err, "error should be nil")
+		assert.NotNil(t, actual, "Read output should not be nil")
+		assert.Equal(t, append(firstPage, secondPage...), *actual)
+		assert.Equal(t, 2, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("Should error if reading all users fails", func(t *testing.T) {
+		httpmock.Reset()
+		httpmock.RegisterResponder(
+			netHttp.MethodGet,
+			"/anubis/rest/v1/users",
+			httpmock.NewJsonResponderOrPanic(500, nil),
+		)
+
+		actual, err := user.ReadAll(context.Background(), *http.MustNewWithConfig(baseUrl, "valid_token", 0, 0, time.Minute), user.NewReadAllInput())
+		assert.Nil(t, actual, "Read output should be nil")
+		assert.NotNil(t, err, "error should not be nil")
+	})
+}
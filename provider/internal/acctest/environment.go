@@ -120,6 +120,26 @@ func (e *env) FtdDataSourceLicenses() string {
 	return e.mustGetString("FTD_DATA_SOURCE_LICENSES")
 }
 
+func (e *env) FtdHaPairResourcePrimaryName() string {
+	return e.mustGetString("FTD_HA_PAIR_RESOURCE_PRIMARY_NAME")
+}
+
+func (e *env) FtdHaPairResourceSecondaryName() string {
+	return e.mustGetString("FTD_HA_PAIR_RESOURCE_SECONDARY_NAME")
+}
+
+func (e *env) FtdHaPairResourceFailoverInterfaceName() string {
+	return e.mustGetString("FTD_HA_PAIR_RESOURCE_FAILOVER_INTERFACE_NAME")
+}
+
+func (e *env) FtdInterfaceResourceFtdName() string {
+	return e.mustGetString("FTD_INTERFACE_RESOURCE_FTD_NAME")
+}
+
+func (e *env) FtdInterfaceResourceInterfaceName() string {
+	return e.mustGetString("FTD_INTERFACE_RESOURCE_INTERFACE_NAME")
+}
+
 func (e *env) FtdResourceName() string {
 	return e.mustGetString("FTD_RESOURCE_NAME")
 }
@@ -268,6 +288,14 @@ func (e *env) AsaDataSourceTags() []string {
 	return e.mustGetCommaSeparatedSlice("ASA_DATA_SOURCE_TAGS")
 }
 
+func (e *env) AsaContextSystemDeviceName() string {
+	return e.mustGetString("ASA_CONTEXT_SYSTEM_DEVICE_NAME")
+}
+
+func (e *env) AsaContextResourceContextName() string {
+	return e.mustGetString("ASA_CONTEXT_RESOURCE_CONTEXT_NAME")
+}
+
 func (e *env) ConnectorDataSourceName() string {
 	return e.mustGetString("CONNECTOR_DATA_SOURCE_NAME")
 }
@@ -0,0 +1,109 @@
+package accesspolicy
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcAccessPolicy(ctx, fmcaccesspolicy.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setAccessPolicy(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcAccessPolicy(ctx, fmcaccesspolicy.NewCreateInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		planData.Name.ValueString(),
+		planData.Description.ValueString(),
+		defaultActionFromModel(planData),
+	))
+	if err != nil {
+		return err
+	}
+
+	setAccessPolicy(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcAccessPolicy(ctx, fmcaccesspolicy.NewUpdateInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		planData.Id.ValueString(),
+		planData.Name.ValueString(),
+		planData.Description.ValueString(),
+		defaultActionFromModel(planData),
+	))
+	if err != nil {
+		return err
+	}
+
+	setAccessPolicy(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcAccessPolicy(ctx, fmcaccesspolicy.NewDeleteInput(fmcInfo.Hostname, fmcInfo.DomainUid, stateData.Id.ValueString()))
+	return err
+}
+
+func defaultActionFromModel(model *ResourceModel) accesspolicy.DefaultAction {
+	return accesspolicy.NewDefaultAction(
+		"", // the id of the default action is assigned by the cdFMC
+		model.DefaultAction.ValueString(),
+		model.DefaultActionLogBegin.ValueBool(),
+		model.DefaultActionLogEnd.ValueBool(),
+		model.DefaultActionSendEventsToFmc.ValueBool(),
+	)
+}
+
+func setAccessPolicy(model *ResourceModel, policy *accesspolicy.AccessPolicy) {
+	model.Id = types.StringValue(policy.Id)
+	model.Name = types.StringValue(policy.Name)
+	if policy.Description != "" {
+		model.Description = types.StringValue(policy.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.DefaultAction = types.StringValue(policy.DefaultAction.Action)
+	model.DefaultActionLogBegin = types.BoolValue(policy.DefaultAction.LogBegin)
+	model.DefaultActionLogEnd = types.BoolValue(policy.DefaultAction.LogEnd)
+	model.DefaultActionSendEventsToFmc = types.BoolValue(policy.DefaultAction.SendEventsToFMC)
+}
@@ -0,0 +1,70 @@
+package accesspolicy_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccessPolicyResource = struct {
+	Name          string
+	DefaultAction string
+	LogEnd        string
+}{
+	Name:          "terraform-provider-cdo-acc-test-access-policy",
+	DefaultAction: "BLOCK",
+	LogEnd:        "false",
+}
+
+const testAccessPolicyResourceTemplate = `
+resource "cdo_cdfmc_access_policy" "test" {
+	name                   = "{{.Name}}"
+	description            = "created by the terraform provider acceptance tests"
+	default_action         = "{{.DefaultAction}}"
+	default_action_log_end = {{.LogEnd}}
+}`
+
+var testAccessPolicyResourceConfig = acctest.MustParseTemplate(testAccessPolicyResourceTemplate, testAccessPolicyResource)
+
+var testAccessPolicyResource_NewDefaultAction = acctest.MustOverrideFields(testAccessPolicyResource, map[string]any{
+	"Name":          "terraform-provider-cdo-acc-test-access-policy-new-name",
+	"DefaultAction": "TRUST",
+	"LogEnd":        "true",
+})
+var testAccessPolicyResourceConfig_NewDefaultAction = acctest.MustParseTemplate(testAccessPolicyResourceTemplate, testAccessPolicyResource_NewDefaultAction)
+
+func TestAccCdFmcAccessPolicyResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessPolicyResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_access_policy.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "name", testAccessPolicyResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "default_action", testAccessPolicyResource.DefaultAction),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "default_action_log_end", testAccessPolicyResource.LogEnd),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_access_policy.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessPolicyResourceConfig_NewDefaultAction,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "name", testAccessPolicyResource_NewDefaultAction.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "default_action", testAccessPolicyResource_NewDefaultAction.DefaultAction),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_policy.test", "default_action_log_end", testAccessPolicyResource_NewDefaultAction.LogEnd),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,238 @@
+package accessrule
+
+import (
+	"context"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcaccesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/accesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util/sliceutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcAccessRule(ctx, fmcaccesspolicy.NewReadRuleInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		stateData.AccessPolicyId.ValueString(),
+		stateData.Id.ValueString(),
+	))
+	if err != nil {
+		return err
+	}
+
+	setRule(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	rule, err := ruleFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+	var insertBefore *int
+	if !planData.InsertBefore.IsNull() {
+		index := int(planData.InsertBefore.ValueInt64())
+		insertBefore = &index
+	}
+
+	createOutp, err := resource.client.CreateFmcAccessRule(ctx, fmcaccesspolicy.NewCreateRuleInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		planData.AccessPolicyId.ValueString(),
+		planData.Section.ValueString(),
+		insertBefore,
+		rule,
+	))
+	if err != nil {
+		return err
+	}
+
+	setRule(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	rule, err := ruleFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcAccessRule(ctx, fmcaccesspolicy.NewUpdateRuleInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		planData.AccessPolicyId.ValueString(),
+		planData.Id.ValueString(),
+		rule,
+	))
+	if err != nil {
+		return err
+	}
+
+	setRule(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcAccessRule(ctx, fmcaccesspolicy.NewDeleteRuleInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		stateData.AccessPolicyId.ValueString(),
+		stateData.Id.ValueString(),
+	))
+	return err
+}
+
+func ruleFromModel(ctx context.Context, model *ResourceModel) (accesspolicy.Rule, error) {
+	sourceZones, err := zonesFromModel(ctx, model.SourceZones)
+	if err != nil {
+		return accesspolicy.Rule{}, err
+	}
+	destinationZones, err := zonesFromModel(ctx, model.DestinationZones)
+	if err != nil {
+		return accesspolicy.Rule{}, err
+	}
+	sourceNetworks, err := networksFromModel(ctx, model.SourceNetworks, model.SourceNetworkLiterals)
+	if err != nil {
+		return accesspolicy.Rule{}, err
+	}
+	destinationNetworks, err := networksFromModel(ctx, model.DestinationNetworks, model.DestinationNetworkLiterals)
+	if err != nil {
+		return accesspolicy.Rule{}, err
+	}
+
+	return accesspolicy.Rule{
+		Name:                model.Name.ValueString(),
+		Action:              model.Action.ValueString(),
+		Enabled:             model.Enabled.ValueBool(),
+		SourceZones:         sourceZones,
+		DestinationZones:    destinationZones,
+		SourceNetworks:      sourceNetworks,
+		DestinationNetworks: destinationNetworks,
+		SourcePorts:         accesspolicy.NewRuleEntities(objectsFromModel(model.SourcePorts), nil),
+		DestinationPorts:    accesspolicy.NewRuleEntities(objectsFromModel(model.DestinationPorts), nil),
+		LogBegin:            model.LogBegin.ValueBool(),
+		LogEnd:              model.LogEnd.ValueBool(),
+		SendEventsToFMC:     model.SendEventsToFmc.ValueBool(),
+	}, nil
+}
+
+func zonesFromModel(ctx context.Context, zones types.Set) (*accesspolicy.RuleEntities, error) {
+	zoneIds, err := util.TFStringSetToGoStringList(ctx, zones)
+	if err != nil {
+		return nil, err
+	}
+	return accesspolicy.NewRuleEntities(sliceutil.Map(zoneIds, func(id string) accesspolicy.RuleObject {
+		return accesspolicy.NewRuleObject(id, accesspolicy.SecurityZoneType)
+	}), nil), nil
+}
+
+func networksFromModel(ctx context.Context, objects []ObjectModel, literals types.Set) (*accesspolicy.RuleEntities, error) {
+	literalValues, err := util.TFStringSetToGoStringList(ctx, literals)
+	if err != nil {
+		return nil, err
+	}
+	return accesspolicy.NewRuleEntities(objectsFromModel(objects), sliceutil.Map(literalValues, func(value string) accesspolicy.RuleLiteral {
+		return accesspolicy.NewRuleLiteral(networkLiteralType(value), value)
+	})), nil
+}
+
+// networkLiteralType returns the FMC type of a network literal from the notation it is written in.
+func networkLiteralType(value string) string {
+	if strings.Contains(value, "/") {
+		return "Network"
+	}
+	if strings.Contains(value, "-") {
+		return "Range"
+	}
+	return "Host"
+}
+
+func objectsFromModel(objects []ObjectModel) []accesspolicy.RuleObject {
+	return sliceutil.Map(objects, func(object ObjectModel) accesspolicy.RuleObject {
+		return accesspolicy.NewRuleObject(object.Id.ValueString(), object.Type.ValueString())
+	})
+}
+
+func setRule(model *ResourceModel, rule *accesspolicy.Rule) {
+	model.Id = types.StringValue(rule.Id)
+	model.Name = types.StringValue(rule.Name)
+	model.Action = types.StringValue(rule.Action)
+	model.Enabled = types.BoolValue(rule.Enabled)
+	if rule.Metadata != nil {
+		model.Section = types.StringValue(strings.ToLower(rule.Metadata.Section))
+		model.RuleIndex = types.Int64Value(int64(rule.Metadata.RuleIndex))
+	}
+	model.SourceZones = idsToTF(rule.SourceZones)
+	model.DestinationZones = idsToTF(rule.DestinationZones)
+	model.SourceNetworks = objectsToTF(rule.SourceNetworks)
+	model.DestinationNetworks = objectsToTF(rule.DestinationNetworks)
+	model.SourceNetworkLiterals = literalsToTF(rule.SourceNetworks)
+	model.DestinationNetworkLiterals = literalsToTF(rule.DestinationNetworks)
+	model.SourcePorts = objectsToTF(rule.SourcePorts)
+	model.DestinationPorts = objectsToTF(rule.DestinationPorts)
+	model.LogBegin = types.BoolValue(rule.LogBegin)
+	model.LogEnd = types.BoolValue(rule.LogEnd)
+	model.SendEventsToFmc = types.BoolValue(rule.SendEventsToFMC)
+}
+
+// idsToTF, objectsToTF and literalsToTF return null for conditions without values, as that is how they are left out in the config.
+
+func idsToTF(entities *accesspolicy.RuleEntities) types.Set {
+	if entities == nil || len(entities.Objects) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return util.GoStringSliceToTFStringSet(sliceutil.Map(entities.Objects, func(object accesspolicy.RuleObject) string {
+		return object.Id
+	}))
+}
+
+func objectsToTF(entities *accesspolicy.RuleEntities) []ObjectModel {
+	if entities == nil || len(entities.Objects) == 0 {
+		return nil
+	}
+	return sliceutil.Map(entities.Objects, func(object accesspolicy.RuleObject) ObjectModel {
+		return ObjectModel{
+			Id:   types.StringValue(object.Id),
+			Type: types.StringValue(object.Type),
+		}
+	})
+}
+
+func literalsToTF(entities *accesspolicy.RuleEntities) types.Set {
+	if entities == nil || len(entities.Literals) == 0 {
+		return types.SetNull(types.StringType)
+	}
+	return util.GoStringSliceToTFStringSet(sliceutil.Map(entities.Literals, func(literal accesspolicy.RuleLiteral) string {
+		return literal.Value
+	}))
+}
@@ -0,0 +1,318 @@
+package accessrule
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id                         types.String  `tfsdk:"id"`
+	Domain                     types.String  `tfsdk:"domain"`
+	AccessPolicyId             types.String  `tfsdk:"access_policy_id"`
+	Name                       types.String  `tfsdk:"name"`
+	Action                     types.String  `tfsdk:"action"`
+	Enabled                    types.Bool    `tfsdk:"enabled"`
+	Section                    types.String  `tfsdk:"section"`
+	InsertBefore               types.Int64   `tfsdk:"insert_before"`
+	RuleIndex                  types.Int64   `tfsdk:"rule_index"`
+	SourceZones                types.Set     `tfsdk:"source_zones"`
+	DestinationZones           types.Set     `tfsdk:"destination_zones"`
+	SourceNetworks             []ObjectModel `tfsdk:"source_networks"`
+	DestinationNetworks        []ObjectModel `tfsdk:"destination_networks"`
+	SourceNetworkLiterals      types.Set     `tfsdk:"source_network_literals"`
+	DestinationNetworkLiterals types.Set     `tfsdk:"destination_network_literals"`
+	SourcePorts                []ObjectModel `tfsdk:"source_ports"`
+	DestinationPorts           []ObjectModel `tfsdk:"destination_ports"`
+	LogBegin                   types.Bool    `tfsdk:"log_begin"`
+	LogEnd                     types.Bool    `tfsdk:"log_end"`
+	SendEventsToFmc            types.Bool    `tfsdk:"send_events_to_fmc"`
+}
+
+type ObjectModel struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdfmc_access_rule"
+}
+
+func objectsAttribute(description string) schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		MarkdownDescription: description,
+		Optional:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{
+					MarkdownDescription: "The ID of the object on the cdFMC.",
+					Required:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "The type of the object on the cdFMC, e.g. `Network`, `Host`, `NetworkGroup`, `ProtocolPortObject` or `PortObjectGroup`.",
+					Required:            true,
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a rule of an access control policy on the cloud-delivered FMC in your tenant. " +
+			"Rules are evaluated in order, use `section` and `insert_before` to choose where the rule is created in the policy. " +
+			"A condition that is not set matches any traffic.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the access rule on the cdFMC.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name or UUID of the cdFMC domain the access policy of the rule is in, e.g. `Global/Customer1`. If not specified, the default domain of the cdFMC is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_policy_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the access policy the rule belongs to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the access rule, unique within the access policy.",
+				Required:            true,
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "The action applied to traffic that matches the rule. Allowed values are: [\"ALLOW\", \"TRUST\", \"BLOCK\", \"MONITOR\", \"BLOCK_RESET\", \"BLOCK_INTERACTIVE\", \"BLOCK_RESET_INTERACTIVE\"].",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("ALLOW", "TRUST", "BLOCK", "MONITOR", "BLOCK_RESET", "BLOCK_INTERACTIVE", "BLOCK_RESET_INTERACTIVE"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"section": schema.StringAttribute{
+				MarkdownDescription: "The section of the access policy the rule is created in. Allowed values are: [\"mandatory\", \"default\"]. Changing this forces the rule to be recreated.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("mandatory"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("mandatory", "default"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"insert_before": schema.Int64Attribute{
+				MarkdownDescription: "The 1-based index of the rule that this rule is inserted before when it is created. If not set, the rule is added to the end of `section`. Changing this forces the rule to be recreated.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"rule_index": schema.Int64Attribute{
+				MarkdownDescription: "The 1-based position of the rule in the access policy.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_zones": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the security zones that the traffic comes from.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_zones": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the security zones that the traffic goes to.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source_networks":      objectsAttribute("The network objects and groups that the traffic comes from."),
+			"destination_networks": objectsAttribute("The network objects and groups that the traffic goes to."),
+			"source_network_literals": schema.SetAttribute{
+				MarkdownDescription: "The host addresses, networks in CIDR notation or address ranges that the traffic comes from, e.g. `10.10.10.10`, `10.10.0.0/16` or `10.10.10.1-10.10.10.9`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"destination_network_literals": schema.SetAttribute{
+				MarkdownDescription: "The host addresses, networks in CIDR notation or address ranges that the traffic goes to, e.g. `10.10.10.10`, `10.10.0.0/16` or `10.10.10.1-10.10.10.9`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"source_ports":      objectsAttribute("The port objects and groups that the traffic comes from."),
+			"destination_ports": objectsAttribute("The port objects and groups that the traffic goes to."),
+			"log_begin": schema.BoolAttribute{
+				MarkdownDescription: "Whether to log at the beginning of connections that match the rule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"log_end": schema.BoolAttribute{
+				MarkdownDescription: "Whether to log at the end of connections that match the rule.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"send_events_to_fmc": schema.BoolAttribute{
+				MarkdownDescription: "Whether to send the connection events of the rule to the cdFMC event viewer.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "create cdfmc access rule resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Create(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to create cdFMC access rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read cdfmc access rule resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read cdFMC access rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update cdfmc access rule resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update cdFMC access rule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete cdfmc access rule resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Delete(ctx, r, &stateData); err != nil {
+		resp.Diagnostics.AddError("failed to delete cdFMC access rule", err.Error())
+		return
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the rule is imported by the ID of its access policy and its own ID, separated by a slash, prefixed by the domain of
+	// the access policy if it is not in the default domain. Domain names contain slashes, e.g. Global/Customer1, but the IDs
+	// on the cdFMC contain neither slashes nor colons.
+	domain, ids := "", req.ID
+	if separator := strings.LastIndex(req.ID, ":"); separator != -1 {
+		domain, ids = req.ID[:separator], req.ID[separator+1:]
+	}
+	accessPolicyId, ruleId, ok := strings.Cut(ids, "/")
+	if !ok || accessPolicyId == "" || ruleId == "" {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("expected an import ID of the form <access_policy_id>/<access_rule_id> or <domain>:<access_policy_id>/<access_rule_id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	if domain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("access_policy_id"), accessPolicyId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ruleId)...)
+}
@@ -0,0 +1,85 @@
+package accessrule_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testAccessRuleResource = struct {
+	PolicyName string
+	Name       string
+	Action     string
+	Literal    string
+}{
+	PolicyName: "terraform-provider-cdo-acc-test-access-rule-policy",
+	Name:       "terraform-provider-cdo-acc-test-access-rule",
+	Action:     "ALLOW",
+	Literal:    "10.10.10.10",
+}
+
+const testAccessRuleResourceTemplate = `
+resource "cdo_cdfmc_access_policy" "test" {
+	name           = "{{.PolicyName}}"
+	default_action = "BLOCK"
+}
+
+resource "cdo_cdfmc_access_rule" "test" {
+	access_policy_id             = cdo_cdfmc_access_policy.test.id
+	name                         = "{{.Name}}"
+	action                       = "{{.Action}}"
+	destination_network_literals = ["{{.Literal}}"]
+	log_end                      = true
+	send_events_to_fmc           = true
+}`
+
+var testAccessRuleResourceConfig = acctest.MustParseTemplate(testAccessRuleResourceTemplate, testAccessRuleResource)
+
+var testAccessRuleResource_NewAction = acctest.MustOverrideFields(testAccessRuleResource, map[string]any{
+	"Action":  "BLOCK",
+	"Literal": "10.10.0.0/16",
+})
+var testAccessRuleResourceConfig_NewAction = acctest.MustParseTemplate(testAccessRuleResourceTemplate, testAccessRuleResource_NewAction)
+
+func TestAccCdFmcAccessRuleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessRuleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_access_rule.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_rule.test", "name", testAccessRuleResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_rule.test", "action", testAccessRuleResource.Action),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_rule.test", "section", "mandatory"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_rule.test", "rule_index", "1"),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_access_rule.test", "destination_network_literals.*", testAccessRuleResource.Literal),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_access_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					rule := state.RootModule().Resources["cdo_cdfmc_access_rule.test"]
+					return fmt.Sprintf("%s/%s", rule.Primary.Attributes["access_policy_id"], rule.Primary.ID), nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessRuleResourceConfig_NewAction,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_access_rule.test", "action", testAccessRuleResource_NewAction.Action),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_access_rule.test", "destination_network_literals.*", testAccessRuleResource_NewAction.Literal),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
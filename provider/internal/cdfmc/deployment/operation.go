@@ -0,0 +1,173 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcdeployment"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	deployedStatus          = "DEPLOYED"
+	deploymentPendingStatus = "DEPLOYMENT_PENDING"
+)
+
+// ftdDevice is an FTD device in CDO, together with the uid of its device record on the cdFMC, which is empty if the device has no changes pending deploy.
+type ftdDevice struct {
+	cdoUid string
+	name   string
+	fmcUid string
+}
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	devices, err := readFtdDevices(ctx, resource, fmcInfo, stateData.FtdIds)
+	if err != nil {
+		return err
+	}
+
+	return setDevices(ctx, stateData, devices)
+}
+
+func Deploy(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	devices, err := readFtdDevices(ctx, resource, fmcInfo, planData.FtdIds)
+	if err != nil {
+		return err
+	}
+
+	var fmcUids []string
+	for _, device := range devices {
+		if device.fmcUid != "" {
+			fmcUids = append(fmcUids, device.fmcUid)
+		}
+	}
+
+	if len(fmcUids) > 0 {
+		tflog.Debug(ctx, fmt.Sprintf("deploying changes to %d FTD devices", len(fmcUids)))
+		_, err = resource.client.DeployFmcChanges(ctx, fmcdeployment.NewDeployInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcUids, ""))
+		if err != nil {
+			return err
+		}
+
+		// re-read the devices, changes made while deploying are still pending
+		devices, err = readFtdDevices(ctx, resource, fmcInfo, planData.FtdIds)
+		if err != nil {
+			return err
+		}
+	} else {
+		tflog.Debug(ctx, "the FTD devices have no changes pending deploy")
+	}
+
+	return setDevices(ctx, planData, devices)
+}
+
+// readFtdDevices reads the FTD devices with the given CDO uids, sorted by uid, and matches their device records on the cdFMC to the devices with changes that can be deployed.
+func readFtdDevices(ctx context.Context, resource *Resource, fmcInfo *cdfmc.FmcInfo, ftdIds types.Set) ([]ftdDevice, error) {
+	cdoUids, err := util.TFStringSetToGoStringList(ctx, ftdIds)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(cdoUids)
+
+	deployableDevices, err := resource.client.ReadFmcDeployableDevices(ctx, fmcdeployment.NewReadDeployableDevicesInput(fmcInfo.Hostname, fmcInfo.DomainUid))
+	if err != nil {
+		return nil, err
+	}
+	// devices that cannot be deployed, e.g. because a deployment is already in progress, are skipped when deploying,
+	// so they are not reported as pending, otherwise the deployment would be planned on every run without ever clearing.
+	deployableFmcUids := make(map[string]bool, len(deployableDevices.Items))
+	for _, deployableDevice := range deployableDevices.Items {
+		if deployableDevice.CanBeDeployed {
+			deployableFmcUids[deployableDevice.Device.Id] = true
+		}
+	}
+
+	devices := make([]ftdDevice, len(cdoUids))
+	for i, cdoUid := range cdoUids {
+		readOutp, err := resource.client.ReadCloudFtdByUid(ctx, cloudftd.NewReadByUidInput(cdoUid))
+		if err != nil {
+			return nil, err
+		}
+		devices[i] = ftdDevice{
+			cdoUid: cdoUid,
+			name:   readOutp.Name,
+		}
+
+		deviceRecord, err := resource.client.ReadCloudFtdDeviceRecord(ctx, cloudftd.NewReadDeviceRecordInput(fmcInfo.Hostname, fmcInfo.DomainUid, cdoUid))
+		if err != nil {
+			if util.Is404Error(err) {
+				// the FTD is not registered with the cdFMC, so it has nothing to deploy
+				continue
+			}
+			return nil, err
+		}
+		if deployableFmcUids[deviceRecord.Id] {
+			devices[i].fmcUid = deviceRecord.Id
+		}
+	}
+
+	return devices, nil
+}
+
+func setDevices(ctx context.Context, resourceModel *ResourceModel, devices []ftdDevice) error {
+	cdoUids := make([]string, len(devices))
+	deviceModels := make([]DeviceModel, len(devices))
+	for i, device := range devices {
+		cdoUids[i] = device.cdoUid
+		deploymentStatus := deployedStatus
+		if device.fmcUid != "" {
+			deploymentStatus = deploymentPendingStatus
+		}
+		deviceModels[i] = DeviceModel{
+			FtdId:            types.StringValue(device.cdoUid),
+			Name:             types.StringValue(device.name),
+			DeploymentStatus: types.StringValue(deploymentStatus),
+		}
+	}
+
+	devicesList, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: deviceModelAttrTypes}, deviceModels)
+	if diags.HasError() {
+		return fmt.Errorf("%s", util.DiagSummary(diags))
+	}
+
+	resourceModel.Id = types.StringValue(strings.Join(cdoUids, ","))
+	resourceModel.Devices = devicesList
+
+	return nil
+}
+
+func hasPendingDeployment(ctx context.Context, devices types.List) (bool, diag.Diagnostics) {
+	if devices.IsNull() || devices.IsUnknown() {
+		return false, nil
+	}
+	var deviceModels []DeviceModel
+	diags := devices.ElementsAs(ctx, &deviceModels, false)
+	if diags.HasError() {
+		return false, diags
+	}
+	for _, deviceModel := range deviceModels {
+		if deviceModel.DeploymentStatus.ValueString() == deploymentPendingStatus {
+			return true, nil
+		}
+	}
+	return false, nil
+}
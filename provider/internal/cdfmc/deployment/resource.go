@@ -0,0 +1,210 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Domain   types.String `tfsdk:"domain"`
+	FtdIds   types.Set    `tfsdk:"ftd_ids"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Devices  types.List   `tfsdk:"devices"`
+}
+
+type DeviceModel struct {
+	FtdId            types.String `tfsdk:"ftd_id"`
+	Name             types.String `tfsdk:"name"`
+	DeploymentStatus types.String `tfsdk:"deployment_status"`
+}
+
+var deviceModelAttrTypes = map[string]attr.Type{
+	"ftd_id":            types.StringType,
+	"name":              types.StringType,
+	"deployment_status": types.StringType,
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_cdfmc_deployment"
+}
+
+func (r *Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to deploy the changes pending on the cloud-delivered FMC to FTD devices. " +
+			"Changes are deployed when the resource is created, when `ftd_ids` or a value in `triggers` changes, and when changes pending deploy are found on refresh. " +
+			"Destroying this resource does not change the devices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the deployment resource. This is the sorted, comma-separated `ftd_ids`.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name or UUID of the cdFMC domain the FTD devices are registered in, e.g. `Global/Customer1`. If not specified, the default domain of the cdFMC is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ftd_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the FTD devices managed by the cdFMC to deploy changes to, i.e. the `id` of `cdo_ftd_device` resources.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary values that cause the pending changes to be deployed again when any of them changes, for example the IDs of the policies and objects that the FTD devices use.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"devices": schema.ListNestedAttribute{
+				MarkdownDescription: "The deployment status of each of the FTD devices, in the order of their IDs.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"ftd_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the FTD device.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the FTD device.",
+							Computed:            true,
+						},
+						"deployment_status": schema.StringAttribute{
+							MarkdownDescription: "Whether the changes on the cdFMC have been deployed to the FTD device (Possible values: [DEPLOYED, DEPLOYMENT_PENDING]).",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create cdFMC deployment resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Deploy(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to deploy changes to FTD devices", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read cdFMC deployment resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read cdFMC deployment", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update cdFMC deployment resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Deploy(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to deploy changes to FTD devices", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing a cdFMC deployment resource is a noop. It will not revert the changes deployed to the FTD devices.")
+}
+
+// ModifyPlan plans a deployment when changes pending deploy were found on refresh, so that they show up in the plan.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pending, diags := hasPendingDeployment(ctx, stateData.Devices)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if pending {
+		tflog.Debug(ctx, "An FTD device has changes pending deploy; plan a deployment")
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("devices"), types.ListUnknown(types.ObjectType{AttrTypes: deviceModelAttrTypes}))...)
+	}
+}
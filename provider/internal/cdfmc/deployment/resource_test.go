@@ -0,0 +1,64 @@
+package deployment_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDeploymentResource = struct {
+	FtdName string
+	Trigger string
+}{
+	FtdName: acctest.Env.FtdDataSourceName(),
+	Trigger: "1",
+}
+
+const testDeploymentResourceTemplate = `
+data "cdo_ftd_device" "test" {
+	name = "{{.FtdName}}"
+}
+
+resource "cdo_cdfmc_deployment" "test" {
+	ftd_ids = [data.cdo_ftd_device.test.id]
+	triggers = {
+		revision = "{{.Trigger}}"
+	}
+}`
+
+var testDeploymentResourceConfig = acctest.MustParseTemplate(testDeploymentResourceTemplate, testDeploymentResource)
+
+var testDeploymentResource_NewTrigger = acctest.MustOverrideFields(testDeploymentResource, map[string]any{
+	"Trigger": "2",
+})
+var testDeploymentResourceConfig_NewTrigger = acctest.MustParseTemplate(testDeploymentResourceTemplate, testDeploymentResource_NewTrigger)
+
+func TestAccCdFmcDeploymentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeploymentResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_cdfmc_deployment.test", "id", "data.cdo_ftd_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_deployment.test", "devices.#", "1"),
+					resource.TestCheckResourceAttrPair("cdo_cdfmc_deployment.test", "devices.0.ftd_id", "data.cdo_ftd_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_deployment.test", "devices.0.name", testDeploymentResource.FtdName),
+					resource.TestCheckResourceAttr("cdo_cdfmc_deployment.test", "devices.0.deployment_status", "DEPLOYED"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeploymentResourceConfig_NewTrigger,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_deployment.test", "triggers.revision", testDeploymentResource_NewTrigger.Trigger),
+					resource.TestCheckResourceAttr("cdo_cdfmc_deployment.test", "devices.0.deployment_status", "DEPLOYED"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,63 @@
+package devicelicenses
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/devicelicense"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcRes, err := resource.client.ReadCloudFmcDevice(ctx)
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcDeviceLicenses(ctx, fmcplatform.NewReadDeviceLicensesInput(fmcRes.Host))
+	if err != nil {
+		return err
+	}
+
+	setResourceModel(stateData, readOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	licenses, err := util.TFStringSetToLicenses(ctx, planData.Licenses)
+	if err != nil {
+		return err
+	}
+
+	fmcRes, err := resource.client.ReadCloudFmcDevice(ctx)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcDeviceLicenses(
+		ctx,
+		fmcplatform.NewUpdateDeviceLicensesInputBuilder().
+			FmcHost(fmcRes.Host).
+			LicenseTypes(licenses).
+			Build(),
+	)
+	if err != nil {
+		return err
+	}
+
+	setResourceModel(planData, updateOutp)
+
+	return nil
+}
+
+func setResourceModel(resourceModel *ResourceModel, item *devicelicense.Item) {
+	resourceModel.Id = types.StringValue(item.Id)
+	// the FMC returns the licenses in FMC terms, convert them back to CDO terms
+	resourceModel.Licenses = util.GoStringSliceToTFStringSet(license.LicensesToStrings(license.LicensesToCdoLicenses(item.LicenseTypes)))
+	resourceModel.PerformanceTier = types.StringValue(string(item.PerformanceTier))
+}
@@ -0,0 +1,180 @@
+package devicelicenses
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/CiscoDevnet/terraform-provider-cdo/validators"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+
+// cdoLicenses are the licenses in CDO terms, the FMC terms are converted to them when reading the licenses back.
+var cdoLicenses = []string{
+	string(license.Base),
+	string(license.Carrier),
+	string(license.Threat),
+	string(license.Malware),
+	string(license.URLFilter),
+}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Licenses        types.Set    `tfsdk:"licenses"`
+	PerformanceTier types.String `tfsdk:"performance_tier"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdfmc_device_licenses"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides the device license entitlements of the cloud-delivered FMC in your tenant, i.e. the licenses that the cdFMC applies to the FTD devices it manages. " +
+			"There is only one set of device licenses per tenant, so declare this resource at most once. " +
+			"Changing the `licenses` of a `cdo_ftd_device` resource updates the same device licenses, so do not use this resource together with `cdo_ftd_device` resources whose licenses change; " +
+			"licenses changed outside of this resource are reported as a warning on refresh and set back on the next apply. " +
+			"Destroying this resource does not change the device licenses, the FTD devices stay entitled to them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the device licenses on the cdFMC.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"licenses": schema.SetAttribute{
+				ElementType:         types.StringType,
+				MarkdownDescription: "The licenses to entitle the FTD devices to. You must enable at least the \"BASE\" license. Allowed values are: [\"BASE\", \"CARRIER\", \"THREAT\", \"MALWARE\", \"URLFilter\"].",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(cdoLicenses...)),
+					validators.SetValueStringsAtLeast(stringvalidator.OneOf(string(license.Base))),
+				},
+			},
+			"performance_tier": schema.StringAttribute{
+				MarkdownDescription: "The performance tier of the device licenses.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read cdFMC device licenses resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	stateLicenses := stateData.Licenses
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read cdFMC device licenses", err.Error())
+		return
+	}
+	// the device licenses are shared by all FTD devices, they are changed by the licenses of cdo_ftd_device resources too
+	if !stateLicenses.IsNull() && !stateLicenses.Equal(stateData.Licenses) {
+		resp.Diagnostics.AddWarning(
+			"cdFMC device licenses changed outside of this resource",
+			fmt.Sprintf("The device licenses of the cdFMC are %s instead of %s, they were changed elsewhere, e.g. by the licenses of a cdo_ftd_device resource. "+
+				"Do not manage the device licenses with both cdo_cdfmc_device_licenses and cdo_ftd_device resources, otherwise they keep overwriting each other.", stateData.Licenses, stateLicenses),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "create cdFMC device licenses resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update cdFMC device licenses", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update cdFMC device licenses resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update cdFMC device licenses", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing the cdFMC device licenses resource is a noop. It will not change the device licenses of the cdFMC.")
+	resp.Diagnostics.AddWarning("Delete cdFMC device licenses is a noop", "The device licenses of the cdFMC are not changed, the FTD devices it manages stay entitled to them. Change them in the cdFMC if you no longer want to use them.")
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
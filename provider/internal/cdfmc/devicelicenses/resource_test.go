@@ -0,0 +1,60 @@
+package devicelicenses_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDeviceLicensesResource = struct {
+	Licenses string
+}{
+	Licenses: `"BASE"`,
+}
+
+const testDeviceLicensesResourceTemplate = `
+resource "cdo_cdfmc_device_licenses" "test" {
+	licenses = [{{.Licenses}}]
+}`
+
+var testDeviceLicensesResourceConfig = acctest.MustParseTemplate(testDeviceLicensesResourceTemplate, testDeviceLicensesResource)
+
+var testDeviceLicensesResource_NewLicenses = acctest.MustOverrideFields(testDeviceLicensesResource, map[string]any{
+	"Licenses": `"BASE", "THREAT"`,
+})
+var testDeviceLicensesResourceConfig_NewLicenses = acctest.MustParseTemplate(testDeviceLicensesResourceTemplate, testDeviceLicensesResource_NewLicenses)
+
+func TestAccCdFmcDeviceLicensesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeviceLicensesResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_device_licenses.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_device_licenses.test", "licenses.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_device_licenses.test", "licenses.*", "BASE"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_device_licenses.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeviceLicensesResourceConfig_NewLicenses,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_device_licenses.test", "licenses.#", "2"),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_device_licenses.test", "licenses.*", "BASE"),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_device_licenses.test", "licenses.*", "THREAT"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,121 @@
+package domains
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcplatform"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	Id      types.String  `tfsdk:"id"`
+	Domains []DomainModel `tfsdk:"domains"`
+}
+
+type DomainModel struct {
+	Uid  types.String `tfsdk:"uid"`
+	Name types.String `tfsdk:"name"`
+	Type types.String `tfsdk:"type"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdfmc_domains"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the domains of the cloud-delivered FMC in your tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the hostname of the cdFMC.",
+				Computed:            true,
+			},
+			"domains": schema.ListNestedAttribute{
+				MarkdownDescription: "The domains of the cdFMC.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"uid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the domain.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The full name of the domain, e.g. `Global/Customer1`.",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "The type of the domain.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var planData DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fmc, err := d.client.ReadCloudFmcDevice(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cdFMC", err.Error())
+		return
+	}
+	domainInfo, err := d.client.ReadFmcDomainInfo(ctx, fmcplatform.NewReadDomainInfoInput(fmc.Host))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cdFMC domains", err.Error())
+		return
+	}
+
+	planData.Id = types.StringValue(fmc.Host)
+	planData.Domains = make([]DomainModel, len(domainInfo.Items))
+	for i, item := range domainInfo.Items {
+		planData.Domains[i] = DomainModel{
+			Uid:  types.StringValue(item.Uuid),
+			Name: types.StringValue(item.Name),
+			Type: types.StringValue(item.Type),
+		}
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
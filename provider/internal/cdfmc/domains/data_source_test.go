@@ -0,0 +1,29 @@
+package domains_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testDomainsConfig = `
+data "cdo_cdfmc_domains" "test" {}`
+
+func TestAccCdFmcDomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testDomainsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.cdo_cdfmc_domains.test", "id"),
+					resource.TestCheckResourceAttr("data.cdo_cdfmc_domains.test", "domains.0.name", "Global"),
+					resource.TestCheckResourceAttrSet("data.cdo_cdfmc_domains.test", "domains.0.uid"),
+				),
+			},
+		},
+	})
+}
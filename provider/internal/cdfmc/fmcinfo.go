@@ -0,0 +1,32 @@
+package cdfmc
+
+import (
+	"context"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+)
+
+// FmcInfo is what the FMC API requests made through the cdFMC proxy need: the hostname of the cdFMC and its domain.
+type FmcInfo struct {
+	Hostname  string
+	DomainUid string
+}
+
+// ReadFmcInfo reads the hostname and domain of the cdFMC in the tenant.
+func ReadFmcInfo(ctx context.Context, client *cdoClient.Client) (*FmcInfo, error) {
+	return ReadFmcInfoInDomain(ctx, client, "")
+}
+
+// ReadFmcInfoInDomain is ReadFmcInfo for the given domain of the cdFMC, which is its uuid or name, the domain of the cdFMC is used if it is empty.
+func ReadFmcInfoInDomain(ctx context.Context, client *cdoClient.Client, domain string) (*FmcInfo, error) {
+	readOut, err := client.ReadCloudFmcDomainUid(ctx, cloudfmc.NewReadDomainUidInput(domain))
+	if err != nil {
+		return nil, err
+	}
+
+	return &FmcInfo{
+		Hostname:  readOut.FmcHost,
+		DomainUid: readOut.DomainUid,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package cdfmc
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ReadFtdDeviceRecordUid reads the ID of the device record of the FTD on the cdFMC, the configuration of the FTD on the cdFMC is found under its device record.
+func ReadFtdDeviceRecordUid(ctx context.Context, client *cdoClient.Client, fmcInfo *FmcInfo, ftdUid string) (string, error) {
+	deviceRecord, err := client.ReadCloudFtdDeviceRecord(ctx, cloudftd.NewReadDeviceRecordInput(fmcInfo.Hostname, fmcInfo.DomainUid, ftdUid))
+	if err != nil {
+		return "", fmt.Errorf("failed to read FTD on cdFMC: %w", err)
+	}
+	return deviceRecord.Id, nil
+}
+
+// Ipv4FromModel converts the IPv4 attributes of an interface resource to its IPv4 addressing on the cdFMC, it is nil if the interface has no IPv4 address.
+func Ipv4FromModel(address types.String, netmask types.String, dhcp types.Bool) (*fmcinterface.Ipv4, error) {
+	if dhcp.ValueBool() {
+		if address.ValueString() != "" || netmask.ValueString() != "" {
+			return nil, fmt.Errorf("ipv4_address and ipv4_netmask cannot be set when ipv4_dhcp is true")
+		}
+		return fmcinterface.NewDhcpIpv4(true), nil
+	}
+	if address.ValueString() == "" {
+		return nil, nil
+	}
+	return fmcinterface.NewStaticIpv4(address.ValueString(), netmask.ValueString()), nil
+}
+
+// Ipv4ToModel converts the IPv4 addressing of an interface on the cdFMC to the IPv4 attributes of an interface resource.
+func Ipv4ToModel(ipv4 *fmcinterface.Ipv4) (address types.String, netmask types.String, dhcp types.Bool) {
+	address, netmask, dhcp = types.StringNull(), types.StringNull(), types.BoolValue(false)
+	if ipv4 == nil {
+		return
+	}
+	if ipv4.Static != nil {
+		address, netmask = types.StringValue(ipv4.Static.Address), types.StringValue(ipv4.Static.Netmask)
+	}
+	if ipv4.Dhcp != nil {
+		dhcp = types.BoolValue(true)
+	}
+	return
+}
+
+// SecurityZoneFromModel converts the security zone ID of an interface resource to the security zone reference of the interface on the cdFMC.
+func SecurityZoneFromModel(securityZoneId types.String) *fmcinterface.Reference {
+	if securityZoneId.ValueString() == "" {
+		return nil
+	}
+	return fmcinterface.NewSecurityZoneReference(securityZoneId.ValueString())
+}
+
+// SecurityZoneToModel converts the security zone reference of an interface on the cdFMC to the security zone ID of an interface resource.
+func SecurityZoneToModel(securityZone *fmcinterface.Reference) types.String {
+	if securityZone == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(securityZone.Id)
+}
+
+// StringOrNull converts an optional string of the cdFMC to a string attribute, it is null if the string is empty as the cdFMC omits unset fields.
+func StringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
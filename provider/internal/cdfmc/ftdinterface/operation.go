@@ -0,0 +1,139 @@
+package ftdinterface
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcPhysicalInterface(ctx, fmcinterface.NewReadPhysicalInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setInterface(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// the physical interface already exists on the FTD, find it by its hardware name
+	interfaces, err := resource.client.ReadAllFmcPhysicalInterfaces(ctx, fmcinterface.NewReadAllPhysicalInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid))
+	if err != nil {
+		return err
+	}
+	for _, physicalInterface := range *interfaces {
+		if physicalInterface.Name == planData.Name.ValueString() {
+			planData.Id = types.StringValue(physicalInterface.Id)
+			return update(ctx, resource, planData, fmcInfo, deviceRecordUid)
+		}
+	}
+
+	return fmt.Errorf("FTD %s has no physical interface named %s", planData.FtdId.ValueString(), planData.Name.ValueString())
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	return update(ctx, resource, planData, fmcInfo, deviceRecordUid)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// the physical interface cannot be deleted, so disable it and clear its settings instead
+	_, err = resource.client.UpdateFmcPhysicalInterface(ctx, fmcinterface.NewUpdatePhysicalInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, modelInterface.PhysicalInterface{
+		Id:      stateData.Id.ValueString(),
+		Name:    stateData.Name.ValueString(),
+		Enabled: false,
+	}))
+	return err
+}
+
+func update(ctx context.Context, resource *Resource, planData *ResourceModel, fmcInfo *cdfmc.FmcInfo, deviceRecordUid string) error {
+	physicalInterface, err := interfaceFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcPhysicalInterface(ctx, fmcinterface.NewUpdatePhysicalInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, physicalInterface))
+	if err != nil {
+		return err
+	}
+
+	setInterface(planData, updateOutp)
+
+	return nil
+}
+
+func interfaceFromModel(model *ResourceModel) (modelInterface.PhysicalInterface, error) {
+	ipv4, err := cdfmc.Ipv4FromModel(model.Ipv4Address, model.Ipv4Netmask, model.Ipv4Dhcp)
+	if err != nil {
+		return modelInterface.PhysicalInterface{}, err
+	}
+
+	return modelInterface.PhysicalInterface{
+		Id:           model.Id.ValueString(),
+		Name:         model.Name.ValueString(),
+		IfName:       model.LogicalName.ValueString(),
+		Description:  model.Description.ValueString(),
+		Enabled:      model.Enabled.ValueBool(),
+		MTU:          int(model.Mtu.ValueInt64()),
+		SecurityZone: cdfmc.SecurityZoneFromModel(model.SecurityZoneId),
+		Ipv4:         ipv4,
+	}, nil
+}
+
+func setInterface(model *ResourceModel, physicalInterface *modelInterface.PhysicalInterface) {
+	model.Id = types.StringValue(physicalInterface.Id)
+	model.Name = types.StringValue(physicalInterface.Name)
+	model.LogicalName = cdfmc.StringOrNull(physicalInterface.IfName)
+	model.Description = cdfmc.StringOrNull(physicalInterface.Description)
+	model.Enabled = types.BoolValue(physicalInterface.Enabled)
+	if physicalInterface.MTU != 0 {
+		model.Mtu = types.Int64Value(int64(physicalInterface.MTU))
+	}
+	model.SecurityZoneId = cdfmc.SecurityZoneToModel(physicalInterface.SecurityZone)
+	model.Ipv4Address, model.Ipv4Netmask, model.Ipv4Dhcp = cdfmc.Ipv4ToModel(physicalInterface.Ipv4)
+}
@@ -0,0 +1,89 @@
+package ftdinterface_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testFtdInterfaceResource = struct {
+	FtdName     string
+	Name        string
+	LogicalName string
+	Ipv4Address string
+}{
+	FtdName:     acctest.Env.FtdInterfaceResourceFtdName(),
+	Name:        acctest.Env.FtdInterfaceResourceInterfaceName(),
+	LogicalName: "tf-acc-test-inside",
+	Ipv4Address: "10.10.10.1",
+}
+
+const testFtdInterfaceResourceTemplate = `
+data "cdo_ftd_device" "test" {
+	name = "{{.FtdName}}"
+}
+
+resource "cdo_cdfmc_security_zone" "test" {
+	name = "terraform-provider-cdo-acc-test-interface-zone"
+}
+
+resource "cdo_cdfmc_ftd_interface" "test" {
+	ftd_id           = data.cdo_ftd_device.test.id
+	name             = "{{.Name}}"
+	logical_name     = "{{.LogicalName}}"
+	security_zone_id = cdo_cdfmc_security_zone.test.id
+	ipv4_address     = "{{.Ipv4Address}}"
+	ipv4_netmask     = "24"
+}`
+
+var testFtdInterfaceResourceConfig = acctest.MustParseTemplate(testFtdInterfaceResourceTemplate, testFtdInterfaceResource)
+
+var testFtdInterfaceResource_NewAddress = acctest.MustOverrideFields(testFtdInterfaceResource, map[string]any{
+	"Ipv4Address": "10.10.20.1",
+})
+var testFtdInterfaceResourceConfig_NewAddress = acctest.MustParseTemplate(testFtdInterfaceResourceTemplate, testFtdInterfaceResource_NewAddress)
+
+func TestAccCdFmcFtdInterfaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testFtdInterfaceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_ftd_interface.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "name", testFtdInterfaceResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "logical_name", testFtdInterfaceResource.LogicalName),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "mtu", "1500"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "ipv4_address", testFtdInterfaceResource.Ipv4Address),
+					resource.TestCheckResourceAttrPair("cdo_cdfmc_ftd_interface.test", "security_zone_id", "cdo_cdfmc_security_zone.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_ftd_interface.test",
+				ImportState:       true,
+				ImportStateIdFunc: importStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testFtdInterfaceResourceConfig_NewAddress,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_interface.test", "ipv4_address", testFtdInterfaceResource_NewAddress.Ipv4Address),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func importStateId(state *terraform.State) (string, error) {
+	attributes := state.RootModule().Resources["cdo_cdfmc_ftd_interface.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s", attributes["ftd_id"], attributes["id"]), nil
+}
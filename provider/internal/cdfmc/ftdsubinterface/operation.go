@@ -0,0 +1,133 @@
+package ftdsubinterface
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcinterface"
+	modelInterface "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcSubInterface(ctx, fmcinterface.NewReadSubInterfaceInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setSubInterface(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	subInterface, err := subInterfaceFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcSubInterface(ctx, fmcinterface.NewCreateSubInterfaceInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, subInterface))
+	if err != nil {
+		return err
+	}
+
+	setSubInterface(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	subInterface, err := subInterfaceFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcSubInterface(ctx, fmcinterface.NewUpdateSubInterfaceInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, planData.Id.ValueString(), subInterface))
+	if err != nil {
+		return err
+	}
+
+	setSubInterface(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcSubInterface(ctx, fmcinterface.NewDeleteSubInterfaceInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, stateData.Id.ValueString()))
+	return err
+}
+
+func subInterfaceFromModel(model *ResourceModel) (modelInterface.SubInterface, error) {
+	ipv4, err := cdfmc.Ipv4FromModel(model.Ipv4Address, model.Ipv4Netmask, model.Ipv4Dhcp)
+	if err != nil {
+		return modelInterface.SubInterface{}, err
+	}
+
+	return modelInterface.SubInterface{
+		Id:           model.Id.ValueString(),
+		Name:         model.ParentName.ValueString(),
+		SubIntfId:    int(model.SubInterfaceId.ValueInt64()),
+		VlanId:       int(model.VlanId.ValueInt64()),
+		IfName:       model.LogicalName.ValueString(),
+		Description:  model.Description.ValueString(),
+		Enabled:      model.Enabled.ValueBool(),
+		MTU:          int(model.Mtu.ValueInt64()),
+		SecurityZone: cdfmc.SecurityZoneFromModel(model.SecurityZoneId),
+		Ipv4:         ipv4,
+	}, nil
+}
+
+func setSubInterface(model *ResourceModel, subInterface *modelInterface.SubInterface) {
+	model.Id = types.StringValue(subInterface.Id)
+	model.ParentName = types.StringValue(subInterface.Name)
+	model.SubInterfaceId = types.Int64Value(int64(subInterface.SubIntfId))
+	model.VlanId = types.Int64Value(int64(subInterface.VlanId))
+	model.LogicalName = cdfmc.StringOrNull(subInterface.IfName)
+	model.Description = cdfmc.StringOrNull(subInterface.Description)
+	model.Enabled = types.BoolValue(subInterface.Enabled)
+	if subInterface.MTU != 0 {
+		model.Mtu = types.Int64Value(int64(subInterface.MTU))
+	}
+	model.SecurityZoneId = cdfmc.SecurityZoneToModel(subInterface.SecurityZone)
+	model.Ipv4Address, model.Ipv4Netmask, model.Ipv4Dhcp = cdfmc.Ipv4ToModel(subInterface.Ipv4)
+}
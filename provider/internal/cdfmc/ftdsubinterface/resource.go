@@ -0,0 +1,251 @@
+package ftdsubinterface
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Domain         types.String `tfsdk:"domain"`
+	FtdId          types.String `tfsdk:"ftd_id"`
+	ParentName     types.String `tfsdk:"parent_name"`
+	SubInterfaceId types.Int64  `tfsdk:"subinterface_id"`
+	VlanId         types.Int64  `tfsdk:"vlan_id"`
+	LogicalName    types.String `tfsdk:"logical_name"`
+	Description    types.String `tfsdk:"description"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	Mtu            types.Int64  `tfsdk:"mtu"`
+	SecurityZoneId types.String `tfsdk:"security_zone_id"`
+	Ipv4Address    types.String `tfsdk:"ipv4_address"`
+	Ipv4Netmask    types.String `tfsdk:"ipv4_netmask"`
+	Ipv4Dhcp       types.Bool   `tfsdk:"ipv4_dhcp"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdfmc_ftd_subinterface"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a VLAN subinterface of a physical interface of an FTD managed by the cloud-delivered FMC in your tenant. " +
+			"The changes only take effect on the FTD once they are deployed, e.g. using the `cdo_cdfmc_deployment` resource.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subinterface on the cdFMC.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name or UUID of the cdFMC domain the FTD is in, e.g. `Global/Customer1`. If not specified, the default domain of the cdFMC is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ftd_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the FTD, as returned by the `id` attribute of the `cdo_ftd_device` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_name": schema.StringAttribute{
+				MarkdownDescription: "The hardware name of the physical interface the subinterface belongs to, e.g. `GigabitEthernet0/1`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subinterface_id": schema.Int64Attribute{
+				MarkdownDescription: "The number of the subinterface, it is appended to the name of the physical interface, e.g. `100` for `GigabitEthernet0/1.100`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4294967295),
+				},
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: "The VLAN ID of the traffic of the subinterface.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4094),
+				},
+			},
+			"logical_name": schema.StringAttribute{
+				MarkdownDescription: "The logical name of the subinterface, e.g. `vlan100`. It is used to refer to the interface in the configuration of the FTD, e.g. in static routes.",
+				Optional:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "The description of the subinterface.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subinterface is enabled.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"mtu": schema.Int64Attribute{
+				MarkdownDescription: "The maximum transmission unit of the subinterface in bytes, it cannot be larger than the MTU of its physical interface.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1500),
+				Validators: []validator.Int64{
+					int64validator.Between(64, 9198),
+				},
+			},
+			"security_zone_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the security zone of the subinterface, as returned by the `id` attribute of the `cdo_cdfmc_security_zone` resource.",
+				Optional:            true,
+			},
+			"ipv4_address": schema.StringAttribute{
+				MarkdownDescription: "The static IPv4 address of the subinterface, e.g. `10.10.10.1`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("ipv4_netmask")),
+				},
+			},
+			"ipv4_netmask": schema.StringAttribute{
+				MarkdownDescription: "The netmask of the static IPv4 address of the subinterface, either as a prefix length, e.g. `24`, or in dotted notation, e.g. `255.255.255.0`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("ipv4_address")),
+				},
+			},
+			"ipv4_dhcp": schema.BoolAttribute{
+				MarkdownDescription: "Whether the subinterface gets its IPv4 address and default route using DHCP. Cannot be true if `ipv4_address` is set.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "create cdfmc FTD subinterface resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Create(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to create cdFMC FTD subinterface", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read cdfmc FTD subinterface resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read cdFMC FTD subinterface", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update cdfmc FTD subinterface resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update cdFMC FTD subinterface", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete cdfmc FTD subinterface resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Delete(ctx, r, &stateData); err != nil {
+		resp.Diagnostics.AddError("failed to delete cdFMC FTD subinterface", err.Error())
+		return
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the subinterface is imported by the ID of its FTD and its own ID on the cdFMC, separated by a slash
+	cdfmc.ImportFtdStateWithDomain(ctx, req, resp)
+}
@@ -0,0 +1,95 @@
+package ftdsubinterface_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testFtdSubInterfaceResource = struct {
+	FtdName     string
+	ParentName  string
+	VlanId      string
+	LogicalName string
+	Ipv4Address string
+}{
+	FtdName:     acctest.Env.FtdInterfaceResourceFtdName(),
+	ParentName:  acctest.Env.FtdInterfaceResourceInterfaceName(),
+	VlanId:      "100",
+	LogicalName: "tf-acc-test-vlan100",
+	Ipv4Address: "10.10.100.1",
+}
+
+const testFtdSubInterfaceResourceTemplate = `
+data "cdo_ftd_device" "test" {
+	name = "{{.FtdName}}"
+}
+
+resource "cdo_cdfmc_security_zone" "test" {
+	name = "terraform-provider-cdo-acc-test-subinterface-zone"
+}
+
+resource "cdo_cdfmc_ftd_subinterface" "test" {
+	ftd_id           = data.cdo_ftd_device.test.id
+	parent_name      = "{{.ParentName}}"
+	subinterface_id  = 100
+	vlan_id          = {{.VlanId}}
+	logical_name     = "{{.LogicalName}}"
+	security_zone_id = cdo_cdfmc_security_zone.test.id
+	ipv4_address     = "{{.Ipv4Address}}"
+	ipv4_netmask     = "24"
+}`
+
+var testFtdSubInterfaceResourceConfig = acctest.MustParseTemplate(testFtdSubInterfaceResourceTemplate, testFtdSubInterfaceResource)
+
+var testFtdSubInterfaceResource_NewAddress = acctest.MustOverrideFields(testFtdSubInterfaceResource, map[string]any{
+	"Ipv4Address": "10.10.200.1",
+})
+var testFtdSubInterfaceResourceConfig_NewAddress = acctest.MustParseTemplate(testFtdSubInterfaceResourceTemplate, testFtdSubInterfaceResource_NewAddress)
+
+func TestAccCdFmcFtdSubInterfaceResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testFtdSubInterfaceResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_ftd_subinterface.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "parent_name", testFtdSubInterfaceResource.ParentName),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "subinterface_id", "100"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "vlan_id", testFtdSubInterfaceResource.VlanId),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "logical_name", testFtdSubInterfaceResource.LogicalName),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "mtu", "1500"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "ipv4_address", testFtdSubInterfaceResource.Ipv4Address),
+					resource.TestCheckResourceAttrPair("cdo_cdfmc_ftd_subinterface.test", "security_zone_id", "cdo_cdfmc_security_zone.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_ftd_subinterface.test",
+				ImportState:       true,
+				ImportStateIdFunc: importStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testFtdSubInterfaceResourceConfig_NewAddress,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_subinterface.test", "ipv4_address", testFtdSubInterfaceResource_NewAddress.Ipv4Address),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func importStateId(state *terraform.State) (string, error) {
+	attributes := state.RootModule().Resources["cdo_cdfmc_ftd_subinterface.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s", attributes["ftd_id"], attributes["id"]), nil
+}
@@ -0,0 +1,50 @@
+package cdfmc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// ImportStateWithDomain imports a cdFMC resource by its ID, or by `<domain>:<id>` if it is in another domain than the default domain of the cdFMC.
+func ImportStateWithDomain(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// the IDs on the cdFMC never contain a colon, but domain names may
+	separator := strings.LastIndex(req.ID, ":")
+	if separator == -1 {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), req.ID[:separator])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID[separator+1:])...)
+}
+
+// ImportFtdStateWithDomain imports the configuration of an FTD on the cdFMC by `<ftd_id>/<id>`, or by `<domain>:<ftd_id>/<id>` if the FTD is in another domain than the default domain of the cdFMC.
+func ImportFtdStateWithDomain(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// domain names contain slashes, e.g. Global/Customer1, but the IDs on the cdFMC and CDO contain neither slashes nor colons
+	idSeparator := strings.LastIndex(req.ID, "/")
+	if idSeparator == -1 {
+		idSeparator = len(req.ID)
+	}
+	domainAndFtdId, id := req.ID[:idSeparator], strings.TrimPrefix(req.ID[idSeparator:], "/")
+	domain, ftdId := "", domainAndFtdId
+	if domainSeparator := strings.LastIndex(domainAndFtdId, ":"); domainSeparator != -1 {
+		domain, ftdId = domainAndFtdId[:domainSeparator], domainAndFtdId[domainSeparator+1:]
+	}
+	if ftdId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"invalid import ID",
+			fmt.Sprintf("expected an import ID of the form <ftd_id>/<id> or <domain>:<ftd_id>/<id>, got: %s", req.ID),
+		)
+		return
+	}
+
+	if domain != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ftd_id"), ftdId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,142 @@
+package networkgroup
+
+import (
+	"context"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util/sliceutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcObject(ctx, fmcobjects.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.NetworkGroups, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setObject(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcObject(ctx, fmcobjects.NewCreateInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.NetworkGroups, object))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcObject(ctx, fmcobjects.NewUpdateInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.NetworkGroups, planData.Id.ValueString(), object))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcObject(ctx, fmcobjects.NewDeleteInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.NetworkGroups, stateData.Id.ValueString()))
+	return err
+}
+
+func objectFromModel(ctx context.Context, model *ResourceModel) (fmcobject.Object, error) {
+	literals, err := util.TFStringSetToGoStringList(ctx, model.Literals)
+	if err != nil {
+		return fmcobject.Object{}, err
+	}
+
+	return fmcobject.Object{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Overridable: model.Overridable.ValueBool(),
+		Objects: sliceutil.Map(model.Objects, func(object ObjectModel) fmcobject.Reference {
+			return fmcobject.NewReference(object.Id.ValueString(), object.Type.ValueString())
+		}),
+		Literals: sliceutil.Map(literals, func(value string) fmcobject.Literal {
+			return fmcobject.NewLiteral(literalType(value), value)
+		}),
+	}, nil
+}
+
+// literalType returns the FMC type of a literal from the notation it is written in.
+func literalType(value string) fmcobject.Type {
+	if strings.Contains(value, "/") {
+		return fmcobject.NetworkType
+	}
+	if strings.Contains(value, "-") {
+		return fmcobject.RangeType
+	}
+	return fmcobject.HostType
+}
+
+func setObject(model *ResourceModel, object *fmcobject.Object) {
+	model.Id = types.StringValue(object.Id)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	// objects and literals that are not set are null in the config
+	model.Objects = nil
+	if len(object.Objects) > 0 {
+		model.Objects = sliceutil.Map(object.Objects, func(reference fmcobject.Reference) ObjectModel {
+			return ObjectModel{
+				Id:   types.StringValue(reference.Id),
+				Type: types.StringValue(reference.Type),
+			}
+		})
+	}
+	model.Literals = types.SetNull(types.StringType)
+	if len(object.Literals) > 0 {
+		model.Literals = util.GoStringSliceToTFStringSet(sliceutil.Map(object.Literals, func(literal fmcobject.Literal) string {
+			return literal.Value
+		}))
+	}
+	model.Overridable = types.BoolValue(object.Overridable)
+}
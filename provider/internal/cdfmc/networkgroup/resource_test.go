@@ -0,0 +1,72 @@
+package networkgroup_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testNetworkGroupResource = struct {
+	Name    string
+	Literal string
+}{
+	Name:    "terraform-provider-cdo-acc-test-network-group",
+	Literal: "10.30.0.1",
+}
+
+const testNetworkGroupResourceTemplate = `
+resource "cdo_cdfmc_network_object" "test" {
+	name  = "{{.Name}}-member"
+	type  = "Network"
+	value = "10.10.0.0/16"
+}
+
+resource "cdo_cdfmc_network_group" "test" {
+	name     = "{{.Name}}"
+	objects  = [{
+		id   = cdo_cdfmc_network_object.test.id
+		type = cdo_cdfmc_network_object.test.type
+	}]
+	literals = ["{{.Literal}}"]
+}`
+
+var testNetworkGroupResourceConfig = acctest.MustParseTemplate(testNetworkGroupResourceTemplate, testNetworkGroupResource)
+
+var testNetworkGroupResource_NewLiteral = acctest.MustOverrideFields(testNetworkGroupResource, map[string]any{
+	"Literal": "10.40.0.0/24",
+})
+var testNetworkGroupResourceConfig_NewLiteral = acctest.MustParseTemplate(testNetworkGroupResourceTemplate, testNetworkGroupResource_NewLiteral)
+
+func TestAccCdFmcNetworkGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkGroupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_network_group.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_group.test", "name", testNetworkGroupResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_group.test", "objects.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_network_group.test", "literals.*", testNetworkGroupResource.Literal),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_network_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkGroupResourceConfig_NewLiteral,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("cdo_cdfmc_network_group.test", "literals.*", testNetworkGroupResource_NewLiteral.Literal),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
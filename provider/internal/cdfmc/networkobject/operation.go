@@ -0,0 +1,141 @@
+package networkobject
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var typeToKind = map[fmcobject.Type]fmcobject.Kind{
+	fmcobject.HostType:    fmcobject.Hosts,
+	fmcobject.NetworkType: fmcobject.Networks,
+	fmcobject.RangeType:   fmcobject.Ranges,
+	fmcobject.FqdnType:    fmcobject.Fqdns,
+}
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// the type is not known after import, in which case every kind of network object is tried
+	kinds := []fmcobject.Kind{fmcobject.Hosts, fmcobject.Networks, fmcobject.Ranges, fmcobject.Fqdns}
+	if !stateData.Type.IsNull() {
+		kinds = []fmcobject.Kind{typeToKind[stateData.Type.ValueString()]}
+	}
+
+	for _, kind := range kinds {
+		readOutp, err := resource.client.ReadFmcObject(ctx, fmcobjects.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, kind, stateData.Id.ValueString()))
+		if err != nil && util.Is404Error(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		setObject(stateData, readOutp)
+		return nil
+	}
+
+	return fmt.Errorf("network object %s: %s", stateData.Id.ValueString(), http.StatusText(http.StatusNotFound))
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcObject(ctx, fmcobjects.NewCreateInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		typeToKind[planData.Type.ValueString()],
+		objectFromModel(planData),
+	))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcObject(ctx, fmcobjects.NewUpdateInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		typeToKind[planData.Type.ValueString()],
+		planData.Id.ValueString(),
+		objectFromModel(planData),
+	))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcObject(ctx, fmcobjects.NewDeleteInput(
+		fmcInfo.Hostname,
+		fmcInfo.DomainUid,
+		typeToKind[stateData.Type.ValueString()],
+		stateData.Id.ValueString(),
+	))
+	return err
+}
+
+func objectFromModel(model *ResourceModel) fmcobject.Object {
+	object := fmcobject.Object{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		Value:       model.Value.ValueString(),
+		Overridable: model.Overridable.ValueBool(),
+	}
+	if model.Type.ValueString() == fmcobject.FqdnType {
+		object.DnsResolution = model.DnsResolution.ValueString() // empty when unknown, FMC then uses its default
+	}
+	return object
+}
+
+func setObject(model *ResourceModel, object *fmcobject.Object) {
+	model.Id = types.StringValue(object.Id)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.Type = types.StringValue(object.Type)
+	model.Value = types.StringValue(object.Value)
+	if object.DnsResolution != "" {
+		model.DnsResolution = types.StringValue(object.DnsResolution)
+	} else {
+		model.DnsResolution = types.StringNull()
+	}
+	model.Overridable = types.BoolValue(object.Overridable)
+}
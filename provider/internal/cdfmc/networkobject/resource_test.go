@@ -0,0 +1,79 @@
+package networkobject_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testNetworkObjectResource = struct {
+	Name  string
+	Type  string
+	Value string
+}{
+	Name:  "terraform-provider-cdo-acc-test-network-object",
+	Type:  "Network",
+	Value: "10.10.0.0/16",
+}
+
+const testNetworkObjectResourceTemplate = `
+resource "cdo_cdfmc_network_object" "test" {
+	name  = "{{.Name}}"
+	type  = "{{.Type}}"
+	value = "{{.Value}}"
+}`
+
+var testNetworkObjectResourceConfig = acctest.MustParseTemplate(testNetworkObjectResourceTemplate, testNetworkObjectResource)
+
+var testNetworkObjectResource_NewValue = acctest.MustOverrideFields(testNetworkObjectResource, map[string]any{
+	"Value": "10.20.0.0/16",
+})
+var testNetworkObjectResourceConfig_NewValue = acctest.MustParseTemplate(testNetworkObjectResourceTemplate, testNetworkObjectResource_NewValue)
+
+var testNetworkObjectResource_NewType = acctest.MustOverrideFields(testNetworkObjectResource, map[string]any{
+	"Type":  "Host",
+	"Value": "10.20.0.1",
+})
+var testNetworkObjectResourceConfig_NewType = acctest.MustParseTemplate(testNetworkObjectResourceTemplate, testNetworkObjectResource_NewType)
+
+func TestAccCdFmcNetworkObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_network_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "name", testNetworkObjectResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "type", testNetworkObjectResource.Type),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "value", testNetworkObjectResource.Value),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_network_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkObjectResourceConfig_NewValue,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "value", testNetworkObjectResource_NewValue.Value),
+				),
+			},
+			// Replace and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkObjectResourceConfig_NewType,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "type", testNetworkObjectResource_NewType.Type),
+					resource.TestCheckResourceAttr("cdo_cdfmc_network_object.test", "value", testNetworkObjectResource_NewType.Value),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,67 @@
+package portobject_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testPortObjectResource = struct {
+	Name     string
+	Protocol string
+	Port     string
+}{
+	Name:     "terraform-provider-cdo-acc-test-port-object",
+	Protocol: "TCP",
+	Port:     "8443",
+}
+
+const testPortObjectResourceTemplate = `
+resource "cdo_cdfmc_port_object" "test" {
+	name     = "{{.Name}}"
+	protocol = "{{.Protocol}}"
+	port     = "{{.Port}}"
+}`
+
+var testPortObjectResourceConfig = acctest.MustParseTemplate(testPortObjectResourceTemplate, testPortObjectResource)
+
+var testPortObjectResource_NewPort = acctest.MustOverrideFields(testPortObjectResource, map[string]any{
+	"Protocol": "UDP",
+	"Port":     "8000-8080",
+})
+var testPortObjectResourceConfig_NewPort = acctest.MustParseTemplate(testPortObjectResourceTemplate, testPortObjectResource_NewPort)
+
+func TestAccCdFmcPortObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testPortObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_port_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_port_object.test", "name", testPortObjectResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_port_object.test", "protocol", testPortObjectResource.Protocol),
+					resource.TestCheckResourceAttr("cdo_cdfmc_port_object.test", "port", testPortObjectResource.Port),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_port_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testPortObjectResourceConfig_NewPort,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_port_object.test", "protocol", testPortObjectResource_NewPort.Protocol),
+					resource.TestCheckResourceAttr("cdo_cdfmc_port_object.test", "port", testPortObjectResource_NewPort.Port),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
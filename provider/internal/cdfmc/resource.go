@@ -6,6 +6,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -14,6 +15,7 @@ import (
 )
 
 var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -138,3 +140,8 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 	// delete is noop
 	res.Diagnostics.AddWarning("Delete cdFMC is an noop", "Please reach out to CDO TAC if you really want to delete a cdFMC.")
 }
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	// there is only one cdFMC per tenant, read ignores the id, which is set to the uid of the cdFMC on read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
@@ -0,0 +1,91 @@
+package securityzone
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcObject(ctx, fmcobjects.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.SecurityZones, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setObject(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcObject(ctx, fmcobjects.NewCreateInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.SecurityZones, objectFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcObject(ctx, fmcobjects.NewUpdateInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.SecurityZones, planData.Id.ValueString(), objectFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	setObject(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcObject(ctx, fmcobjects.NewDeleteInput(fmcInfo.Hostname, fmcInfo.DomainUid, fmcobject.SecurityZones, stateData.Id.ValueString()))
+	return err
+}
+
+func objectFromModel(model *ResourceModel) fmcobject.Object {
+	return fmcobject.Object{
+		Name:          model.Name.ValueString(),
+		Description:   model.Description.ValueString(),
+		InterfaceMode: model.InterfaceMode.ValueString(),
+	}
+}
+
+func setObject(model *ResourceModel, object *fmcobject.Object) {
+	model.Id = types.StringValue(object.Id)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.InterfaceMode = types.StringValue(object.InterfaceMode)
+}
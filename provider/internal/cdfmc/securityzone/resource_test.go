@@ -0,0 +1,61 @@
+package securityzone_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testSecurityZoneResource = struct {
+	Name        string
+	Description string
+}{
+	Name:        "terraform-provider-cdo-acc-test-security-zone",
+	Description: "created by the terraform provider acceptance tests",
+}
+
+const testSecurityZoneResourceTemplate = `
+resource "cdo_cdfmc_security_zone" "test" {
+	name        = "{{.Name}}"
+	description = "{{.Description}}"
+}`
+
+var testSecurityZoneResourceConfig = acctest.MustParseTemplate(testSecurityZoneResourceTemplate, testSecurityZoneResource)
+
+var testSecurityZoneResource_NewDescription = acctest.MustOverrideFields(testSecurityZoneResource, map[string]any{
+	"Description": "updated by the terraform provider acceptance tests",
+})
+var testSecurityZoneResourceConfig_NewDescription = acctest.MustParseTemplate(testSecurityZoneResourceTemplate, testSecurityZoneResource_NewDescription)
+
+func TestAccCdFmcSecurityZoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testSecurityZoneResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_security_zone.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_security_zone.test", "name", testSecurityZoneResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_security_zone.test", "interface_mode", "ROUTED"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_security_zone.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testSecurityZoneResourceConfig_NewDescription,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_security_zone.test", "description", testSecurityZoneResource_NewDescription.Description),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,125 @@
+package smartlicense
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	RegistrationStatus      types.String `tfsdk:"registration_status"`
+	AuthorizationStatus     types.String `tfsdk:"authorization_status"`
+	EvaluationMode          types.Bool   `tfsdk:"evaluation_mode"`
+	EvaluationExpiresInDays types.Int64  `tfsdk:"evaluation_expires_in_days"`
+	ExportControl           types.Bool   `tfsdk:"export_control"`
+	VirtualAccount          types.String `tfsdk:"virtual_account"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cdfmc_smart_license"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to get the Smart Licensing status of the cloud-delivered FMC in your tenant.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the virtual account of the smart license, or `evaluation` if the cdFMC is in evaluation mode.",
+				Computed:            true,
+			},
+			"registration_status": schema.StringAttribute{
+				MarkdownDescription: "The registration status of the cdFMC with the Smart Software Manager, e.g. `REGISTERED`, `UNREGISTERED` or `EVALUATION`.",
+				Computed:            true,
+			},
+			"authorization_status": schema.StringAttribute{
+				MarkdownDescription: "The authorization status of the licenses of the cdFMC, e.g. `AUTHORIZED` or `OUT_OF_COMPLIANCE`.",
+				Computed:            true,
+			},
+			"evaluation_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether the cdFMC is using the evaluation license.",
+				Computed:            true,
+			},
+			"evaluation_expires_in_days": schema.Int64Attribute{
+				MarkdownDescription: "The number of days left before the evaluation license expires.",
+				Computed:            true,
+			},
+			"export_control": schema.BoolAttribute{
+				MarkdownDescription: "Whether export-controlled functionality is enabled for the smart license.",
+				Computed:            true,
+			},
+			"virtual_account": schema.StringAttribute{
+				MarkdownDescription: "The Smart Software Manager virtual account the cdFMC is registered to.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var planData DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	smartLicense, err := d.client.ReadCloudFmcSmartLicense(ctx, cloudfmc.NewReadSmartLicenseInput())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read cdFMC smart license", err.Error())
+		return
+	}
+	if len(smartLicense.Items) == 0 {
+		resp.Diagnostics.AddError("Failed to read cdFMC smart license", "the cdFMC returned no smart license")
+		return
+	}
+	item := smartLicense.Items[0]
+
+	planData.Id = types.StringValue(item.Metadata.VirtualAccount)
+	if item.Metadata.VirtualAccount == "" {
+		planData.Id = types.StringValue("evaluation")
+	}
+	planData.RegistrationStatus = types.StringValue(item.RegStatus)
+	planData.AuthorizationStatus = types.StringValue(item.Metadata.AuthStatus)
+	planData.EvaluationMode = types.BoolValue(item.Metadata.EvalUsed)
+	planData.EvaluationExpiresInDays = types.Int64Value(int64(item.Metadata.EvalExpiresInDays))
+	planData.ExportControl = types.BoolValue(item.Metadata.ExportControl)
+	planData.VirtualAccount = types.StringValue(item.Metadata.VirtualAccount)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
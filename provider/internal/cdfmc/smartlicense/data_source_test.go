@@ -0,0 +1,29 @@
+package smartlicense_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testSmartLicenseConfig = `
+data "cdo_cdfmc_smart_license" "test" {}`
+
+func TestAccCdFmcSmartLicenseDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testSmartLicenseConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.cdo_cdfmc_smart_license.test", "id"),
+					resource.TestCheckResourceAttrSet("data.cdo_cdfmc_smart_license.test", "registration_status"),
+					resource.TestCheckResourceAttrSet("data.cdo_cdfmc_smart_license.test", "evaluation_mode"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,127 @@
+package staticroute
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmcroute"
+	modelRoute "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/fmcroute"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util/sliceutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	readOutp, err := resource.client.ReadFmcIpv4StaticRoute(ctx, fmcroute.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setRoute(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateFmcIpv4StaticRoute(ctx, fmcroute.NewCreateInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, routeFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	setRoute(planData, createOutp)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, planData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateFmcIpv4StaticRoute(ctx, fmcroute.NewUpdateInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, planData.Id.ValueString(), routeFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	setRoute(planData, updateOutp)
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+	deviceRecordUid, err := cdfmc.ReadFtdDeviceRecordUid(ctx, resource.client, fmcInfo, stateData.FtdId.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.DeleteFmcIpv4StaticRoute(ctx, fmcroute.NewDeleteInput(fmcInfo.Hostname, fmcInfo.DomainUid, deviceRecordUid, stateData.Id.ValueString()))
+	return err
+}
+
+func routeFromModel(model *ResourceModel) modelRoute.Ipv4StaticRoute {
+	gateway := modelRoute.NewLiteralGateway(model.Gateway.ValueString())
+	if model.GatewayHostId.ValueString() != "" {
+		gateway = modelRoute.NewObjectGateway(model.GatewayHostId.ValueString())
+	}
+
+	return modelRoute.Ipv4StaticRoute{
+		Id:            model.Id.ValueString(),
+		InterfaceName: model.InterfaceName.ValueString(),
+		SelectedNetworks: sliceutil.Map(model.Networks, func(network ObjectModel) modelRoute.Reference {
+			return modelRoute.NewReference(network.Id.ValueString(), network.Type.ValueString())
+		}),
+		Gateway:     gateway,
+		MetricValue: int(model.Metric.ValueInt64()),
+		IsTunneled:  model.IsTunneled.ValueBool(),
+	}
+}
+
+func setRoute(model *ResourceModel, route *modelRoute.Ipv4StaticRoute) {
+	model.Id = types.StringValue(route.Id)
+	model.InterfaceName = types.StringValue(route.InterfaceName)
+	model.Networks = sliceutil.Map(route.SelectedNetworks, func(reference modelRoute.Reference) ObjectModel {
+		return ObjectModel{
+			Id:   types.StringValue(reference.Id),
+			Type: types.StringValue(reference.Type),
+		}
+	})
+	model.Gateway, model.GatewayHostId = types.StringNull(), types.StringNull()
+	if route.Gateway.Object != nil {
+		model.GatewayHostId = types.StringValue(route.Gateway.Object.Id)
+	}
+	if route.Gateway.Literal != nil {
+		model.Gateway = types.StringValue(route.Gateway.Literal.Value)
+	}
+	model.Metric = types.Int64Value(int64(route.MetricValue))
+	model.IsTunneled = types.BoolValue(route.IsTunneled)
+}
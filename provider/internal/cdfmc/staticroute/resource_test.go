@@ -0,0 +1,100 @@
+package staticroute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testStaticRouteResource = struct {
+	FtdName       string
+	InterfaceName string
+	Gateway       string
+	Metric        string
+}{
+	FtdName:       acctest.Env.FtdInterfaceResourceFtdName(),
+	InterfaceName: acctest.Env.FtdInterfaceResourceInterfaceName(),
+	Gateway:       "10.10.30.254",
+	Metric:        "1",
+}
+
+const testStaticRouteResourceTemplate = `
+data "cdo_ftd_device" "test" {
+	name = "{{.FtdName}}"
+}
+
+resource "cdo_cdfmc_ftd_interface" "test" {
+	ftd_id       = data.cdo_ftd_device.test.id
+	name         = "{{.InterfaceName}}"
+	logical_name = "tf-acc-test-route"
+	ipv4_address = "10.10.30.1"
+	ipv4_netmask = "24"
+}
+
+resource "cdo_cdfmc_network_object" "test" {
+	name  = "terraform-provider-cdo-acc-test-route-destination"
+	type  = "Network"
+	value = "10.20.0.0/16"
+}
+
+resource "cdo_cdfmc_ftd_static_route" "test" {
+	ftd_id         = data.cdo_ftd_device.test.id
+	interface_name = cdo_cdfmc_ftd_interface.test.logical_name
+	networks = [{
+		id   = cdo_cdfmc_network_object.test.id
+		type = "Network"
+	}]
+	gateway = "{{.Gateway}}"
+	metric  = {{.Metric}}
+}`
+
+var testStaticRouteResourceConfig = acctest.MustParseTemplate(testStaticRouteResourceTemplate, testStaticRouteResource)
+
+var testStaticRouteResource_NewMetric = acctest.MustOverrideFields(testStaticRouteResource, map[string]any{
+	"Metric": "10",
+})
+var testStaticRouteResourceConfig_NewMetric = acctest.MustParseTemplate(testStaticRouteResourceTemplate, testStaticRouteResource_NewMetric)
+
+func TestAccCdFmcFtdStaticRouteResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testStaticRouteResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_ftd_static_route.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "interface_name", "tf-acc-test-route"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "networks.#", "1"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "gateway", testStaticRouteResource.Gateway),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "metric", testStaticRouteResource.Metric),
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "is_tunneled", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_ftd_static_route.test",
+				ImportState:       true,
+				ImportStateIdFunc: importStateId,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testStaticRouteResourceConfig_NewMetric,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_ftd_static_route.test", "metric", testStaticRouteResource_NewMetric.Metric),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func importStateId(state *terraform.State) (string, error) {
+	attributes := state.RootModule().Resources["cdo_cdfmc_ftd_static_route.test"].Primary.Attributes
+	return fmt.Sprintf("%s/%s", attributes["ftd_id"], attributes["id"]), nil
+}
@@ -0,0 +1,62 @@
+package urlobject_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testUrlObjectResource = struct {
+	Name string
+	Url  string
+}{
+	Name: "terraform-provider-cdo-acc-test-url-object",
+	Url:  "https://www.example.com",
+}
+
+const testUrlObjectResourceTemplate = `
+resource "cdo_cdfmc_url_object" "test" {
+	name        = "{{.Name}}"
+	description = "created by the terraform provider acceptance tests"
+	url         = "{{.Url}}"
+}`
+
+var testUrlObjectResourceConfig = acctest.MustParseTemplate(testUrlObjectResourceTemplate, testUrlObjectResource)
+
+var testUrlObjectResource_NewUrl = acctest.MustOverrideFields(testUrlObjectResource, map[string]any{
+	"Url": "https://www.example.org/path",
+})
+var testUrlObjectResourceConfig_NewUrl = acctest.MustParseTemplate(testUrlObjectResourceTemplate, testUrlObjectResource_NewUrl)
+
+func TestAccCdFmcUrlObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testUrlObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_cdfmc_url_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_cdfmc_url_object.test", "name", testUrlObjectResource.Name),
+					resource.TestCheckResourceAttr("cdo_cdfmc_url_object.test", "url", testUrlObjectResource.Url),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_cdfmc_url_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testUrlObjectResourceConfig_NewUrl,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_cdfmc_url_object.test", "url", testUrlObjectResource_NewUrl.Url),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
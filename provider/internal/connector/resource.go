@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -164,5 +166,17 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadConnectorByName(ctx, *connector.NewReadByNameInput(uidOrName))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import SDC", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("bootstrap_data"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&res.Diagnostics, "bootstrap_data")
 }
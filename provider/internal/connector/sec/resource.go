@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector/sec"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -13,6 +16,7 @@ import (
 )
 
 var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -175,3 +179,19 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 		res.Diagnostics.AddError("failed to delete Sec resource", err.Error())
 	}
 }
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadSecByName(ctx, sec.NewReadByNameInputBuilder().Name(uidOrName).Build())
+		if err != nil {
+			res.Diagnostics.AddError("failed to import SEC", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("cdo_bootstrap_data"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&res.Diagnostics, "cdo_bootstrap_data")
+}
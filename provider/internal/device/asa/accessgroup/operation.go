@@ -0,0 +1,98 @@
+package accessgroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadAsaAccessGroup(ctx, asaaccess.NewReadAccessGroupInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setAccessGroup(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	accessGroup, err := accessGroupFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateAsaAccessGroup(ctx, asaaccess.NewCreateAccessGroupInput(accessGroup))
+	if err != nil {
+		return err
+	}
+
+	setAccessGroup(planData, createOutp)
+
+	return asa.DeployConfig(ctx, resource.client, planData.DeviceUid.ValueString())
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	accessGroup, err := accessGroupFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateAsaAccessGroup(ctx, asaaccess.NewUpdateAccessGroupInput(planData.Id.ValueString(), accessGroup))
+	if err != nil {
+		return err
+	}
+
+	setAccessGroup(planData, updateOutp)
+
+	return asa.DeployConfig(ctx, resource.client, planData.DeviceUid.ValueString())
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsaAccessGroup(ctx, asaaccess.NewDeleteAccessGroupInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return asa.DeployConfig(ctx, resource.client, stateData.DeviceUid.ValueString())
+}
+
+func accessGroupFromModel(model *ResourceModel) (modelAccess.AccessGroup, error) {
+	direction := modelAccess.Direction(strings.ToUpper(model.Direction.ValueString()))
+	hasInterface := model.InterfaceName.ValueString() != ""
+	if direction == modelAccess.Global && hasInterface {
+		return modelAccess.AccessGroup{}, fmt.Errorf("interface_name cannot be set for a global access group")
+	}
+	if direction != modelAccess.Global && !hasInterface {
+		return modelAccess.AccessGroup{}, fmt.Errorf("interface_name must be set for direction %s", model.Direction.ValueString())
+	}
+
+	return modelAccess.AccessGroup{
+		DeviceUid:     model.DeviceUid.ValueString(),
+		AccessListUid: model.AccessListId.ValueString(),
+		InterfaceName: model.InterfaceName.ValueString(),
+		Direction:     direction,
+	}, nil
+}
+
+func setAccessGroup(model *ResourceModel, accessGroup *modelAccess.AccessGroup) {
+	model.Id = types.StringValue(accessGroup.Uid)
+	model.DeviceUid = types.StringValue(accessGroup.DeviceUid)
+	model.AccessListId = types.StringValue(accessGroup.AccessListUid)
+	if accessGroup.InterfaceName != "" {
+		model.InterfaceName = types.StringValue(accessGroup.InterfaceName)
+	} else {
+		model.InterfaceName = types.StringNull()
+	}
+	model.Direction = types.StringValue(strings.ToLower(string(accessGroup.Direction)))
+}
@@ -0,0 +1,79 @@
+package accessgroup_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccessGroupResource = struct {
+	AsaName    string
+	AccessList string
+}{
+	AsaName:    acctest.Env.AsaDataSourceName(),
+	AccessList: "first",
+}
+
+const testAccessGroupResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_asa_access_list" "first" {
+	device_uid = data.cdo_asa_device.test.id
+	name       = "terraform-provider-cdo-acc-test-asa-access-group-first"
+	entries    = [{ action = "permit" }]
+}
+
+resource "cdo_asa_access_list" "second" {
+	device_uid = data.cdo_asa_device.test.id
+	name       = "terraform-provider-cdo-acc-test-asa-access-group-second"
+	entries    = [{ action = "deny" }]
+}
+
+resource "cdo_asa_access_group" "test" {
+	device_uid     = data.cdo_asa_device.test.id
+	access_list_id = cdo_asa_access_list.{{.AccessList}}.id
+	direction      = "global"
+}`
+
+var testAccessGroupResourceConfig = acctest.MustParseTemplate(testAccessGroupResourceTemplate, testAccessGroupResource)
+
+var testAccessGroupResource_NewAccessList = acctest.MustOverrideFields(testAccessGroupResource, map[string]any{
+	"AccessList": "second",
+})
+var testAccessGroupResourceConfig_NewAccessList = acctest.MustParseTemplate(testAccessGroupResourceTemplate, testAccessGroupResource_NewAccessList)
+
+func TestAccAsaAccessGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessGroupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_access_group.test", "id"),
+					resource.TestCheckResourceAttrPair("cdo_asa_access_group.test", "access_list_id", "cdo_asa_access_list.first", "id"),
+					resource.TestCheckNoResourceAttr("cdo_asa_access_group.test", "interface_name"),
+					resource.TestCheckResourceAttr("cdo_asa_access_group.test", "direction", "global"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_asa_access_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessGroupResourceConfig_NewAccessList,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_asa_access_group.test", "access_list_id", "cdo_asa_access_list.second", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
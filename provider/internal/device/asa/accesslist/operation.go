@@ -0,0 +1,136 @@
+package accesslist
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaaccess"
+	modelAccess "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaaccess"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadAsaAccessList(ctx, asaaccess.NewReadAccessListInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setAccessList(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	createOutp, err := resource.client.CreateAsaAccessList(ctx, asaaccess.NewCreateAccessListInput(accessListFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	if err := asa.DeployConfig(ctx, resource.client, planData.DeviceUid.ValueString()); err != nil {
+		return err
+	}
+
+	// read the access list again for the hit counts after the deployment
+	planData.Id = types.StringValue(createOutp.Uid)
+	return Read(ctx, resource, planData)
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	_, err := resource.client.UpdateAsaAccessList(ctx, asaaccess.NewUpdateAccessListInput(planData.Id.ValueString(), accessListFromModel(planData)))
+	if err != nil {
+		return err
+	}
+
+	if err := asa.DeployConfig(ctx, resource.client, planData.DeviceUid.ValueString()); err != nil {
+		return err
+	}
+
+	return Read(ctx, resource, planData)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsaAccessList(ctx, asaaccess.NewDeleteAccessListInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return asa.DeployConfig(ctx, resource.client, stateData.DeviceUid.ValueString())
+}
+
+func accessListFromModel(model *ResourceModel) modelAccess.AccessList {
+	entries := make([]modelAccess.Entry, len(model.Entries))
+	for i, entry := range model.Entries {
+		entries[i] = modelAccess.Entry{
+			// positions start at 1 on the ASA
+			Position:    i + 1,
+			Action:      modelAccess.Action(strings.ToUpper(entry.Action.ValueString())),
+			Source:      modelAccess.NewReference(entry.SourceObjectId.ValueString()),
+			Destination: modelAccess.NewReference(entry.DestinationObjectId.ValueString()),
+			Service:     modelAccess.NewReference(entry.ServiceObjectId.ValueString()),
+			Remark:      entry.Remark.ValueString(),
+		}
+		if !entry.LogLevel.IsNull() {
+			entries[i].Logging = &modelAccess.Logging{
+				Level:    entry.LogLevel.ValueString(),
+				Interval: int(entry.LogInterval.ValueInt64()),
+			}
+		}
+	}
+
+	return modelAccess.AccessList{
+		DeviceUid: model.DeviceUid.ValueString(),
+		Name:      model.Name.ValueString(),
+		Entries:   entries,
+	}
+}
+
+func setAccessList(model *ResourceModel, accessList *modelAccess.AccessList) {
+	model.Id = types.StringValue(accessList.Uid)
+	model.DeviceUid = types.StringValue(accessList.DeviceUid)
+	model.Name = types.StringValue(accessList.Name)
+
+	// CDO does not guarantee the order of the entries, sort them by position so that they are in the order of the config
+	entries := make([]modelAccess.Entry, len(accessList.Entries))
+	copy(entries, accessList.Entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Position < entries[j].Position
+	})
+
+	model.Entries = make([]EntryModel, len(entries))
+	for i, entry := range entries {
+		model.Entries[i] = EntryModel{
+			Action:              types.StringValue(strings.ToLower(string(entry.Action))),
+			SourceObjectId:      referenceToModel(entry.Source),
+			DestinationObjectId: referenceToModel(entry.Destination),
+			ServiceObjectId:     referenceToModel(entry.Service),
+			LogLevel:            types.StringNull(),
+			LogInterval:         types.Int64Null(),
+			Remark:              types.StringNull(),
+			HitCount:            types.Int64Value(entry.HitCount),
+		}
+		if entry.Remark != "" {
+			model.Entries[i].Remark = types.StringValue(entry.Remark)
+		}
+		if entry.Logging != nil {
+			model.Entries[i].LogLevel = types.StringValue(entry.Logging.Level)
+			if entry.Logging.Interval != 0 {
+				model.Entries[i].LogInterval = types.Int64Value(int64(entry.Logging.Interval))
+			}
+		}
+	}
+}
+
+// referenceToModel returns the ID of the referenced object, or null if the entry matches any.
+func referenceToModel(reference *asaobject.Reference) types.String {
+	if reference == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(reference.Uid)
+}
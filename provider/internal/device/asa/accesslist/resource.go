@@ -0,0 +1,229 @@
+package accesslist
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	DeviceUid types.String `tfsdk:"device_uid"`
+	Name      types.String `tfsdk:"name"`
+	Entries   []EntryModel `tfsdk:"entries"`
+}
+
+type EntryModel struct {
+	Action              types.String `tfsdk:"action"`
+	SourceObjectId      types.String `tfsdk:"source_object_id"`
+	DestinationObjectId types.String `tfsdk:"destination_object_id"`
+	ServiceObjectId     types.String `tfsdk:"service_object_id"`
+	LogLevel            types.String `tfsdk:"log_level"`
+	LogInterval         types.Int64  `tfsdk:"log_interval"`
+	Remark              types.String `tfsdk:"remark"`
+	HitCount            types.Int64  `tfsdk:"hit_count"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asa_access_list"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides an extended access list on an ASA managed by CDO. " +
+			"The entries are evaluated in the order they are listed in, and the first matching entry decides whether the traffic is permitted or denied. " +
+			"Changes are deployed to the ASA when they are applied. Use the `cdo_asa_access_group` resource to apply the access list to the traffic of an interface.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the access list on CDO.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA the access list is on, as returned by the `id` attribute of the `cdo_asa_device` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the access list.",
+				Required:            true,
+			},
+			"entries": schema.ListNestedAttribute{
+				MarkdownDescription: "The access control entries of the access list, in the order they are evaluated in.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Whether the traffic matched by the entry is permitted or denied. Allowed values are: [\"permit\", \"deny\"].",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("permit", "deny"),
+							},
+						},
+						"source_object_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the network object or network object group the traffic comes from, as returned by the `id` attribute of the `cdo_asa_network_object` or `cdo_asa_object_group` resource. The entry matches any source if it is not set.",
+							Optional:            true,
+						},
+						"destination_object_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the network object or network object group the traffic goes to, as returned by the `id` attribute of the `cdo_asa_network_object` or `cdo_asa_object_group` resource. The entry matches any destination if it is not set.",
+							Optional:            true,
+						},
+						"service_object_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the service object or service object group of the traffic, as returned by the `id` attribute of the `cdo_asa_service_object` or `cdo_asa_object_group` resource. The entry matches any IP traffic if it is not set.",
+							Optional:            true,
+						},
+						"log_level": schema.StringAttribute{
+							MarkdownDescription: "The level the traffic matched by the entry is logged at, the traffic is not logged if it is not set. Allowed values are: [\"default\", \"emergencies\", \"alerts\", \"critical\", \"errors\", \"warnings\", \"notifications\", \"informational\", \"debugging\"].",
+							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("default", "emergencies", "alerts", "critical", "errors", "warnings", "notifications", "informational", "debugging"),
+							},
+						},
+						"log_interval": schema.Int64Attribute{
+							MarkdownDescription: "The interval in seconds between the log messages of the traffic matched by the entry, between 1 and 600. The ASA uses its default interval of 300 seconds if it is not set, which is then read back from the ASA.",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 600),
+								int64validator.AlsoRequires(path.MatchRelative().AtParent().AtName("log_level")),
+							},
+						},
+						"remark": schema.StringAttribute{
+							MarkdownDescription: "A remark describing the entry.",
+							Optional:            true,
+						},
+						"hit_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of times the entry matched traffic on the ASA.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "create ASA access list resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Create(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to create ASA access list", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read ASA access list resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read ASA access list", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update ASA access list resource")
+
+	var planData ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update ASA access list", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete ASA access list resource")
+
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Delete(ctx, r, &stateData); err != nil {
+		resp.Diagnostics.AddError("failed to delete ASA access list", err.Error())
+		return
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
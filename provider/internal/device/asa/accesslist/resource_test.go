@@ -0,0 +1,111 @@
+package accesslist_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testAccessListResource = struct {
+	AsaName     string
+	Name        string
+	FirstEntry  string
+	SecondEntry string
+}{
+	AsaName:     acctest.Env.AsaDataSourceName(),
+	Name:        "terraform-provider-cdo-acc-test-asa-access-list",
+	FirstEntry:  "permit_web",
+	SecondEntry: "deny_all",
+}
+
+const testAccessListResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_asa_network_object" "test" {
+	name        = "terraform-provider-cdo-acc-test-asa-access-list-network"
+	device_uids = [data.cdo_asa_device.test.id]
+	type        = "Network"
+	value       = "10.10.0.0/16"
+}
+
+resource "cdo_asa_service_object" "test" {
+	name             = "terraform-provider-cdo-acc-test-asa-access-list-service"
+	device_uids      = [data.cdo_asa_device.test.id]
+	protocol         = "tcp"
+	destination_port = "443"
+}
+
+locals {
+	entries = {
+		permit_web = {
+			action            = "permit"
+			source_object_id  = cdo_asa_network_object.test.id
+			service_object_id = cdo_asa_service_object.test.id
+			log_level         = "informational"
+			log_interval      = 300
+			remark            = "allow the internal network to the web"
+		}
+		deny_all = {
+			action = "deny"
+		}
+	}
+}
+
+resource "cdo_asa_access_list" "test" {
+	device_uid = data.cdo_asa_device.test.id
+	name       = "{{.Name}}"
+	entries    = [local.entries.{{.FirstEntry}}, local.entries.{{.SecondEntry}}]
+}`
+
+var testAccessListResourceConfig = acctest.MustParseTemplate(testAccessListResourceTemplate, testAccessListResource)
+
+var testAccessListResource_Reordered = acctest.MustOverrideFields(testAccessListResource, map[string]any{
+	"FirstEntry":  "deny_all",
+	"SecondEntry": "permit_web",
+})
+var testAccessListResourceConfig_Reordered = acctest.MustParseTemplate(testAccessListResourceTemplate, testAccessListResource_Reordered)
+
+func TestAccAsaAccessListResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessListResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_access_list.test", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "name", testAccessListResource.Name),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.0.action", "permit"),
+					resource.TestCheckResourceAttrPair("cdo_asa_access_list.test", "entries.0.source_object_id", "cdo_asa_network_object.test", "id"),
+					resource.TestCheckNoResourceAttr("cdo_asa_access_list.test", "entries.0.destination_object_id"),
+					resource.TestCheckResourceAttrPair("cdo_asa_access_list.test", "entries.0.service_object_id", "cdo_asa_service_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.0.log_level", "informational"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.0.log_interval", "300"),
+					resource.TestCheckResourceAttrSet("cdo_asa_access_list.test", "entries.0.hit_count"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.1.action", "deny"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_asa_access_list.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testAccessListResourceConfig_Reordered,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.#", "2"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.0.action", "deny"),
+					resource.TestCheckResourceAttr("cdo_asa_access_list.test", "entries.1.action", "permit"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,43 @@
+package asaonboarding
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	// the onboarding goes away with the ASA
+	_, err := resource.client.ReadAsa(ctx, *asa.NewReadInput(stateData.AsaUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	waitOutp, err := resource.client.WaitForAsaRegistration(ctx, asa.NewWaitForRegistrationInput(planData.AsaUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	planData.Id = types.StringValue(waitOutp.Uid)
+
+	return nil
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel, stateData *ResourceModel) error {
+
+	// asa_uid requires replace, so there is nothing to update
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	// the ASA is deleted by the cdo_asa_device resource
+	return nil
+}
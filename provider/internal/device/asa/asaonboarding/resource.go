@@ -0,0 +1,167 @@
+package asaonboarding
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	AsaUid types.String `tfsdk:"asa_uid"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asa_device_onboarding"
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource is meant to be used in conjunction with a `cdo_asa_device` resource with `onboarding_method` set to `registration_key`, to complete the onboarding of the ASA to CDO. " +
+			"The `cdo_asa_device` creates an ASA device on CDO that is pending registration, and generates a command with the registration key that should be run on the ASA CLI. " +
+			"This resource waits for you to finish running the registration command, and for CDO to onboard the ASA. " +
+			"If you are spinning up an ASAv using Terraform, you can pass the `registration_command` of the `cdo_asa_device` through to the ASAv. " +
+			"This resource will time out if the ASA does not register within 30 minutes of it starting to poll.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of this ASA onboarding resource, it is the ID of the ASA.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"asa_uid": schema.StringAttribute{
+				MarkdownDescription: "The ID of the ASA to wait for. This value is returned by the `id` attribute of the `cdo_asa_device` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read ASA onboarding resource")
+
+	// 1. read terraform plan data into the model
+	var stateData ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// 2. do read
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read ASA onboarding resource", err.Error())
+		return
+	}
+
+	// 3. save data into terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+	tflog.Trace(ctx, "read ASA onboarding resource done")
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, res *resource.CreateResponse) {
+	tflog.Trace(ctx, "create ASA onboarding resource")
+
+	// 1. read terraform plan data into model
+	var planData ResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// 2. create resource & fill model data
+	if err := Create(ctx, r, &planData); err != nil {
+		res.Diagnostics.AddError("failed to create ASA onboarding resource", err.Error())
+		return
+	}
+
+	// 3. fill terraform state using model data
+	res.Diagnostics.Append(res.State.Set(ctx, &planData)...)
+	tflog.Trace(ctx, "create ASA onboarding resource done")
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update ASA onboarding resource")
+
+	// 1. read plan and state data from terraform
+	var planData ResourceModel
+	res.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+	var stateData ResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// 2. update resource & state data
+	if err := Update(ctx, r, &planData, &stateData); err != nil {
+		res.Diagnostics.AddError("failed to update ASA onboarding resource", err.Error())
+		return
+	}
+
+	// 3. update terraform state with updated state data
+	res.Diagnostics.Append(res.State.Set(ctx, &stateData)...)
+	tflog.Trace(ctx, "update ASA onboarding resource done")
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete ASA onboarding resource")
+
+	// 1. read state data from terraform state
+	var stateData ResourceModel
+	res.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if res.Diagnostics.HasError() {
+		return
+	}
+
+	// 2. delete the resource
+	if err := Delete(ctx, r, &stateData); err != nil {
+		res.Diagnostics.AddError("failed to delete ASA onboarding resource", err.Error())
+	}
+}
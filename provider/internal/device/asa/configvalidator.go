@@ -0,0 +1,55 @@
+package asa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ConfigValidator = &onboardingMethodConfigValidator{}
+
+type onboardingMethodConfigValidator struct{}
+
+func (c onboardingMethodConfigValidator) Description(ctx context.Context) string {
+	return c.MarkdownDescription(ctx)
+}
+
+func (c onboardingMethodConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintln("Ensure username and password are only set when onboarding the ASA with credentials.")
+}
+
+func (c onboardingMethodConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData AsaDeviceResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the onboarding method is not known yet, e.g. it is the output of another resource
+	if configData.OnboardingMethod.IsUnknown() {
+		return
+	}
+
+	if configData.OnboardingMethod.ValueString() == onboardingMethodRegistrationKey {
+		// no credentials should be sent to CDO when onboarding with a registration key
+		if !configData.Username.IsNull() || !configData.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("onboarding_method"),
+				"Username and password must not be set when onboarding with a registration key",
+				"Remove username and password, or set onboarding_method to \"credentials\".",
+			)
+		}
+		return
+	}
+
+	// onboarding method is null (defaults to credentials) or credentials
+	if configData.Username.IsNull() || configData.Password.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("onboarding_method"),
+			"Username and password must be set when onboarding with credentials",
+			"Set username and password, or set onboarding_method to \"registration_key\".",
+		)
+	}
+}
@@ -0,0 +1,15 @@
+package asa
+
+import (
+	"context"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+)
+
+// DeployConfig deploys the changes staged on CDO to the ASA with the given device UID, and waits for the deployment to finish.
+func DeployConfig(ctx context.Context, client *cdoClient.Client, deviceUid string) error {
+	_, err := client.DeployDeviceChanges(ctx, changes.NewDeployInput(deviceUid, devicetype.Asa))
+	return err
+}
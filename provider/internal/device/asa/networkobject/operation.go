@@ -0,0 +1,93 @@
+package networkobject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadAsaObject(ctx, asaobjects.NewReadInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return setObject(stateData, readOutp)
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateAsaObject(ctx, asaobjects.NewCreateInput(object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, createOutp)
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateAsaObject(ctx, asaobjects.NewUpdateInput(planData.Id.ValueString(), object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, updateOutp)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsaObject(ctx, asaobjects.NewDeleteInput(stateData.Id.ValueString()))
+	return err
+}
+
+func objectFromModel(ctx context.Context, model *ResourceModel) (asaobject.Object, error) {
+	targets, err := asa.ObjectTargetsFromModel(ctx, model.DeviceUids)
+	if err != nil {
+		return asaobject.Object{}, err
+	}
+
+	return asaobject.Object{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ObjectType:  asaobject.NetworkObject,
+		Contents: []asaobject.Content{
+			asaobject.NewNetworkContent(asaobject.NetworkType(model.Type.ValueString()), model.Value.ValueString()),
+		},
+		Targets: targets,
+	}, nil
+}
+
+func setObject(model *ResourceModel, object *asaobject.Object) error {
+	if object.ObjectType != asaobject.NetworkObject || len(object.Contents) != 1 {
+		return fmt.Errorf("object %s is a %s, not a network object", object.Uid, object.ObjectType)
+	}
+
+	model.Id = types.StringValue(object.Uid)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.DeviceUids = asa.ObjectTargetsToModel(object.Targets)
+	model.Type = types.StringValue(string(object.Contents[0].NetworkType))
+	model.Value = types.StringValue(object.Contents[0].Value)
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package networkobject_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testNetworkObjectResource = struct {
+	AsaName string
+	Name    string
+	Type    string
+	Value   string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+	Name:    "terraform-provider-cdo-acc-test-asa-network-object",
+	Type:    "Network",
+	Value:   "10.10.0.0/16",
+}
+
+const testNetworkObjectResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_asa_network_object" "test" {
+	name        = "{{.Name}}"
+	device_uids = [data.cdo_asa_device.test.id]
+	type        = "{{.Type}}"
+	value       = "{{.Value}}"
+}`
+
+var testNetworkObjectResourceConfig = acctest.MustParseTemplate(testNetworkObjectResourceTemplate, testNetworkObjectResource)
+
+var testNetworkObjectResource_NewValue = acctest.MustOverrideFields(testNetworkObjectResource, map[string]any{
+	"Type":  "Range",
+	"Value": "10.10.10.1-10.10.10.9",
+})
+var testNetworkObjectResourceConfig_NewValue = acctest.MustParseTemplate(testNetworkObjectResourceTemplate, testNetworkObjectResource_NewValue)
+
+func TestAccAsaNetworkObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_network_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "name", testNetworkObjectResource.Name),
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "device_uids.#", "1"),
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "type", testNetworkObjectResource.Type),
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "value", testNetworkObjectResource.Value),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_asa_network_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testNetworkObjectResourceConfig_NewValue,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "type", testNetworkObjectResource_NewValue.Type),
+					resource.TestCheckResourceAttr("cdo_asa_network_object.test", "value", testNetworkObjectResource_NewValue.Value),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
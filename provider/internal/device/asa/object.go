@@ -0,0 +1,26 @@
+package asa
+
+import (
+	"context"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util/sliceutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ObjectTargetsFromModel converts the device UIDs of an ASA object resource to the ASAs the object is on, an object on more than one ASA is shared.
+func ObjectTargetsFromModel(ctx context.Context, deviceUids types.Set) ([]asaobject.Target, error) {
+	uids, err := util.TFStringSetToGoStringList(ctx, deviceUids)
+	if err != nil {
+		return nil, err
+	}
+	return sliceutil.Map(uids, asaobject.NewTarget), nil
+}
+
+// ObjectTargetsToModel converts the ASAs an object is on to the device UIDs of an ASA object resource.
+func ObjectTargetsToModel(targets []asaobject.Target) types.Set {
+	return util.GoStringSliceToTFStringSet(sliceutil.Map(targets, func(target asaobject.Target) string {
+		return target.Uid
+	}))
+}
@@ -0,0 +1,145 @@
+package objectgroup
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util/sliceutil"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	networkGroupType = "Network"
+	serviceGroupType = "Service"
+)
+
+var typeToObjectType = map[string]asaobject.Type{
+	networkGroupType: asaobject.NetworkGroup,
+	serviceGroupType: asaobject.ServiceGroup,
+}
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadAsaObject(ctx, asaobjects.NewReadInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return setObject(stateData, readOutp)
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateAsaObject(ctx, asaobjects.NewCreateInput(object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, createOutp)
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateAsaObject(ctx, asaobjects.NewUpdateInput(planData.Id.ValueString(), object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, updateOutp)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsaObject(ctx, asaobjects.NewDeleteInput(stateData.Id.ValueString()))
+	return err
+}
+
+func objectFromModel(ctx context.Context, model *ResourceModel) (asaobject.Object, error) {
+	objectIds, err := util.TFStringSetToGoStringList(ctx, model.ObjectIds)
+	if err != nil {
+		return asaobject.Object{}, err
+	}
+	literals, err := util.TFStringSetToGoStringList(ctx, model.Literals)
+	if err != nil {
+		return asaobject.Object{}, err
+	}
+	if len(literals) > 0 && model.Type.ValueString() != networkGroupType {
+		return asaobject.Object{}, fmt.Errorf("literals can only be set for network groups")
+	}
+	targets, err := asa.ObjectTargetsFromModel(ctx, model.DeviceUids)
+	if err != nil {
+		return asaobject.Object{}, err
+	}
+
+	return asaobject.Object{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ObjectType:  typeToObjectType[model.Type.ValueString()],
+		Contents: sliceutil.Map(literals, func(value string) asaobject.Content {
+			return asaobject.NewNetworkContent(literalType(value), value)
+		}),
+		References: sliceutil.Map(objectIds, asaobject.NewReference),
+		Targets:    targets,
+	}, nil
+}
+
+// literalType returns the network type of a literal from the notation it is written in.
+func literalType(value string) asaobject.NetworkType {
+	if strings.Contains(value, "/") {
+		return asaobject.SubnetNetwork
+	}
+	if strings.Contains(value, "-") {
+		return asaobject.RangeNetwork
+	}
+	return asaobject.HostNetwork
+}
+
+func setObject(model *ResourceModel, object *asaobject.Object) error {
+	switch object.ObjectType {
+	case asaobject.NetworkGroup:
+		model.Type = types.StringValue(networkGroupType)
+	case asaobject.ServiceGroup:
+		model.Type = types.StringValue(serviceGroupType)
+	default:
+		return fmt.Errorf("object %s is a %s, not an object group", object.Uid, object.ObjectType)
+	}
+
+	model.Id = types.StringValue(object.Uid)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.DeviceUids = asa.ObjectTargetsToModel(object.Targets)
+	// object IDs and literals that are not set are null in the config
+	model.ObjectIds = types.SetNull(types.StringType)
+	if len(object.References) > 0 {
+		model.ObjectIds = util.GoStringSliceToTFStringSet(sliceutil.Map(object.References, func(reference asaobject.Reference) string {
+			return reference.Uid
+		}))
+	}
+	model.Literals = types.SetNull(types.StringType)
+	if len(object.Contents) > 0 {
+		model.Literals = util.GoStringSliceToTFStringSet(sliceutil.Map(object.Contents, func(content asaobject.Content) string {
+			return content.Value
+		}))
+	}
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package objectgroup_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testObjectGroupResource = struct {
+	AsaName string
+	Name    string
+	Literal string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+	Name:    "terraform-provider-cdo-acc-test-asa-object-group",
+	Literal: "10.20.0.0/16",
+}
+
+const testObjectGroupResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_asa_network_object" "test" {
+	name        = "terraform-provider-cdo-acc-test-asa-object-group-member"
+	device_uids = [data.cdo_asa_device.test.id]
+	type        = "Host"
+	value       = "10.10.10.10"
+}
+
+resource "cdo_asa_object_group" "test" {
+	name        = "{{.Name}}"
+	device_uids = [data.cdo_asa_device.test.id]
+	type        = "Network"
+	object_ids  = [cdo_asa_network_object.test.id]
+	literals    = ["{{.Literal}}"]
+}`
+
+var testObjectGroupResourceConfig = acctest.MustParseTemplate(testObjectGroupResourceTemplate, testObjectGroupResource)
+
+var testObjectGroupResource_NewLiteral = acctest.MustOverrideFields(testObjectGroupResource, map[string]any{
+	"Literal": "10.30.0.1-10.30.0.9",
+})
+var testObjectGroupResourceConfig_NewLiteral = acctest.MustParseTemplate(testObjectGroupResourceTemplate, testObjectGroupResource_NewLiteral)
+
+func TestAccAsaObjectGroupResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testObjectGroupResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_object_group.test", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_object_group.test", "name", testObjectGroupResource.Name),
+					resource.TestCheckResourceAttr("cdo_asa_object_group.test", "type", "Network"),
+					resource.TestCheckResourceAttr("cdo_asa_object_group.test", "object_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cdo_asa_object_group.test", "literals.*", testObjectGroupResource.Literal),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_asa_object_group.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testObjectGroupResourceConfig_NewLiteral,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("cdo_asa_object_group.test", "literals.*", testObjectGroupResource_NewLiteral.Literal),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -9,13 +9,18 @@ import (
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/connectivity"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/publicapilabels"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/validators"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -23,6 +28,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -35,6 +41,12 @@ import (
 
 var _ resource.Resource = &AsaDeviceResource{}
 var _ resource.ResourceWithImportState = &AsaDeviceResource{}
+var _ resource.ResourceWithConfigValidators = &AsaDeviceResource{}
+
+const (
+	onboardingMethodCredentials     = "credentials"
+	onboardingMethodRegistrationKey = "registration_key"
+)
 
 func NewAsaDeviceResource() resource.Resource {
 	return &AsaDeviceResource{}
@@ -55,11 +67,21 @@ type AsaDeviceResourceModel struct {
 	Labels        types.Set    `tfsdk:"labels"`
 	GroupedLabels types.Map    `tfsdk:"grouped_labels"`
 
+	OnboardingMethod    types.String `tfsdk:"onboarding_method"`
+	RegistrationCommand types.String `tfsdk:"registration_command"`
+
 	Username          types.String `tfsdk:"username"`
 	Password          types.String `tfsdk:"password"`
 	IgnoreCertificate types.Bool   `tfsdk:"ignore_certificate"`
 	SoftwareVersion   types.String `tfsdk:"software_version"`
 	AsdmVersion       types.String `tfsdk:"asdm_version"`
+	ConnectivityState types.String `tfsdk:"connectivity_state"`
+	CredentialsValid  types.Bool   `tfsdk:"credentials_valid"`
+	HaAwareUpgrade    types.Bool   `tfsdk:"ha_aware_upgrade"`
+	HaMode            types.String `tfsdk:"ha_mode"`
+	HaRole            types.String `tfsdk:"ha_role"`
+	HaPeerUnit        types.String `tfsdk:"ha_peer_unit"`
+	FailoverHealth    types.String `tfsdk:"failover_health"`
 }
 
 func (r *AsaDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,21 +106,15 @@ func (r *AsaDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:            true,
 			},
 			"connector_name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Secure Device Connector (SDC) that will be used to communicate with the device. This value is not required if the connector type selected is Cloud Connector (CDG).",
+				MarkdownDescription: "The name of the Secure Device Connector (SDC) that will be used to communicate with the device. This value is not required if the connector type selected is Cloud Connector (CDG). Changing the connector moves the device to the new connector without onboarding it again.",
 				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"connector_type": schema.StringAttribute{
-				MarkdownDescription: "The type of the connector that will be used to communicate with the device. CDO can communicate with your device using either a Cloud Connector (CDG) or a Secure Device Connector (SDC); see [the CDO documentation](https://docs.defenseorchestrator.com/c-connect-cisco-defense-orchestratortor-the-secure-device-connector.html) to learn more (Valid values: [CDG, SDC]).",
+				MarkdownDescription: "The type of the connector that will be used to communicate with the device. CDO can communicate with your device using either a Cloud Connector (CDG) or a Secure Device Connector (SDC); see [the CDO documentation](https://docs.defenseorchestrator.com/c-connect-cisco-defense-orchestratortor-the-secure-device-connector.html) to learn more (Valid values: [CDG, SDC]). Changing the connector type moves the device to the new connector without onboarding it again.",
 				Required:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf("CDG", "SDC"),
 				},
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"socket_address": schema.StringAttribute{
 				MarkdownDescription: "The address of the device to onboard, specified in the format `host:port`.",
@@ -137,13 +153,33 @@ func (r *AsaDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 				Default: mapdefault.StaticValue(types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{})), // default to empty list
 			},
+			"onboarding_method": schema.StringAttribute{
+				MarkdownDescription: "How the device is onboarded to CDO (Valid values: [credentials, registration_key]). With `credentials`, CDO logs in to the device using `username` and `password`. With `registration_key`, no credentials are sent to CDO: the device is created pending registration, and the command in `registration_command` must be run on the ASA CLI to register it. Use the `cdo_asa_device_onboarding` resource to wait for the device to register. Changing the onboarding method onboards the device again.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(onboardingMethodCredentials),
+				Validators: []validator.String{
+					stringvalidator.OneOf(onboardingMethodCredentials, onboardingMethodRegistrationKey),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"registration_command": schema.StringAttribute{
+				MarkdownDescription: "The command, containing the registration key, to run on the ASA CLI to register the device with CDO. This is only set if `onboarding_method` is `registration_key`.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"username": schema.StringAttribute{
-				MarkdownDescription: "The username used to authenticate with the device.",
-				Required:            true,
+				MarkdownDescription: "The username used to authenticate with the device. This is required if `onboarding_method` is `credentials`, and must not be set if it is `registration_key`.",
+				Optional:            true,
 			},
 			"password": schema.StringAttribute{
-				MarkdownDescription: "The password used to authenticate with the device.",
-				Required:            true,
+				MarkdownDescription: "The password used to authenticate with the device. This is required if `onboarding_method` is `credentials`, and must not be set if it is `registration_key`.",
+				Optional:            true,
 				Sensitive:           true,
 			},
 			"ignore_certificate": schema.BoolAttribute{
@@ -160,10 +196,46 @@ func (r *AsaDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Optional:            true,
 				Computed:            true,
 			},
+			"connectivity_state": schema.StringAttribute{
+				MarkdownDescription: "The connectivity state of the device as reported by CDO (Possible values: [ONLINE, OFFLINE, UNKNOWN]). A device that CDO cannot reach, for example because it was moved or its connector is down, is reported as `OFFLINE`.",
+				Computed:            true,
+			},
+			"credentials_valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether CDO can log in to the device with its current credentials. This is `false` when the credentials were changed on the device, or in the CDO UI, without being updated here.",
+				Computed:            true,
+			},
+			"ha_aware_upgrade": schema.BoolAttribute{
+				MarkdownDescription: "Set this attribute to true to upgrade an ASA failover pair without taking it out of service when `software_version` or `asdm_version` is changed: the standby unit is upgraded first, the pair fails over to it, the other unit is upgraded, and the pair fails back. This requires a healthy active/standby failover pair.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"ha_mode": schema.StringAttribute{
+				MarkdownDescription: "The failover mode of the device (Possible values: [STANDALONE, ACTIVE_STANDBY, ACTIVE_ACTIVE]).",
+				Computed:            true,
+			},
+			"ha_role": schema.StringAttribute{
+				MarkdownDescription: "The role of the unit CDO manages in the failover pair (Possible values: [ACTIVE, STANDBY]). This is not set for standalone devices.",
+				Computed:            true,
+			},
+			"ha_peer_unit": schema.StringAttribute{
+				MarkdownDescription: "The peer unit of the device in the failover pair. This is not set for standalone devices.",
+				Computed:            true,
+			},
+			"failover_health": schema.StringAttribute{
+				MarkdownDescription: "The health of the failover pair as reported by CDO (Possible values: [HEALTHY, DEGRADED, FAILED]). This is not set for standalone devices.",
+				Computed:            true,
+			},
 		},
 	}
 }
 
+func (r *AsaDeviceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		onboardingMethodConfigValidator{},
+	}
+}
+
 func (r *AsaDeviceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -184,7 +256,7 @@ func (r *AsaDeviceResource) Configure(ctx context.Context, req resource.Configur
 	r.client = client
 }
 
-// TODO plan diffing should exclude host, id, port, and sdc_name (unless SDCType is changed, in which case it should be a destroy and create)
+// TODO plan diffing should exclude host, id, port, and sdc_name
 // TODO terraform should wait when credentials are updated and the device is synced
 // TODO verify changing groups of changes
 
@@ -224,12 +296,15 @@ func (r *AsaDeviceResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	port, err := strconv.ParseInt(asaReadOutp.Port, 10, 16)
-	if err != nil {
-		resp.Diagnostics.AddError("unable to read ASA Device", err.Error())
-		return
+	// a device onboarded with a registration key does not report its port and versions until it has registered
+	if asaReadOutp.Port != "" {
+		port, err := strconv.ParseInt(asaReadOutp.Port, 10, 16)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read ASA Device", err.Error())
+			return
+		}
+		stateData.Port = types.Int64Value(port)
 	}
-	stateData.Port = types.Int64Value(port)
 
 	stateData.ID = types.StringValue(asaReadOutp.Uid)
 	stateData.ConnectorType = types.StringValue(asaReadOutp.ConnectorType)
@@ -239,8 +314,29 @@ func (r *AsaDeviceResource) Read(ctx context.Context, req resource.ReadRequest,
 	stateData.IgnoreCertificate = types.BoolValue(asaReadOutp.IgnoreCertificate)
 	stateData.Labels = util.GoStringSliceToTFStringSet(asaReadOutp.Tags.UngroupedTags())
 	stateData.GroupedLabels = util.GoMapToStringSetTFMap(asaReadOutp.Tags.GroupedTags())
-	stateData.SoftwareVersion = types.StringValue(asaReadOutp.SoftwareVersion)
-	stateData.AsdmVersion = types.StringValue(asaSpecificDeviceReadOutp.Metadata.AsdmVersion)
+	if asaReadOutp.SoftwareVersion != "" || !isOnboardedWithRegistrationKey(stateData) {
+		stateData.SoftwareVersion = types.StringValue(asaReadOutp.SoftwareVersion)
+		stateData.AsdmVersion = types.StringValue(asaSpecificDeviceReadOutp.Metadata.AsdmVersion)
+	}
+	setHealth(stateData, asaReadOutp, asaSpecificDeviceReadOutp)
+	setFailover(stateData, asaSpecificDeviceReadOutp)
+	if stateData.OnboardingMethod.IsNull() {
+		stateData.OnboardingMethod = types.StringValue(onboardingMethodCredentials)
+	}
+	if stateData.HaAwareUpgrade.IsNull() {
+		stateData.HaAwareUpgrade = types.BoolValue(false)
+	}
+
+	// look up the connector name using the connector uid of the device, so that moving the device
+	// to another SDC outside of terraform shows up as drift. It is also not known after import.
+	if asaReadOutp.ConnectorType == "SDC" {
+		readSdcOutp, err := r.client.ReadConnectorByUid(ctx, *connector.NewReadByUidInput(asaReadOutp.ConnectorUid))
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read ASA Device connector", err.Error())
+			return
+		}
+		stateData.ConnectorName = types.StringValue(readSdcOutp.Name)
+	}
 
 	tflog.Trace(ctx, "done read ASA device resource")
 
@@ -282,6 +378,11 @@ func (r *AsaDeviceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
+	if planData.OnboardingMethod.ValueString() == onboardingMethodRegistrationKey {
+		r.createWithRegistrationKey(ctx, &planData, specificSdcOutp, planTags, res)
+		return
+	}
+
 	createInp := asa.NewCreateRequestInput(
 		planData.Name.ValueString(),
 		specificSdcOutp.Uid,
@@ -314,26 +415,71 @@ func (r *AsaDeviceResource) Create(ctx context.Context, req resource.CreateReque
 	planData.ConnectorType = types.StringValue(createOutp.ConnectorType)
 	planData.ConnectorName = getConnectorName(&planData)
 	planData.Name = types.StringValue(createOutp.Name)
-	parts := strings.Split(planData.SocketAddress.ValueString(), ":")
-	if len(parts) == 2 {
-		planData.Host = types.StringValue(parts[0])
-		port, err := strconv.ParseInt(parts[1], 10, 64)
-		if err != nil {
-			res.Diagnostics.AddError("failed to parse port", err.Error())
-			return
-		}
-		planData.Port = types.Int64Value(port)
-	} else {
-		res.Diagnostics.AddError("invalid socket address format", "expected format is host:port")
+	planData.RegistrationCommand = types.StringNull()
+	if err := setHostAndPort(&planData); err != nil {
+		res.Diagnostics.AddError("invalid socket address format", err.Error())
 		return
 	}
 
 	planData.SoftwareVersion = types.StringValue(createOutp.SoftwareVersion)
 	planData.AsdmVersion = types.StringValue(createSpecificOutp.Metadata.AsdmVersion)
+	setHealth(&planData, createOutp, createSpecificOutp)
+	setFailover(&planData, createSpecificOutp)
 
 	res.Diagnostics.Append(res.State.Set(ctx, &planData)...)
 }
 
+// createWithRegistrationKey creates the ASA pending registration and saves the registration command to run on the device.
+// It does not wait for the device to register, as the command is only known to the user once this resource has been created.
+func (r *AsaDeviceResource) createWithRegistrationKey(ctx context.Context, planData *AsaDeviceResourceModel, specificSdcOutp *connector.ReadOutput, planTags publicapilabels.Type, res *resource.CreateResponse) {
+
+	createInp := asa.NewCreateWithRegistrationKeyInput(
+		planData.Name.ValueString(),
+		specificSdcOutp.Uid,
+		planData.ConnectorType.ValueString(),
+		planData.SocketAddress.ValueString(),
+		planData.IgnoreCertificate.ValueBool(),
+		planTags,
+	)
+
+	createOutp, createSpecificOutp, createErr := r.client.CreateAsaWithRegistrationKey(ctx, *createInp)
+	if createErr != nil {
+		tflog.Error(ctx, "Failed to create ASA device with registration key")
+		if createErr.CreatedResourceId != nil {
+			deleteInp := asa.NewDeleteInput(*createErr.CreatedResourceId)
+			_, err := r.client.DeleteAsa(ctx, *deleteInp)
+			if err != nil {
+				res.Diagnostics.AddError("failed to delete ASA device", err.Error())
+			}
+		}
+
+		res.Diagnostics.AddError("failed to create ASA device", createErr.Error())
+		return
+	}
+
+	planData.ID = types.StringValue(createOutp.Uid)
+	planData.ConnectorType = types.StringValue(createOutp.ConnectorType)
+	planData.ConnectorName = getConnectorName(planData)
+	planData.Name = types.StringValue(createOutp.Name)
+	planData.RegistrationCommand = types.StringValue(createSpecificOutp.Metadata.RegistrationCommand)
+	if err := setHostAndPort(planData); err != nil {
+		res.Diagnostics.AddError("invalid socket address format", err.Error())
+		return
+	}
+
+	// the versions are not known until the device has registered, keep the planned versions if there are any
+	if planData.SoftwareVersion.IsUnknown() {
+		planData.SoftwareVersion = types.StringValue(createOutp.SoftwareVersion)
+	}
+	if planData.AsdmVersion.IsUnknown() {
+		planData.AsdmVersion = types.StringValue(createSpecificOutp.Metadata.AsdmVersion)
+	}
+	setHealth(planData, createOutp, createSpecificOutp)
+	setFailover(planData, createSpecificOutp)
+
+	res.Diagnostics.Append(res.State.Set(ctx, planData)...)
+}
+
 func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateRequest, res *resource.UpdateResponse) {
 
 	tflog.Trace(ctx, "update ASA device resource")
@@ -381,6 +527,8 @@ func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateReque
 		updateInp.SoftwareVersion = planData.SoftwareVersion.ValueString()
 		tflog.Debug(ctx, fmt.Sprintf("Updating software version to %s", updateInp.SoftwareVersion))
 	}
+	updateInp.HaAwareUpgrade = planData.HaAwareUpgrade.ValueBool()
+	updateInp.PendingRegistration = isPendingRegistration(stateData)
 
 	if isNameUpdated(planData, stateData) {
 		updateInp.Name = planData.Name.ValueString()
@@ -402,6 +550,21 @@ func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateReque
 		updateInp.Password = planData.Password.ValueString()
 	}
 
+	if isConnectorUpdated(planData, stateData) {
+		updateInp.ConnectorType = planData.ConnectorType.ValueString()
+		if strings.EqualFold(planData.ConnectorType.ValueString(), "SDC") {
+			readSdcOutp, err := r.client.ReadConnectorByName(ctx, *connector.NewReadByNameInput(planData.ConnectorName.ValueString()))
+			if err != nil {
+				res.Diagnostics.AddError("failed to read the new connector of the ASA device", err.Error())
+				return
+			}
+			updateInp.ConnectorUid = readSdcOutp.Uid
+		}
+		// the credentials are encrypted with the public key of the connector, so they need to be sent again
+		updateInp.Username = planData.Username.ValueString()
+		updateInp.Password = planData.Password.ValueString()
+	}
+
 	_, err = r.client.UpdateAsa(ctx, *updateInp)
 	if err != nil {
 		res.Diagnostics.AddError("failed to update ASA device", err.Error())
@@ -426,10 +589,21 @@ func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateReque
 		res.Diagnostics.AddError("unable to read ASA Specific Device", err.Error())
 		return
 	}
-	port, err := parsePort(readOutp.Port)
-	if err != nil {
-		res.Diagnostics.AddError("unable to parse port", err.Error())
-		return
+	// a device onboarded with a registration key does not report its port until it has registered
+	if readOutp.Port != "" {
+		port, err := parsePort(readOutp.Port)
+		if err != nil {
+			res.Diagnostics.AddError("unable to parse port", err.Error())
+			return
+		}
+		stateData.Host = types.StringValue(readOutp.Host)
+		stateData.Port = types.Int64Value(port)
+	} else {
+		stateData.SocketAddress = planData.SocketAddress
+		if err := setHostAndPort(stateData); err != nil {
+			res.Diagnostics.AddError("invalid socket address format", err.Error())
+			return
+		}
 	}
 
 	tflog.Debug(ctx, fmt.Sprintf("On CDO --- Software version: %s, ASDM version: %s", readOutp.SoftwareVersion, asaSpecificDeviceReadOutp.Metadata.AsdmVersion))
@@ -441,8 +615,6 @@ func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateReque
 	stateData.ConnectorName = getConnectorName(planData)
 	stateData.Name = types.StringValue(readOutp.Name)
 	stateData.SocketAddress = planData.SocketAddress
-	stateData.Host = types.StringValue(readOutp.Host)
-	stateData.Port = types.Int64Value(port)
 	stateData.Labels = planData.Labels
 	stateData.GroupedLabels = planData.GroupedLabels
 	if planData.SoftwareVersion.ValueString() != "" && util.DoNormalisedVersionsMatch(planData.SoftwareVersion.ValueString(), readOutp.SoftwareVersion) {
@@ -467,6 +639,9 @@ func (r *AsaDeviceResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	stateData.IgnoreCertificate = planData.IgnoreCertificate
+	stateData.HaAwareUpgrade = planData.HaAwareUpgrade
+	setHealth(stateData, readOutp, asaSpecificDeviceReadOutp)
+	setFailover(stateData, asaSpecificDeviceReadOutp)
 
 	res.Diagnostics.Append(res.State.Set(ctx, &stateData)...)
 }
@@ -532,13 +707,34 @@ func (r *AsaDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 }
 
 func (r *AsaDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadDeviceByName(ctx, device.NewReadByNameAndTypeInput(uidOrName, devicetype.Asa))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import ASA device", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("onboarding_method"), onboardingMethodCredentials)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("username"), types.StringNull())...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&res.Diagnostics, "username", "password")
 }
 
 func isCredentialUpdated(planData, stateData *AsaDeviceResourceModel) bool {
 	return planData.Username.ValueString() != stateData.Username.ValueString() || planData.Password.ValueString() != stateData.Password.ValueString()
 }
 
+func isConnectorUpdated(planData, stateData *AsaDeviceResourceModel) bool {
+	if !strings.EqualFold(planData.ConnectorType.ValueString(), stateData.ConnectorType.ValueString()) {
+		return true
+	}
+	return strings.EqualFold(planData.ConnectorType.ValueString(), "SDC") && planData.ConnectorName.ValueString() != stateData.ConnectorName.ValueString()
+}
+
 func isNameUpdated(planData, stateData *AsaDeviceResourceModel) bool {
 	return !planData.Name.Equal(stateData.Name)
 }
@@ -555,6 +751,52 @@ func isLocationUpdated(planData, stateData *AsaDeviceResourceModel) bool {
 	return !planData.SocketAddress.Equal(stateData.SocketAddress)
 }
 
+func isOnboardedWithRegistrationKey(stateData *AsaDeviceResourceModel) bool {
+	return stateData.OnboardingMethod.ValueString() == onboardingMethodRegistrationKey
+}
+
+// isPendingRegistration is whether the device was onboarded with a registration key and has not come online since, i.e. the registration command has not been run on it yet.
+func isPendingRegistration(stateData *AsaDeviceResourceModel) bool {
+	return isOnboardedWithRegistrationKey(stateData) && stateData.ConnectivityState.ValueString() != connectivity.Online.String()
+}
+
+// setHostAndPort sets the host and port from the socket address, as the device does not report them until it has been onboarded.
+func setHostAndPort(resourceModel *AsaDeviceResourceModel) error {
+	parts := strings.Split(resourceModel.SocketAddress.ValueString(), ":")
+	if len(parts) != 2 {
+		return errors.New("expected format is host:port")
+	}
+	port, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse port, cause=%w", err)
+	}
+	resourceModel.Host = types.StringValue(parts[0])
+	resourceModel.Port = types.Int64Value(port)
+	return nil
+}
+
+// setHealth sets the computed attributes that show whether CDO can still reach and log in to the device.
+func setHealth(resourceModel *AsaDeviceResourceModel, readOutp *asa.ReadOutput, readSpecificOutp *asa.ReadSpecificOutput) {
+	resourceModel.ConnectivityState = types.StringValue(connectivity.State(readOutp.ConnectivityState).String())
+	resourceModel.CredentialsValid = types.BoolValue(!state.IsBadCredentials(readOutp.State) && !state.IsBadCredentials(readSpecificOutp.State))
+}
+
+// setFailover sets the computed attributes that describe the failover pair the device belongs to, if any.
+func setFailover(resourceModel *AsaDeviceResourceModel, readSpecificOutp *asa.ReadSpecificOutput) {
+	failover := readSpecificOutp.Metadata.Failover
+	if !failover.IsPair() {
+		resourceModel.HaMode = types.StringValue(string(asa.FailoverModeStandalone))
+		resourceModel.HaRole = types.StringNull()
+		resourceModel.HaPeerUnit = types.StringNull()
+		resourceModel.FailoverHealth = types.StringNull()
+		return
+	}
+	resourceModel.HaMode = types.StringValue(string(failover.Mode))
+	resourceModel.HaRole = types.StringValue(string(failover.Role))
+	resourceModel.HaPeerUnit = types.StringValue(failover.PeerUnit)
+	resourceModel.FailoverHealth = types.StringValue(string(failover.Health))
+}
+
 func parsePort(rawPort string) (int64, error) {
 	return strconv.ParseInt(rawPort, 10, 16)
 
@@ -55,6 +55,16 @@ resource "cdo_asa_device" "test" {
 	ignore_certificate = "{{.IgnoreCertificate}}"
 }`
 
+const asaResourceTemplateRegistrationKey = `
+resource "cdo_asa_device" "test" {
+	name = "{{.Name}}"
+	socket_address = "{{.SocketAddress}}"
+	connector_name = "{{.ConnectorName}}"
+	connector_type = "{{.ConnectorType}}"
+	onboarding_method = "registration_key"
+	ignore_certificate = "{{.IgnoreCertificate}}"
+}`
+
 // SDC configs.
 
 // default config.
@@ -77,6 +87,11 @@ var testAsaResourceConfig_SDC = acctest.MustParseTemplate(asaResourceTemplate, t
 
 var testAsaResourceConfig_SDC_NoLabels = acctest.MustParseTemplate(asaResourceTemplateNoLabels, testAsaResource_SDC)
 
+var testAsaResource_SDC_RegistrationKey = acctest.MustOverrideFields(testAsaResource_SDC, map[string]any{
+	"Name": acctest.Env.AsaResourceSdcName() + "-registration-key",
+})
+var testAsaResourceConfig_SDC_RegistrationKey = acctest.MustParseTemplate(asaResourceTemplateRegistrationKey, testAsaResource_SDC_RegistrationKey)
+
 // new label order config.
 var reorderedLabels = testutil.MustJson(sliceutil.Reverse(labels))
 
@@ -121,6 +136,12 @@ func TestAccAsaDeviceResource_SDC(t *testing.T) {
 					resource.TestCheckResourceAttr("cdo_asa_device.test", "connector_type", testAsaResource_SDC.ConnectorType),
 					resource.TestCheckResourceAttr("cdo_asa_device.test", "username", testAsaResource_SDC.Username),
 					resource.TestCheckResourceAttr("cdo_asa_device.test", "password", testAsaResource_SDC.Password),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "onboarding_method", "credentials"),
+					resource.TestCheckNoResourceAttr("cdo_asa_device.test", "registration_command"),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "connectivity_state", "ONLINE"),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "credentials_valid", "true"),
+					resource.TestCheckResourceAttrSet("cdo_asa_device.test", "ha_mode"),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "ha_aware_upgrade", "false"),
 					resource.TestCheckResourceAttr("cdo_asa_device.test", "labels.#", strconv.Itoa(len(labels))),
 					resource.TestCheckTypeSetElemAttr("cdo_asa_device.test", "labels.*", labels[0]),
 					resource.TestCheckTypeSetElemAttr("cdo_asa_device.test", "labels.*", labels[1]),
@@ -201,3 +222,24 @@ func TestAccAsaDeviceResource_SDC_NoLabels(t *testing.T) {
 		},
 	})
 }
+
+func TestAccAsaDeviceResource_SDC_RegistrationKey(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing, the device stays pending registration as the command is not run on it
+			{
+				Config: acctest.ProviderConfig() + testAsaResourceConfig_SDC_RegistrationKey,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "name", testAsaResource_SDC_RegistrationKey.Name),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "onboarding_method", "registration_key"),
+					resource.TestCheckResourceAttrSet("cdo_asa_device.test", "registration_command"),
+					resource.TestCheckNoResourceAttr("cdo_asa_device.test", "username"),
+					resource.TestCheckNoResourceAttr("cdo_asa_device.test", "password"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,114 @@
+package securitycontext
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	Id              types.String   `tfsdk:"id"`
+	SystemDeviceUid types.String   `tfsdk:"system_device_uid"`
+	Contexts        []ContextModel `tfsdk:"contexts"`
+}
+
+type ContextModel struct {
+	Name         types.String `tfsdk:"name"`
+	FirewallMode types.String `tfsdk:"firewall_mode"`
+	AdminContext types.Bool   `tfsdk:"admin_context"`
+	DeviceUid    types.String `tfsdk:"device_uid"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asa_contexts"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the security contexts of an ASA running in multiple context mode, that is onboarded to CDO through its system context.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the same as `system_device_uid`.",
+				Computed:            true,
+			},
+			"system_device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA device onboarded through its system context.",
+				Required:            true,
+			},
+			"contexts": schema.ListNestedAttribute{
+				MarkdownDescription: "The security contexts of the ASA, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the security context.",
+							Computed:            true,
+						},
+						"firewall_mode": schema.StringAttribute{
+							MarkdownDescription: "The firewall mode of the context (Possible values: [ROUTED, TRANSPARENT]).",
+							Computed:            true,
+						},
+						"admin_context": schema.BoolAttribute{
+							MarkdownDescription: "Whether the context is the admin context of the ASA.",
+							Computed:            true,
+						},
+						"device_uid": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the device the context is onboarded as, it is not set if the context has not been onboarded.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var configData DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ReadContexts(ctx, d, &configData); err != nil {
+		resp.Diagnostics.AddError("Failed to read ASA security contexts", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &configData)...)
+}
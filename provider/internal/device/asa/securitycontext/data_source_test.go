@@ -0,0 +1,45 @@
+package securitycontext_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDataSource = struct {
+	SystemDeviceName string
+}{
+	SystemDeviceName: acctest.Env.AsaContextSystemDeviceName(),
+}
+
+const testDataSourceTemplate = `
+data "cdo_asa_device" "system" {
+	name = "{{.SystemDeviceName}}"
+}
+
+data "cdo_asa_contexts" "test" {
+	system_device_uid = data.cdo_asa_device.system.id
+}`
+
+var testDataSourceConfig = acctest.MustParseTemplate(testDataSourceTemplate, testDataSource)
+
+func TestAccAsaContextsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cdo_asa_contexts.test", "id", "data.cdo_asa_device.system", "id"),
+					resource.TestCheckResourceAttrSet("data.cdo_asa_contexts.test", "contexts.#"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.cdo_asa_contexts.test", "contexts.*", map[string]string{
+						"admin_context": "true",
+					}),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package securitycontext
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asacontext"
+	modelAsaContext "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asacontext"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/connectivity"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	contextOutp, err := resource.client.ReadAsaContext(ctx, asacontext.NewReadInput(stateData.SystemDeviceUid.ValueString(), stateData.ContextName.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	// the id is not known yet when the context is imported by its name
+	if stateData.Id.ValueString() == "" {
+		if contextOutp.DeviceUid == "" {
+			return fmt.Errorf("ASA security context %s is not onboarded to CDO", stateData.ContextName.ValueString())
+		}
+		stateData.Id = types.StringValue(contextOutp.DeviceUid)
+	}
+
+	readOutp, err := resource.client.ReadAsa(ctx, *asa.NewReadInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setContext(stateData, contextOutp)
+	setDevice(stateData, readOutp)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	onboardOutp, err := resource.client.OnboardAsaContext(ctx, asacontext.NewOnboardInput(
+		planData.SystemDeviceUid.ValueString(),
+		planData.ContextName.ValueString(),
+		planData.Name.ValueString(),
+	))
+	if err != nil {
+		return err
+	}
+	planData.Id = types.StringValue(onboardOutp.Uid)
+
+	planTags, err := tagsFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	// the context is onboarded with the credentials of the system context, and without labels
+	if len(planTags.UngroupedTags()) > 0 || len(planTags.GroupedTags()) > 0 || !planData.Username.IsNull() {
+		updateInp := asa.NewUpdateInput(
+			onboardOutp.Uid,
+			planData.Name.ValueString(),
+			planData.Username.ValueString(),
+			planData.Password.ValueString(),
+			planTags,
+		)
+		if _, err := resource.client.UpdateAsa(ctx, *updateInp); err != nil {
+			return err
+		}
+	}
+
+	return Read(ctx, resource, planData)
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel, stateData *ResourceModel) error {
+
+	planTags, err := tagsFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateInp := asa.NewUpdateInput(
+		stateData.Id.ValueString(),
+		planData.Name.ValueString(),
+		"",
+		"",
+		planTags,
+	)
+	if !planData.Username.Equal(stateData.Username) || !planData.Password.Equal(stateData.Password) {
+		updateInp.Username = planData.Username.ValueString()
+		updateInp.Password = planData.Password.ValueString()
+	}
+	if _, err := resource.client.UpdateAsa(ctx, *updateInp); err != nil {
+		return err
+	}
+
+	planData.Id = stateData.Id
+
+	return Read(ctx, resource, planData)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsa(ctx, *asa.NewDeleteInput(stateData.Id.ValueString()))
+	return err
+}
+
+func ReadContexts(ctx context.Context, dataSource *DataSource, configData *DataSourceModel) error {
+
+	readOutp, err := dataSource.client.ReadAllAsaContexts(ctx, asacontext.NewReadAllInput(configData.SystemDeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	contexts := make([]modelAsaContext.Context, len(readOutp.Items))
+	copy(contexts, readOutp.Items)
+	sort.SliceStable(contexts, func(i, j int) bool {
+		return contexts[i].Name < contexts[j].Name
+	})
+
+	configData.Id = configData.SystemDeviceUid
+	configData.Contexts = make([]ContextModel, len(contexts))
+	for i, securityContext := range contexts {
+		deviceUid := types.StringNull()
+		if securityContext.DeviceUid != "" {
+			deviceUid = types.StringValue(securityContext.DeviceUid)
+		}
+		configData.Contexts[i] = ContextModel{
+			Name:         types.StringValue(securityContext.Name),
+			FirewallMode: types.StringValue(string(securityContext.FirewallMode)),
+			AdminContext: types.BoolValue(securityContext.IsAdminContext),
+			DeviceUid:    deviceUid,
+		}
+	}
+
+	return nil
+}
+
+func setContext(resourceModel *ResourceModel, contextOutp *asacontext.ReadOutput) {
+	resourceModel.FirewallMode = types.StringValue(string(contextOutp.FirewallMode))
+	resourceModel.AdminContext = types.BoolValue(contextOutp.IsAdminContext)
+}
+
+func setDevice(resourceModel *ResourceModel, readOutp *asa.ReadOutput) {
+	resourceModel.Name = types.StringValue(readOutp.Name)
+	resourceModel.Labels = util.GoStringSliceToTFStringSet(readOutp.Tags.UngroupedTags())
+	resourceModel.GroupedLabels = util.GoMapToStringSetTFMap(readOutp.Tags.GroupedTags())
+	resourceModel.SoftwareVersion = types.StringValue(readOutp.SoftwareVersion)
+	resourceModel.ConnectivityState = types.StringValue(connectivity.State(readOutp.ConnectivityState).String())
+}
+
+func tagsFromModel(ctx context.Context, resourceModel *ResourceModel) (tags.Type, error) {
+	ungroupedLabels, err := util.TFStringSetToGoStringList(ctx, resourceModel.Labels)
+	if err != nil {
+		return nil, fmt.Errorf("error while converting terraform labels to go slice, %s", resourceModel.Labels)
+	}
+
+	groupedLabels, err := util.TFMapToGoMapOfStringSlices(ctx, resourceModel.GroupedLabels)
+	if err != nil {
+		return nil, fmt.Errorf("error while converting terraform grouped labels to go map, %v", resourceModel.GroupedLabels)
+	}
+
+	return tags.New(ungroupedLabels, groupedLabels), nil
+}
@@ -0,0 +1,85 @@
+package securitycontext_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+var testResource = struct {
+	SystemDeviceName string
+	ContextName      string
+	Name             string
+	Label            string
+}{
+	SystemDeviceName: acctest.Env.AsaContextSystemDeviceName(),
+	ContextName:      acctest.Env.AsaContextResourceContextName(),
+	Name:             "terraform-acceptance-test-asa-context",
+	Label:            "acceptancetest",
+}
+
+const testResourceTemplate = `
+data "cdo_asa_device" "system" {
+	name = "{{.SystemDeviceName}}"
+}
+
+resource "cdo_asa_context" "test" {
+	system_device_uid = data.cdo_asa_device.system.id
+	context_name      = "{{.ContextName}}"
+	name              = "{{.Name}}"
+	labels            = ["{{.Label}}"]
+}`
+
+var testResourceConfig = acctest.MustParseTemplate(testResourceTemplate, testResource)
+
+var testResource_NewName = acctest.MustOverrideFields(testResource, map[string]any{
+	"Name":  "terraform-acceptance-test-asa-context-renamed",
+	"Label": "acceptancetest-renamed",
+})
+var testResourceConfig_NewName = acctest.MustParseTemplate(testResourceTemplate, testResource_NewName)
+
+func TestAccAsaContextResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_context.test", "id"),
+					resource.TestCheckResourceAttrPair("cdo_asa_context.test", "system_device_uid", "data.cdo_asa_device.system", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "context_name", testResource.ContextName),
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "name", testResource.Name),
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "labels.#", "1"),
+					resource.TestCheckTypeSetElemAttr("cdo_asa_context.test", "labels.*", testResource.Label),
+					resource.TestCheckResourceAttrSet("cdo_asa_context.test", "firewall_mode"),
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "admin_context", "false"),
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "connectivity_state", "ONLINE"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName: "cdo_asa_context.test",
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					attributes := state.RootModule().Resources["cdo_asa_context.test"].Primary.Attributes
+					return fmt.Sprintf("%s/%s", attributes["system_device_uid"], attributes["context_name"]), nil
+				},
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testResourceConfig_NewName,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_asa_context.test", "name", testResource_NewName.Name),
+					resource.TestCheckTypeSetElemAttr("cdo_asa_context.test", "labels.*", testResource_NewName.Label),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
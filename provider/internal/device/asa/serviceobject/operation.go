@@ -0,0 +1,111 @@
+package serviceobject
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa/asaobjects"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/asa/asaobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var protocols = []string{"tcp", "udp", "tcp-udp", "icmp", "icmp6", "ip"}
+
+// only these protocols have ports
+var protocolsWithPorts = map[string]bool{"tcp": true, "udp": true, "tcp-udp": true}
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadAsaObject(ctx, asaobjects.NewReadInput(stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	return setObject(stateData, readOutp)
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateAsaObject(ctx, asaobjects.NewCreateInput(object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, createOutp)
+}
+
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	object, err := objectFromModel(ctx, planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateAsaObject(ctx, asaobjects.NewUpdateInput(planData.Id.ValueString(), object))
+	if err != nil {
+		return err
+	}
+
+	return setObject(planData, updateOutp)
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	_, err := resource.client.DeleteAsaObject(ctx, asaobjects.NewDeleteInput(stateData.Id.ValueString()))
+	return err
+}
+
+func objectFromModel(ctx context.Context, model *ResourceModel) (asaobject.Object, error) {
+	hasPorts := model.SourcePort.ValueString() != "" || model.DestinationPort.ValueString() != ""
+	if hasPorts && !protocolsWithPorts[model.Protocol.ValueString()] {
+		return asaobject.Object{}, fmt.Errorf("source_port and destination_port cannot be set for protocol %s", model.Protocol.ValueString())
+	}
+
+	targets, err := asa.ObjectTargetsFromModel(ctx, model.DeviceUids)
+	if err != nil {
+		return asaobject.Object{}, err
+	}
+
+	return asaobject.Object{
+		Name:        model.Name.ValueString(),
+		Description: model.Description.ValueString(),
+		ObjectType:  asaobject.ServiceObject,
+		Contents: []asaobject.Content{
+			asaobject.NewServiceContent(model.Protocol.ValueString(), model.SourcePort.ValueString(), model.DestinationPort.ValueString()),
+		},
+		Targets: targets,
+	}, nil
+}
+
+func setObject(model *ResourceModel, object *asaobject.Object) error {
+	if object.ObjectType != asaobject.ServiceObject || len(object.Contents) != 1 {
+		return fmt.Errorf("object %s is a %s, not a service object", object.Uid, object.ObjectType)
+	}
+
+	model.Id = types.StringValue(object.Uid)
+	model.Name = types.StringValue(object.Name)
+	if object.Description != "" {
+		model.Description = types.StringValue(object.Description)
+	} else {
+		model.Description = types.StringNull()
+	}
+	model.DeviceUids = asa.ObjectTargetsToModel(object.Targets)
+	model.Protocol = types.StringValue(object.Contents[0].Protocol)
+	model.SourcePort = stringOrNull(object.Contents[0].SourcePort)
+	model.DestinationPort = stringOrNull(object.Contents[0].DestinationPort)
+
+	return nil
+}
+
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,75 @@
+package serviceobject_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testServiceObjectResource = struct {
+	AsaName         string
+	Name            string
+	Protocol        string
+	DestinationPort string
+}{
+	AsaName:         acctest.Env.AsaDataSourceName(),
+	Name:            "terraform-provider-cdo-acc-test-asa-service-object",
+	Protocol:        "tcp",
+	DestinationPort: "443",
+}
+
+const testServiceObjectResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_asa_service_object" "test" {
+	name             = "{{.Name}}"
+	device_uids      = [data.cdo_asa_device.test.id]
+	protocol         = "{{.Protocol}}"
+	destination_port = "{{.DestinationPort}}"
+}`
+
+var testServiceObjectResourceConfig = acctest.MustParseTemplate(testServiceObjectResourceTemplate, testServiceObjectResource)
+
+var testServiceObjectResource_NewPorts = acctest.MustOverrideFields(testServiceObjectResource, map[string]any{
+	"Protocol":        "udp",
+	"DestinationPort": "1024-65535",
+})
+var testServiceObjectResourceConfig_NewPorts = acctest.MustParseTemplate(testServiceObjectResourceTemplate, testServiceObjectResource_NewPorts)
+
+func TestAccAsaServiceObjectResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testServiceObjectResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_asa_service_object.test", "id"),
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "name", testServiceObjectResource.Name),
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "device_uids.#", "1"),
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "protocol", testServiceObjectResource.Protocol),
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "destination_port", testServiceObjectResource.DestinationPort),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_asa_service_object.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testServiceObjectResourceConfig_NewPorts,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "protocol", testServiceObjectResource_NewPorts.Protocol),
+					resource.TestCheckResourceAttr("cdo_asa_service_object.test", "destination_port", testServiceObjectResource_NewPorts.DestinationPort),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,120 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	Id              types.String  `tfsdk:"id"`
+	DeviceUid       types.String  `tfsdk:"device_uid"`
+	DownloadVersion types.Int64   `tfsdk:"download_version"`
+	Backups         []BackupModel `tfsdk:"backups"`
+	Config          types.String  `tfsdk:"config"`
+}
+
+type BackupModel struct {
+	Id          types.String `tfsdk:"id"`
+	Version     types.Int64  `tfsdk:"version"`
+	CreatedDate types.String `tfsdk:"created_date"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_backups"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to list the backups of the configuration of a device onboarded to CDO, and to download the configuration in one of them.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the same as `device_uid`.",
+				Computed:            true,
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the device to list the backups of.",
+				Required:            true,
+			},
+			"download_version": schema.Int64Attribute{
+				MarkdownDescription: "The version of the backup to download the configuration of into `config`.",
+				Optional:            true,
+			},
+			"backups": schema.ListNestedAttribute{
+				MarkdownDescription: "The backups of the device that CDO retains, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The unique identifier of the backup.",
+							Computed:            true,
+						},
+						"version": schema.Int64Attribute{
+							MarkdownDescription: "The version of the backup, the versions of the backups of a device start at 1, and increase with every backup.",
+							Computed:            true,
+						},
+						"created_date": schema.StringAttribute{
+							MarkdownDescription: "The time the backup was made, in RFC 3339 format.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The configuration in the backup with version `download_version`, if it is set.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var configData DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ReadBackups(ctx, d, &configData); err != nil {
+		resp.Diagnostics.AddError("Failed to read device backups", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &configData)...)
+}
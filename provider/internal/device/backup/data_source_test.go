@@ -0,0 +1,43 @@
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testBackupsDataSource = struct {
+	AsaName string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+}
+
+const testBackupsDataSourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+data "cdo_device_backups" "test" {
+	device_uid = data.cdo_asa_device.test.id
+}`
+
+var testBackupsDataSourceConfig = acctest.MustParseTemplate(testBackupsDataSourceTemplate, testBackupsDataSource)
+
+func TestAccDeviceBackupsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testBackupsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cdo_device_backups.test", "id", "data.cdo_asa_device.test", "id"),
+					resource.TestCheckResourceAttrSet("data.cdo_device_backups.test", "backups.#"),
+					resource.TestCheckNoResourceAttr("data.cdo_device_backups.test", "config"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,143 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	clientBackup "github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/backup"
+	modelBackup "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/backup"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ReadSchedule(ctx context.Context, resource *ScheduleResource, stateData *ScheduleResourceModel) error {
+
+	readOutp, err := resource.client.ReadDeviceBackupSchedule(ctx, clientBackup.NewReadScheduleInput(stateData.DeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setSchedule(stateData, readOutp)
+
+	return nil
+}
+
+func CreateSchedule(ctx context.Context, resource *ScheduleResource, planData *ScheduleResourceModel) error {
+
+	schedule, err := scheduleFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	createOutp, err := resource.client.CreateDeviceBackupSchedule(ctx, clientBackup.NewCreateScheduleInput(schedule))
+	if err != nil {
+		return err
+	}
+
+	setSchedule(planData, createOutp)
+
+	return nil
+}
+
+func UpdateSchedule(ctx context.Context, resource *ScheduleResource, planData *ScheduleResourceModel) error {
+
+	schedule, err := scheduleFromModel(planData)
+	if err != nil {
+		return err
+	}
+
+	updateOutp, err := resource.client.UpdateDeviceBackupSchedule(ctx, clientBackup.NewUpdateScheduleInput(schedule))
+	if err != nil {
+		return err
+	}
+
+	setSchedule(planData, updateOutp)
+
+	return nil
+}
+
+func DeleteSchedule(ctx context.Context, resource *ScheduleResource, stateData *ScheduleResourceModel) error {
+
+	_, err := resource.client.DeleteDeviceBackupSchedule(ctx, clientBackup.NewDeleteScheduleInput(stateData.DeviceUid.ValueString()))
+	return err
+}
+
+func ReadBackups(ctx context.Context, dataSource *DataSource, configData *DataSourceModel) error {
+
+	readOutp, err := dataSource.client.ReadAllDeviceBackups(ctx, clientBackup.NewReadAllInput(configData.DeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	// newest first
+	backups := make([]modelBackup.Backup, len(readOutp.Items))
+	copy(backups, readOutp.Items)
+	sort.SliceStable(backups, func(i, j int) bool {
+		return backups[i].Version > backups[j].Version
+	})
+
+	configData.Id = configData.DeviceUid
+	configData.Backups = make([]BackupModel, len(backups))
+	for i, backup := range backups {
+		configData.Backups[i] = BackupModel{
+			Id:          types.StringValue(backup.Uid),
+			Version:     types.Int64Value(backup.Version),
+			CreatedDate: types.StringValue(time.UnixMilli(backup.CreatedDate).UTC().Format(time.RFC3339)),
+		}
+	}
+
+	configData.Config = types.StringNull()
+	if configData.DownloadVersion.IsNull() {
+		return nil
+	}
+	for _, backup := range backups {
+		if backup.Version == configData.DownloadVersion.ValueInt64() {
+			downloadOutp, err := dataSource.client.DownloadDeviceBackup(ctx, clientBackup.NewDownloadInput(backup.DeviceUid, backup.Uid))
+			if err != nil {
+				return err
+			}
+			configData.Config = types.StringValue(downloadOutp.Config)
+			return nil
+		}
+	}
+	return fmt.Errorf("the device has no backup with version %d", configData.DownloadVersion.ValueInt64())
+}
+
+func scheduleFromModel(model *ScheduleResourceModel) (modelBackup.Schedule, error) {
+	frequency := modelBackup.Frequency(strings.ToUpper(model.Frequency.ValueString()))
+	hasDayOfWeek := !model.DayOfWeek.IsNull()
+	if hasDayOfWeek != (frequency == modelBackup.Weekly) {
+		return modelBackup.Schedule{}, fmt.Errorf("day_of_week must be set for weekly backups, and only for weekly backups")
+	}
+	hasDayOfMonth := !model.DayOfMonth.IsNull()
+	if hasDayOfMonth != (frequency == modelBackup.Monthly) {
+		return modelBackup.Schedule{}, fmt.Errorf("day_of_month must be set for monthly backups, and only for monthly backups")
+	}
+
+	return modelBackup.Schedule{
+		DeviceUid:      model.DeviceUid.ValueString(),
+		Frequency:      frequency,
+		Time:           model.Time.ValueString(),
+		DayOfWeek:      strings.ToUpper(model.DayOfWeek.ValueString()),
+		DayOfMonth:     int(model.DayOfMonth.ValueInt64()),
+		RetentionCount: int(model.RetentionCount.ValueInt64()),
+	}, nil
+}
+
+func setSchedule(model *ScheduleResourceModel, schedule *modelBackup.Schedule) {
+	model.Id = types.StringValue(schedule.DeviceUid)
+	model.DeviceUid = types.StringValue(schedule.DeviceUid)
+	model.Frequency = types.StringValue(strings.ToLower(string(schedule.Frequency)))
+	model.Time = types.StringValue(schedule.Time)
+	model.DayOfWeek = types.StringNull()
+	if schedule.DayOfWeek != "" {
+		model.DayOfWeek = types.StringValue(strings.ToLower(schedule.DayOfWeek))
+	}
+	model.DayOfMonth = types.Int64Null()
+	if schedule.DayOfMonth != 0 {
+		model.DayOfMonth = types.Int64Value(int64(schedule.DayOfMonth))
+	}
+	model.RetentionCount = types.Int64Value(int64(schedule.RetentionCount))
+}
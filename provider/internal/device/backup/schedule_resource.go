@@ -0,0 +1,201 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &ScheduleResource{}
+var _ resource.ResourceWithImportState = &ScheduleResource{}
+
+func NewScheduleResource() resource.Resource {
+	return &ScheduleResource{}
+}
+
+type ScheduleResource struct {
+	client *cdoClient.Client
+}
+
+type ScheduleResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	DeviceUid      types.String `tfsdk:"device_uid"`
+	Frequency      types.String `tfsdk:"frequency"`
+	Time           types.String `tfsdk:"time"`
+	DayOfWeek      types.String `tfsdk:"day_of_week"`
+	DayOfMonth     types.Int64  `tfsdk:"day_of_month"`
+	RetentionCount types.Int64  `tfsdk:"retention_count"`
+}
+
+func (r *ScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_backup_schedule"
+}
+
+func (r *ScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Provides a schedule of versioned backups of the configuration of a device onboarded to CDO. A device has at most one backup schedule. " +
+			"Use the `cdo_device_backups` data source to list the backups. Destroying this resource stops the scheduled backups, but retains the backups that were already made.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the backup schedule. This is the same as `device_uid`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the device to back up.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"frequency": schema.StringAttribute{
+				MarkdownDescription: "How often the device is backed up. Allowed values are: [\"daily\", \"weekly\", \"monthly\"].",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("daily", "weekly", "monthly"),
+				},
+			},
+			"time": schema.StringAttribute{
+				MarkdownDescription: "The time of day the device is backed up at, in UTC in the format `HH:MM`, e.g. `02:30`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^([01]\d|2[0-3]):[0-5]\d$`), "must be a time of day in the format HH:MM"),
+				},
+			},
+			"day_of_week": schema.StringAttribute{
+				MarkdownDescription: "The day of the week the device is backed up on. It must be set for weekly backups, and must not be set otherwise. Allowed values are: [\"monday\", \"tuesday\", \"wednesday\", \"thursday\", \"friday\", \"saturday\", \"sunday\"].",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("monday", "tuesday", "wednesday", "thursday", "friday", "saturday", "sunday"),
+				},
+			},
+			"day_of_month": schema.Int64Attribute{
+				MarkdownDescription: "The day of the month the device is backed up on, between 1 and 28. It must be set for monthly backups, and must not be set otherwise.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 28),
+				},
+			},
+			"retention_count": schema.Int64Attribute{
+				MarkdownDescription: "The number of backups of the device that are retained, the oldest backups are deleted beyond it. Defaults to 10.",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Trace(ctx, "create device backup schedule resource")
+
+	var planData ScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := CreateSchedule(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to create device backup schedule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *ScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	tflog.Trace(ctx, "read device backup schedule resource")
+
+	var stateData ScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ReadSchedule(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("failed to read device backup schedule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
+}
+
+func (r *ScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update device backup schedule resource")
+
+	var planData ScheduleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := UpdateSchedule(ctx, r, &planData); err != nil {
+		resp.Diagnostics.AddError("failed to update device backup schedule", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
+
+func (r *ScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete device backup schedule resource")
+
+	var stateData ScheduleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := DeleteSchedule(ctx, r, &stateData); err != nil {
+		resp.Diagnostics.AddError("failed to delete device backup schedule", err.Error())
+		return
+	}
+}
+
+func (r *ScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("device_uid"), req.ID)...)
+}
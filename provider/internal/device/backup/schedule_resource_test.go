@@ -0,0 +1,75 @@
+package backup_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testScheduleResource = struct {
+	AsaName   string
+	Frequency string
+	Day       string
+}{
+	AsaName:   acctest.Env.AsaDataSourceName(),
+	Frequency: "weekly",
+	Day:       `day_of_week = "sunday"`,
+}
+
+const testScheduleResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_device_backup_schedule" "test" {
+	device_uid = data.cdo_asa_device.test.id
+	frequency  = "{{.Frequency}}"
+	time       = "02:30"
+	{{.Day}}
+}`
+
+var testScheduleResourceConfig = acctest.MustParseTemplate(testScheduleResourceTemplate, testScheduleResource)
+
+var testScheduleResource_Monthly = acctest.MustOverrideFields(testScheduleResource, map[string]any{
+	"Frequency": "monthly",
+	"Day":       "day_of_month = 1",
+})
+var testScheduleResourceConfig_Monthly = acctest.MustParseTemplate(testScheduleResourceTemplate, testScheduleResource_Monthly)
+
+func TestAccDeviceBackupScheduleResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testScheduleResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_device_backup_schedule.test", "id", "data.cdo_asa_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "frequency", "weekly"),
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "time", "02:30"),
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "day_of_week", "sunday"),
+					resource.TestCheckNoResourceAttr("cdo_device_backup_schedule.test", "day_of_month"),
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "retention_count", "10"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "cdo_device_backup_schedule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testScheduleResourceConfig_Monthly,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "frequency", "monthly"),
+					resource.TestCheckNoResourceAttr("cdo_device_backup_schedule.test", "day_of_week"),
+					resource.TestCheckResourceAttr("cdo_device_backup_schedule.test", "day_of_month", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &CommandResource{}
+
+func NewCommandResource() resource.Resource {
+	return &CommandResource{}
+}
+
+type CommandResource struct {
+	client *cdoClient.Client
+}
+
+type CommandResourceModel struct {
+	Id        types.String   `tfsdk:"id"`
+	DeviceUid types.String   `tfsdk:"device_uid"`
+	Commands  []types.String `tfsdk:"commands"`
+	Triggers  types.Map      `tfsdk:"triggers"`
+	Output    types.String   `tfsdk:"output"`
+}
+
+func (r *CommandResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_device_cli_command"
+}
+
+func (r *CommandResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to execute CLI commands on an ASA or IOS device onboarded to CDO, for example to enable SSH on an IOS device after onboarding it. " +
+			"The commands are executed once when the resource is created, and again only when `device_uid`, `commands` or a value in `triggers` changes. " +
+			"Destroying this resource does not change the device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the result of the commands on CDO.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA or IOS device to execute the commands on.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"commands": schema.ListAttribute{
+				MarkdownDescription: "The CLI commands to execute, in order.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary values that cause the commands to be executed again when any of them changes.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "The output of the commands.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CommandResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CommandResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create device cli command resource")
+
+	var planData CommandResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ExecuteCommand(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to execute CLI commands on device", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *CommandResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read device cli command resource")
+
+	var stateData CommandResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ReadCommand(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read device cli command", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *CommandResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	// every attribute that can be configured requires replacement, so there is nothing to update on the device
+	tflog.Trace(ctx, "update device cli command resource")
+
+	var planData CommandResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *CommandResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing a device cli command resource is a noop. It will not revert the changes the commands made to the device.")
+}
@@ -0,0 +1,64 @@
+package cli_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testCommandResource = struct {
+	IosName string
+	Trigger string
+}{
+	IosName: acctest.Env.IosDataSourceName(),
+	Trigger: "1",
+}
+
+const testCommandResourceTemplate = `
+data "cdo_ios_device" "test" {
+	name = "{{.IosName}}"
+}
+
+resource "cdo_device_cli_command" "test" {
+	device_uid = data.cdo_ios_device.test.id
+	commands   = ["configure terminal", "ip ssh version 2", "end"]
+	triggers = {
+		run = "{{.Trigger}}"
+	}
+}`
+
+var testCommandResourceConfig = acctest.MustParseTemplate(testCommandResourceTemplate, testCommandResource)
+
+var testCommandResource_NewTrigger = acctest.MustOverrideFields(testCommandResource, map[string]any{
+	"Trigger": "2",
+})
+var testCommandResourceConfig_NewTrigger = acctest.MustParseTemplate(testCommandResourceTemplate, testCommandResource_NewTrigger)
+
+func TestAccDeviceCliCommandResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testCommandResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_device_cli_command.test", "id"),
+					resource.TestCheckResourceAttrPair("cdo_device_cli_command.test", "device_uid", "data.cdo_ios_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_device_cli_command.test", "commands.#", "3"),
+					resource.TestCheckResourceAttrSet("cdo_device_cli_command.test", "output"),
+				),
+			},
+			// Re-execute testing
+			{
+				Config: acctest.ProviderConfig() + testCommandResourceConfig_NewTrigger,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_cli_command.test", "triggers.run", testCommandResource_NewTrigger.Trigger),
+					resource.TestCheckResourceAttrSet("cdo_device_cli_command.test", "output"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/asa"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func ReadRunningConfig(ctx context.Context, dataSource *RunningConfigDataSource, configData *RunningConfigDataSourceModel) error {
+
+	deviceType, err := readDeviceType(ctx, dataSource.client, configData.DeviceUid.ValueString())
+	if err != nil {
+		return err
+	}
+
+	var config string
+	switch deviceType {
+	case devicetype.Asa:
+		readOutp, err := dataSource.client.ReadAsaRunningConfig(ctx, asa.NewReadRunningConfigInput(configData.DeviceUid.ValueString()))
+		if err != nil {
+			return err
+		}
+		config = readOutp.Config
+	case devicetype.Ios:
+		readOutp, err := dataSource.client.ReadIosRunningConfig(ctx, ios.NewReadRunningConfigInput(configData.DeviceUid.ValueString()))
+		if err != nil {
+			return err
+		}
+		config = readOutp.Config
+	default:
+		return fmt.Errorf("reading the running config is not supported for devices of type %s", deviceType)
+	}
+
+	configData.Id = configData.DeviceUid
+	configData.Config = types.StringValue(config)
+
+	return nil
+}
+
+func ReadCommand(ctx context.Context, resource *CommandResource, stateData *CommandResourceModel) error {
+
+	// the commands are only executed once, reading only checks that the device still exists
+	_, err := readDeviceType(ctx, resource.client, stateData.DeviceUid.ValueString())
+	return err
+}
+
+func ExecuteCommand(ctx context.Context, resource *CommandResource, planData *CommandResourceModel) error {
+
+	deviceType, err := readDeviceType(ctx, resource.client, planData.DeviceUid.ValueString())
+	if err != nil {
+		return err
+	}
+
+	commands := util.TFStringListToGoStringList(planData.Commands)
+
+	var result *asa.ExecuteCliOutput
+	switch deviceType {
+	case devicetype.Asa:
+		result, err = resource.client.ExecuteAsaCli(ctx, asa.NewExecuteCliInput(planData.DeviceUid.ValueString(), commands))
+	case devicetype.Ios:
+		result, err = resource.client.ExecuteIosCli(ctx, ios.NewExecuteCliInput(planData.DeviceUid.ValueString(), commands))
+	default:
+		return fmt.Errorf("executing CLI commands is not supported for devices of type %s", deviceType)
+	}
+	if err != nil {
+		return err
+	}
+
+	planData.Id = types.StringValue(result.Uid)
+	planData.Output = types.StringValue(result.Output)
+
+	return nil
+}
+
+func readDeviceType(ctx context.Context, client *cdoClient.Client, deviceUid string) (devicetype.Type, error) {
+	readOutp, err := client.ReadDeviceChanges(ctx, changes.NewReadInput(deviceUid))
+	if err != nil {
+		return "", err
+	}
+	return readOutp.DeviceType, nil
+}
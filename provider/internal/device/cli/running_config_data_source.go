@@ -0,0 +1,88 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type RunningConfigDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	DeviceUid types.String `tfsdk:"device_uid"`
+	Config    types.String `tfsdk:"config"`
+}
+
+func NewRunningConfigDataSource() datasource.DataSource {
+	return &RunningConfigDataSource{}
+}
+
+type RunningConfigDataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *RunningConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_device_running_config"
+}
+
+func (d *RunningConfigDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to read the running config of an ASA or IOS device onboarded to CDO. The config is read from the device through its connector every time the data source is read.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the same as `device_uid`.",
+				Computed:            true,
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA or IOS device to read the running config of.",
+				Required:            true,
+			},
+			"config": schema.StringAttribute{
+				MarkdownDescription: "The running config of the device, as shown by `show running-config`. It is sensitive as it may contain secrets such as keys and password hashes.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *RunningConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RunningConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var configData RunningConfigDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := ReadRunningConfig(ctx, d, &configData); err != nil {
+		resp.Diagnostics.AddError("Failed to read running config of device", err.Error())
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &configData)...)
+}
@@ -0,0 +1,43 @@
+package cli_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testRunningConfigDataSource = struct {
+	AsaName string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+}
+
+const testRunningConfigDataSourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+data "cdo_device_running_config" "test" {
+	device_uid = data.cdo_asa_device.test.id
+}`
+
+var testRunningConfigDataSourceConfig = acctest.MustParseTemplate(testRunningConfigDataSourceTemplate, testRunningConfigDataSource)
+
+func TestAccDeviceRunningConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testRunningConfigDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cdo_device_running_config.test", "id", "data.cdo_asa_device.test", "id"),
+					resource.TestMatchResourceAttr("data.cdo_device_running_config.test", "config", regexp.MustCompile(`(?m)^hostname `)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,92 @@
+package conflict
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	conflictedDevices, err := readConflictedDevices(ctx, resource, stateData)
+	if err != nil {
+		return err
+	}
+
+	setConflicts(stateData, conflictedDevices)
+
+	return nil
+}
+
+func Resolve(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	conflictedDevices, err := readConflictedDevices(ctx, resource, planData)
+	if err != nil {
+		return err
+	}
+
+	for _, conflictedDevice := range conflictedDevices {
+		switch planData.Action.ValueString() {
+		case "accept":
+			tflog.Debug(ctx, fmt.Sprintf("accepting out-of-band changes to device %s", conflictedDevice.Name))
+			_, err = resource.client.AcceptDeviceConflict(ctx, changes.NewAcceptConflictInput(conflictedDevice.Uid))
+		case "reject":
+			tflog.Debug(ctx, fmt.Sprintf("rejecting out-of-band changes to device %s", conflictedDevice.Name))
+			_, err = resource.client.RejectDeviceConflict(ctx, changes.NewRejectConflictInput(conflictedDevice.Uid))
+		default:
+			return fmt.Errorf("unsupported conflict resolution action: %s", planData.Action.ValueString())
+		}
+		if err != nil {
+			return fmt.Errorf("failed to %s the out-of-band changes to device %s: %w", planData.Action.ValueString(), conflictedDevice.Name, err)
+		}
+	}
+
+	// devices of the label may have new conflicts by now, they are resolved on the next apply
+	remainingConflictedDevices, err := readConflictedDevices(ctx, resource, planData)
+	if err != nil {
+		return err
+	}
+
+	planData.Id = types.StringValue(idOf(planData))
+	setConflicts(planData, remainingConflictedDevices)
+
+	return nil
+}
+
+func readConflictedDevices(ctx context.Context, resource *Resource, resourceModel *ResourceModel) ([]changes.ReadOutput, error) {
+	if !resourceModel.DeviceUid.IsNull() {
+		readOutp, err := resource.client.ReadDeviceChanges(ctx, changes.NewReadInput(resourceModel.DeviceUid.ValueString()))
+		if err != nil {
+			return nil, err
+		}
+		if !readOutp.HasConflict() {
+			return []changes.ReadOutput{}, nil
+		}
+		return []changes.ReadOutput{*readOutp}, nil
+	}
+
+	readAllOutp, err := resource.client.ReadAllDeviceConflicts(ctx, changes.NewReadAllConflictsInput(resourceModel.Label.ValueString()))
+	if err != nil {
+		return nil, err
+	}
+	return readAllOutp.Items, nil
+}
+
+func idOf(resourceModel *ResourceModel) string {
+	if !resourceModel.DeviceUid.IsNull() {
+		return resourceModel.DeviceUid.ValueString()
+	}
+	return resourceModel.Label.ValueString()
+}
+
+func setConflicts(resourceModel *ResourceModel, conflictedDevices []changes.ReadOutput) {
+	deviceUids := make([]string, len(conflictedDevices))
+	for i, conflictedDevice := range conflictedDevices {
+		deviceUids[i] = conflictedDevice.Uid
+	}
+	resourceModel.ConflictedDeviceUids = util.GoStringSliceToTFStringSet(deviceUids)
+}
@@ -0,0 +1,190 @@
+package conflict
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	DeviceUid            types.String `tfsdk:"device_uid"`
+	Label                types.String `tfsdk:"label"`
+	Action               types.String `tfsdk:"action"`
+	Triggers             types.Map    `tfsdk:"triggers"`
+	ConflictedDeviceUids types.Set    `tfsdk:"conflicted_device_uids"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_device_conflict_resolution"
+}
+
+func (r *Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to resolve conflicts between the configuration of devices on CDO and changes made to the devices outside CDO, without relying on the tenant-wide auto-accept setting. " +
+			"The conflicts of a single device, or of all devices with a label, are accepted or rejected when the resource is created, when a value in `triggers` changes, and when conflicts are found on refresh. " +
+			"Destroying this resource does not change the devices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the conflict resolution resource. This is the same as `device_uid` or `label`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the device to resolve conflicts of. Exactly one of `device_uid` and `label` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("device_uid"), path.MatchRoot("label")),
+				},
+			},
+			"label": schema.StringAttribute{
+				MarkdownDescription: "The label of the devices to resolve conflicts of. Exactly one of `device_uid` and `label` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "How conflicts are resolved. `accept` replaces the configuration on CDO with the configuration on the device, `reject` deploys the configuration on CDO to the device, overwriting the out-of-band changes. Allowed values are: [\"accept\", \"reject\"].",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("accept", "reject"),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary values that cause the conflicts to be resolved again when any of them changes.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"conflicted_device_uids": schema.SetAttribute{
+				MarkdownDescription: "The unique identifiers of the devices in scope whose configuration conflicts with changes made outside CDO. This is empty after the conflicts have been resolved.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create device conflict resolution resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Resolve(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to resolve device conflicts", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read device conflict resolution resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read device conflicts", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update device conflict resolution resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Resolve(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to resolve device conflicts", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing a device conflict resolution resource is a noop. It will not revert the resolved conflicts.")
+}
+
+// ModifyPlan plans a conflict resolution when conflicts were found on refresh, so that they show up in the plan.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(stateData.ConflictedDeviceUids.Elements()) > 0 {
+		tflog.Debug(ctx, "Devices in scope have conflicts; plan a conflict resolution")
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("conflicted_device_uids"), types.SetUnknown(types.StringType))...)
+	}
+}
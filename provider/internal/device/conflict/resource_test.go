@@ -0,0 +1,100 @@
+package conflict_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testConflictResolutionResource = struct {
+	AsaName string
+	Action  string
+	Trigger string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+	Action:  "accept",
+	Trigger: "1",
+}
+
+const testConflictResolutionResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_device_conflict_resolution" "test" {
+	device_uid = data.cdo_asa_device.test.id
+	action     = "{{.Action}}"
+	triggers = {
+		revision = "{{.Trigger}}"
+	}
+}`
+
+var testConflictResolutionResourceConfig = acctest.MustParseTemplate(testConflictResolutionResourceTemplate, testConflictResolutionResource)
+
+var testConflictResolutionResource_Reject = acctest.MustOverrideFields(testConflictResolutionResource, map[string]any{
+	"Action":  "reject",
+	"Trigger": "2",
+})
+var testConflictResolutionResourceConfig_Reject = acctest.MustParseTemplate(testConflictResolutionResourceTemplate, testConflictResolutionResource_Reject)
+
+var testLabelConflictResolutionResource = struct {
+	Label string
+}{
+	Label: acctest.Env.AsaDataSourceTags()[0],
+}
+
+const testLabelConflictResolutionResourceTemplate = `
+resource "cdo_device_conflict_resolution" "test" {
+	label  = "{{.Label}}"
+	action = "accept"
+}`
+
+var testLabelConflictResolutionResourceConfig = acctest.MustParseTemplate(testLabelConflictResolutionResourceTemplate, testLabelConflictResolutionResource)
+
+func TestAccDeviceConflictResolutionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testConflictResolutionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_device_conflict_resolution.test", "id", "data.cdo_asa_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "action", "accept"),
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "conflicted_device_uids.#", "0"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testConflictResolutionResourceConfig_Reject,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "action", "reject"),
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "triggers.revision", testConflictResolutionResource_Reject.Trigger),
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "conflicted_device_uids.#", "0"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccDeviceConflictResolutionResource_Label(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testLabelConflictResolutionResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "id", testLabelConflictResolutionResource.Label),
+					resource.TestCheckResourceAttr("cdo_device_conflict_resolution.test", "label", testLabelConflictResolutionResource.Label),
+					resource.TestCheckNoResourceAttr("cdo_device_conflict_resolution.test", "device_uid"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,99 @@
+package credentials
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/credentials"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	// the credentials cannot be read back from CDO, only check that the device still exists
+	readOutp, err := resource.client.ReadDeviceByUid(ctx, *device.NewReadByUidInput(stateData.DeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	stateData.Id = types.StringValue(readOutp.Uid)
+	stateData.DeviceUid = types.StringValue(readOutp.Uid)
+
+	return nil
+}
+
+func Rotate(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	username, password, err := lookupCredentials(planData)
+	if err != nil {
+		return err
+	}
+
+	credentialsHash := planData.CredentialsHash.ValueString()
+	if planData.CredentialsHash.IsUnknown() {
+		credentialsHash, err = hashCredentials(planData.DeviceUid.ValueString(), username, password)
+		if err != nil {
+			return err
+		}
+	} else if !credentialsMatch(credentialsHash, planData.DeviceUid.ValueString(), username, password) {
+		return fmt.Errorf("the values of environment variables %s and %s changed after the plan was made, plan again", planData.UsernameEnvVar.ValueString(), planData.PasswordEnvVar.ValueString())
+	}
+
+	updateOutp, err := resource.client.UpdateDeviceCredentials(ctx, credentials.NewUpdateInput(planData.DeviceUid.ValueString(), username, password))
+	if err != nil {
+		return err
+	}
+
+	planData.Id = types.StringValue(updateOutp.Uid)
+	planData.CredentialsHash = types.StringValue(credentialsHash)
+
+	return nil
+}
+
+func lookupCredentials(resourceModel *ResourceModel) (string, string, error) {
+	username, err := lookupEnv(resourceModel.UsernameEnvVar.ValueString())
+	if err != nil {
+		return "", "", err
+	}
+
+	password, err := lookupEnv(resourceModel.PasswordEnvVar.ValueString())
+	if err != nil {
+		return "", "", err
+	}
+
+	return username, password, nil
+}
+
+func lookupEnv(envName string) (string, error) {
+	value, ok := os.LookupEnv(envName)
+	if !ok || value == "" {
+		return "", fmt.Errorf("environment variable %s is not set", envName)
+	}
+
+	return value, nil
+}
+
+// hashCredentials hashes the credentials with bcrypt, which is salted and slow to brute force, so that the hash in the state does not give the password away.
+func hashCredentials(deviceUid string, username string, password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword(credentialsDigest(deviceUid, username, password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// credentialsMatch checks whether the hash was made by hashCredentials from these credentials.
+func credentialsMatch(credentialsHash string, deviceUid string, username string, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(credentialsHash), credentialsDigest(deviceUid, username, password)) == nil
+}
+
+// credentialsDigest is what bcrypt hashes, as bcrypt only hashes the first 72 bytes of its input.
+func credentialsDigest(deviceUid string, username string, password string) []byte {
+	digest := sha256.Sum256([]byte(deviceUid + "\x00" + username + "\x00" + password))
+	return []byte(hex.EncodeToString(digest[:]))
+}
@@ -0,0 +1,225 @@
+package credentials
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	DeviceUid       types.String `tfsdk:"device_uid"`
+	UsernameEnvVar  types.String `tfsdk:"username_env_var"`
+	PasswordEnvVar  types.String `tfsdk:"password_env_var"`
+	Version         types.String `tfsdk:"version"`
+	CredentialsHash types.String `tfsdk:"credentials_hash"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_device_credentials"
+}
+
+func (r *Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to rotate the credentials CDO uses to connect to an onboarded ASA or IOS device. " +
+			"The credentials are read from environment variables when Terraform runs, and are encrypted with the public key of the Secure Device Connector (SDC) of the device before they are sent to CDO, so they never appear in the configuration or in the state; the state only holds a salted bcrypt hash of them. " +
+			"The credentials are rotated when the resource is created, when `version` changes, and when the values of the environment variables change. " +
+			"Once the credentials are managed by this resource, the `username` and `password` of the device resource are only used to onboard the device, and should not be changed. " +
+			"Destroying this resource does not change the credentials of the device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the device credentials. This is the same as `device_uid`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA or IOS device to rotate the credentials of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username_env_var": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment variable holding the username CDO uses to connect to the device.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"password_env_var": schema.StringAttribute{
+				MarkdownDescription: "The name of the environment variable holding the password CDO uses to connect to the device.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "An arbitrary value, such as the version of the secret in your secrets store, that causes the credentials to be pushed to the device again when it changes.",
+				Optional:            true,
+			},
+			"credentials_hash": schema.StringAttribute{
+				MarkdownDescription: "A salted bcrypt hash of the credentials last pushed to the device. It is used to detect changes to the credentials.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create device credentials resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Rotate(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to rotate device credentials", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read device credentials resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read device credentials", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update device credentials resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// only the names of the environment variables changed, the device already has these credentials
+	if planData.Version.Equal(stateData.Version) && planData.CredentialsHash.Equal(stateData.CredentialsHash) {
+		response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+		return
+	}
+
+	if err := Rotate(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to rotate device credentials", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing a device credentials resource is a noop. It will not change the credentials of the device.")
+}
+
+// ModifyPlan hashes the credentials in the environment variables, so that a change to them shows up in the plan.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// the device may not have been onboarded yet, the hash is computed on apply
+	if planData.DeviceUid.IsUnknown() || planData.UsernameEnvVar.IsUnknown() || planData.PasswordEnvVar.IsUnknown() {
+		return
+	}
+
+	username, password, err := lookupCredentials(&planData)
+	if err != nil {
+		response.Diagnostics.AddError("failed to read device credentials", err.Error())
+		return
+	}
+
+	// bcrypt hashes are salted, so keep the hash in the state if the credentials did not change
+	if !request.State.Raw.IsNull() {
+		var stateData ResourceModel
+		response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		if credentialsMatch(stateData.CredentialsHash.ValueString(), planData.DeviceUid.ValueString(), username, password) {
+			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("credentials_hash"), stateData.CredentialsHash)...)
+			return
+		}
+	}
+
+	credentialsHash, err := hashCredentials(planData.DeviceUid.ValueString(), username, password)
+	if err != nil {
+		response.Diagnostics.AddError("failed to hash device credentials", err.Error())
+		return
+	}
+	response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("credentials_hash"), types.StringValue(credentialsHash))...)
+}
@@ -0,0 +1,91 @@
+package credentials_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const (
+	usernameEnvVar = "TF_ACC_DEVICE_CREDENTIALS_USERNAME"
+	passwordEnvVar = "TF_ACC_DEVICE_CREDENTIALS_PASSWORD"
+)
+
+var testCredentialsResource = struct {
+	AsaName        string
+	SocketAddress  string
+	ConnectorName  string
+	ConnectorType  string
+	Username       string
+	Password       string
+	UsernameEnvVar string
+	PasswordEnvVar string
+	Version        string
+}{
+	AsaName:        acctest.Env.AsaResourceSdcName(),
+	SocketAddress:  acctest.Env.AsaResourceSdcSocketAddress(),
+	ConnectorName:  acctest.Env.AsaResourceSdcConnectorName(),
+	ConnectorType:  acctest.Env.AsaResourceSdcConnectorType(),
+	Username:       acctest.Env.AsaResourceSdcUsername(),
+	Password:       acctest.Env.AsaResourceSdcPassword(),
+	UsernameEnvVar: usernameEnvVar,
+	PasswordEnvVar: passwordEnvVar,
+	Version:        "1",
+}
+
+const testCredentialsResourceTemplate = `
+resource "cdo_asa_device" "test" {
+	name               = "{{.AsaName}}"
+	socket_address     = "{{.SocketAddress}}"
+	connector_name     = "{{.ConnectorName}}"
+	connector_type     = "{{.ConnectorType}}"
+	username           = "{{.Username}}"
+	password           = "{{.Password}}"
+	ignore_certificate = true
+}
+
+resource "cdo_device_credentials" "test" {
+	device_uid       = cdo_asa_device.test.id
+	username_env_var = "{{.UsernameEnvVar}}"
+	password_env_var = "{{.PasswordEnvVar}}"
+	version          = "{{.Version}}"
+}`
+
+var testCredentialsResourceConfig = acctest.MustParseTemplate(testCredentialsResourceTemplate, testCredentialsResource)
+
+var testCredentialsResource_NewVersion = acctest.MustOverrideFields(testCredentialsResource, map[string]any{
+	"Version": "2",
+})
+var testCredentialsResourceConfig_NewVersion = acctest.MustParseTemplate(testCredentialsResourceTemplate, testCredentialsResource_NewVersion)
+
+func TestAccDeviceCredentialsResource(t *testing.T) {
+	t.Setenv(usernameEnvVar, testCredentialsResource.Username)
+	t.Setenv(passwordEnvVar, testCredentialsResource.Password)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testCredentialsResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_device_credentials.test", "id", "cdo_asa_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_device_credentials.test", "version", "1"),
+					resource.TestCheckResourceAttrSet("cdo_device_credentials.test", "credentials_hash"),
+					resource.TestCheckResourceAttr("cdo_asa_device.test", "connectivity_state", "ONLINE"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testCredentialsResourceConfig_NewVersion,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_credentials.test", "version", "2"),
+					resource.TestCheckResourceAttrSet("cdo_device_credentials.test", "credentials_hash"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,75 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/changes"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadDeviceChanges(ctx, changes.NewReadInput(stateData.DeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setChanges(stateData, readOutp)
+
+	return nil
+}
+
+func Deploy(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	readOutp, err := resource.client.ReadDeviceChanges(ctx, changes.NewReadInput(planData.DeviceUid.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	if err := checkDeviceType(readOutp); err != nil {
+		return err
+	}
+	if readOutp.HasConflict() {
+		return fmt.Errorf("the configuration of device %s was changed outside CDO; accept or reject the out-of-band changes before deploying", readOutp.Name)
+	}
+
+	if readOutp.HasPendingChanges() {
+		tflog.Debug(ctx, fmt.Sprintf("deploying changes to device %s", readOutp.Name))
+		readOutp, err = resource.client.DeployDeviceChanges(ctx, changes.NewDeployInput(planData.DeviceUid.ValueString(), readOutp.DeviceType))
+		if err != nil {
+			return err
+		}
+	} else {
+		tflog.Debug(ctx, fmt.Sprintf("device %s has no changes pending deploy", readOutp.Name))
+	}
+
+	planData.Id = planData.DeviceUid
+	setChanges(planData, readOutp)
+
+	return nil
+}
+
+// CheckSupportedDevice checks that changes can be deployed to the device, which only ASA devices support.
+func CheckSupportedDevice(ctx context.Context, resource *Resource, deviceUid string) error {
+
+	readOutp, err := resource.client.ReadDeviceChanges(ctx, changes.NewReadInput(deviceUid))
+	if err != nil {
+		return err
+	}
+
+	return checkDeviceType(readOutp)
+}
+
+func checkDeviceType(readOutp *changes.ReadOutput) error {
+	if !changes.IsDeploySupported(readOutp.DeviceType) {
+		return fmt.Errorf("deploying changes is not supported for devices of type %s, only ASA devices are supported", readOutp.DeviceType)
+	}
+	return nil
+}
+
+func setChanges(resourceModel *ResourceModel, readOutp *changes.ReadOutput) {
+	resourceModel.ConfigState = types.StringValue(string(readOutp.ConfigState))
+	resourceModel.PendingChanges = types.BoolValue(readOutp.HasPendingChanges())
+}
@@ -0,0 +1,198 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	DeviceUid      types.String `tfsdk:"device_uid"`
+	Triggers       types.Map    `tfsdk:"triggers"`
+	ConfigState    types.String `tfsdk:"config_state"`
+	PendingChanges types.Bool   `tfsdk:"pending_changes"`
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_device_deployment"
+}
+
+func (r *Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to deploy the changes pending on CDO to an ASA device. " +
+			"Only ASA devices are supported, other devices are rejected when planning; use the `cdo_cdfmc_deployment` resource for FTD devices managed by the cloud-delivered FMC. " +
+			"Changes are deployed when the resource is created, when a value in `triggers` changes, and when changes pending deploy are found on refresh. " +
+			"Destroying this resource does not change the device.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the deployment resource. This is the same as `device_uid`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"device_uid": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the ASA device to deploy changes to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "A map of arbitrary values that cause the pending changes to be deployed again when any of them changes, for example the labels of the device or the ID of a resource that changes its configuration.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"config_state": schema.StringAttribute{
+				MarkdownDescription: "The state of the configuration of the device on CDO, compared to the configuration on the device (Possible values: [SYNCED, NOT_SYNCED, CONFLICT_DETECTED]).",
+				Computed:            true,
+			},
+			"pending_changes": schema.BoolAttribute{
+				MarkdownDescription: "Whether the device has changes on CDO that have not been deployed to it yet.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create device deployment resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Deploy(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to deploy changes to device", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read device deployment resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read device deployment", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update device deployment resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Deploy(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to deploy changes to device", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Info(ctx, "Removing a device deployment resource is a noop. It will not revert the changes deployed to the device.")
+}
+
+// ModifyPlan rejects devices that changes cannot be deployed to, and plans a deployment when changes pending deploy were found on refresh, so that they show up in the plan.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	// the provider is not configured yet when validating, and the device may not be created yet
+	if r.client != nil && !planData.DeviceUid.IsUnknown() {
+		if err := CheckSupportedDevice(ctx, r, planData.DeviceUid.ValueString()); err != nil {
+			response.Diagnostics.AddAttributeError(path.Root("device_uid"), "cannot deploy changes to device", err.Error())
+			return
+		}
+	}
+
+	if request.State.Raw.IsNull() {
+		return
+	}
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if stateData.PendingChanges.ValueBool() {
+		tflog.Debug(ctx, "The device has changes pending deploy; plan a deployment")
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("pending_changes"), types.BoolUnknown())...)
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root("config_state"), types.StringUnknown())...)
+	}
+}
+
+func (r *Resource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), request.ID)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("device_uid"), request.ID)...)
+}
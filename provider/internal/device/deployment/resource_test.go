@@ -0,0 +1,69 @@
+package deployment_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDeploymentResource = struct {
+	AsaName string
+	Trigger string
+}{
+	AsaName: acctest.Env.AsaDataSourceName(),
+	Trigger: "1",
+}
+
+const testDeploymentResourceTemplate = `
+data "cdo_asa_device" "test" {
+	name = "{{.AsaName}}"
+}
+
+resource "cdo_device_deployment" "test" {
+	device_uid = data.cdo_asa_device.test.id
+	triggers = {
+		revision = "{{.Trigger}}"
+	}
+}`
+
+var testDeploymentResourceConfig = acctest.MustParseTemplate(testDeploymentResourceTemplate, testDeploymentResource)
+
+var testDeploymentResource_NewTrigger = acctest.MustOverrideFields(testDeploymentResource, map[string]any{
+	"Trigger": "2",
+})
+var testDeploymentResourceConfig_NewTrigger = acctest.MustParseTemplate(testDeploymentResourceTemplate, testDeploymentResource_NewTrigger)
+
+func TestAccDeviceDeploymentResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeploymentResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_device_deployment.test", "id", "data.cdo_asa_device.test", "id"),
+					resource.TestCheckResourceAttr("cdo_device_deployment.test", "config_state", "SYNCED"),
+					resource.TestCheckResourceAttr("cdo_device_deployment.test", "pending_changes", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "cdo_device_deployment.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers"},
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testDeploymentResourceConfig_NewTrigger,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cdo_device_deployment.test", "triggers.revision", testDeploymentResource_NewTrigger.Trigger),
+					resource.TestCheckResourceAttr("cdo_device_deployment.test", "pending_changes", "false"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,160 @@
+package day0config
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type DataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	FtdUid        types.String `tfsdk:"ftd_uid"`
+	Hostname      types.String `tfsdk:"hostname"`
+	AdminPassword types.String `tfsdk:"admin_password"`
+	Version       types.String `tfsdk:"version"`
+	Platform      types.String `tfsdk:"platform"`
+	Json          types.String `tfsdk:"json"`
+	UserData      types.String `tfsdk:"user_data"`
+}
+
+func NewDataSource() datasource.DataSource {
+	return &DataSource{}
+}
+
+type DataSource struct {
+	client *cdoClient.Client
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ftd_day0_config"
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Use this data source to build the day-0 configuration of a virtual FTD created using the `cdo_ftd_device` resource. " +
+			"Pass the day-0 configuration to the FTDv as user data when you deploy it, and the FTDv registers itself with the cloud-delivered FMC on first boot. " +
+			"CDO clears the registration key and NAT ID of the FTD once it is onboarded, after which `json` and `user_data` are null and a warning is reported, " +
+			"so ignore changes to the user data of the FTDv, e.g. with `lifecycle { ignore_changes = [user_data] }`, to keep it from being replaced.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the data source. This is the ID of the FTD.",
+				Computed:            true,
+			},
+			"ftd_uid": schema.StringAttribute{
+				MarkdownDescription: "The ID of the FTD to build the day-0 configuration for. This value is returned by the `id` attribute of the `cdo_ftd_device` resource.",
+				Required:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "The hostname of the FTDv.",
+				Required:            true,
+			},
+			"admin_password": schema.StringAttribute{
+				MarkdownDescription: "The password of the admin user of the FTDv.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The software version of the FTDv, e.g. `7.4.1`. If specified, it is only checked to be 7.0.3 or later, the versions the cdFMC can manage; the day-0 configuration is the same for all of them.",
+				Optional:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "The platform the FTDv is deployed on, one of `AWS`, `AZURE` or `KVM`. Defaults to `AWS`. On `KVM`, the day-0 configuration is read from the day0-config file of a disk attached to the FTDv and accepts the EULA, which on `AWS` and `AZURE` is accepted when the instance is launched from the marketplace image.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(cloudftd.Day0PlatformAws), string(cloudftd.Day0PlatformAzure), string(cloudftd.Day0PlatformKvm)),
+				},
+			},
+			"json": schema.StringAttribute{
+				MarkdownDescription: "The day-0 configuration in JSON.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"user_data": schema.StringAttribute{
+				MarkdownDescription: "The day-0 configuration in JSON, base64 encoded, to be used as the user data of the FTDv.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var planData DataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &planData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ftd, err := d.client.ReadCloudFtdByUid(ctx, cloudftd.NewReadByUidInput(planData.FtdUid.ValueString()))
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to read FTD", err.Error())
+		return
+	}
+
+	day0Config, err := cloudftd.NewDay0Config(cloudftd.NewDay0ConfigInput(
+		ftd.Metadata,
+		planData.Hostname.ValueString(),
+		planData.AdminPassword.ValueString(),
+		planData.Version.ValueString(),
+		cloudftd.Day0Platform(planData.Platform.ValueString()),
+	))
+	if errors.Is(err, cloudftd.Day0ConfigUnavailableError) {
+		resp.Diagnostics.AddWarning("FTD day-0 configuration is not available", fmt.Sprintf("%s. The FTDv deployed with its day-0 configuration is not affected.", err.Error()))
+		planData.Id = types.StringValue(ftd.Uid)
+		planData.Json = types.StringNull()
+		planData.UserData = types.StringNull()
+		resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build FTD day-0 configuration", err.Error())
+		return
+	}
+	jsonString, err := day0Config.Json()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build FTD day-0 configuration", err.Error())
+		return
+	}
+	userData, err := day0Config.UserData()
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to build FTD day-0 configuration", err.Error())
+		return
+	}
+
+	planData.Id = types.StringValue(ftd.Uid)
+	planData.Json = types.StringValue(jsonString)
+	planData.UserData = types.StringValue(userData)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &planData)...)
+}
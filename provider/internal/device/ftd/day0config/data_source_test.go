@@ -0,0 +1,64 @@
+package day0config_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testDay0Config = struct {
+	Name             string
+	AccessPolicyName string
+	PerformanceTier  string
+}{
+	Name:             acctest.Env.FtdResourceName(),
+	AccessPolicyName: acctest.Env.FtdResourceAccessPolicyName(),
+	PerformanceTier:  acctest.Env.FtdResourcePerformanceTier(),
+}
+
+const testDay0ConfigTemplate = `
+resource "cdo_ftd_device" "test" {
+	name = "{{.Name}}"
+	access_policy_name = "{{.AccessPolicyName}}"
+	performance_tier = "{{.PerformanceTier}}"
+	virtual = true
+	licenses = ["BASE"]
+}
+
+data "cdo_ftd_day0_config" "test" {
+	ftd_uid = cdo_ftd_device.test.id
+	hostname = "acceptance-test-ftdv"
+	admin_password = "Acceptance-Test-Password1!"
+	version = "7.4.1"
+}
+
+data "cdo_ftd_day0_config" "kvm" {
+	ftd_uid = cdo_ftd_device.test.id
+	hostname = "acceptance-test-ftdv"
+	admin_password = "Acceptance-Test-Password1!"
+	version = "7.2.5"
+	platform = "KVM"
+}`
+
+var testDay0ConfigConfig = acctest.MustParseTemplate(testDay0ConfigTemplate, testDay0Config)
+
+func TestAccFtdDay0ConfigDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: acctest.ProviderConfig() + testDay0ConfigConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.cdo_ftd_day0_config.test", "id", "cdo_ftd_device.test", "id"),
+					resource.TestCheckResourceAttrSet("data.cdo_ftd_day0_config.test", "json"),
+					resource.TestCheckResourceAttrSet("data.cdo_ftd_day0_config.test", "user_data"),
+					resource.TestCheckResourceAttrSet("data.cdo_ftd_day0_config.kvm", "json"),
+					resource.TestCheckResourceAttrSet("data.cdo_ftd_day0_config.kvm", "user_data"),
+				),
+			},
+		},
+	})
+}
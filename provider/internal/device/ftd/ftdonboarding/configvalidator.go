@@ -0,0 +1,41 @@
+package ftdonboarding
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+var _ resource.ConfigValidator = &hostKeyConfigValidator{}
+
+type hostKeyConfigValidator struct{}
+
+func (c hostKeyConfigValidator) Description(ctx context.Context) string {
+	return c.MarkdownDescription(ctx)
+}
+
+func (c hostKeyConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintln("Ensure the host key of the FTD is verified before sending it the password, unless skipping the verification is opted in to.")
+}
+
+func (c hostKeyConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var configData ResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &configData)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if configData.Ssh == nil || configData.Ssh.HostKey.IsUnknown() || configData.Ssh.InsecureSkipHostKeyVerification.IsUnknown() {
+		return
+	}
+
+	if configData.Ssh.HostKey.IsNull() && !configData.Ssh.InsecureSkipHostKeyVerification.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ssh").AtName("host_key"),
+			"Host key must be specified to verify the FTD before sending it the password",
+			"Set host_key to the SSH public key of the FTD, or set insecure_skip_host_key_verification to true to send the password without verifying the FTD.",
+		)
+	}
+}
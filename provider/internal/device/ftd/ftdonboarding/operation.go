@@ -18,6 +18,22 @@ func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) err
 
 func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
 
+	// run the registration command on the FTD ourselves if we can reach it
+	if planData.Ssh != nil {
+		_, err := resource.client.ConfigureFtdManager(ctx, cloudftdonboarding.NewConfigureManagerInput(
+			planData.FtdUid.ValueString(),
+			planData.Ssh.Host.ValueString(),
+			int(planData.Ssh.Port.ValueInt64()),
+			planData.Ssh.Username.ValueString(),
+			planData.Ssh.Password.ValueString(),
+			planData.Ssh.HostKey.ValueString(),
+			planData.Ssh.InsecureSkipHostKeyVerification.ValueBool(),
+		))
+		if err != nil {
+			return err
+		}
+	}
+
 	createOutp, err := resource.client.CreateFtdOnboarding(ctx, cloudftdonboarding.NewCreateInput(planData.FtdUid.ValueString()))
 	if err != nil {
 		return err
@@ -35,6 +51,9 @@ func Update(ctx context.Context, resource *Resource, planData *ResourceModel, st
 		return err
 	}
 
+	// the FTD is already onboarded, so the ssh connection is not used again, e.g. after the password of the FTD was rotated
+	stateData.Ssh = planData.Ssh
+
 	return nil
 }
 
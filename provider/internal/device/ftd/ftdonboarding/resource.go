@@ -7,13 +7,17 @@ import (
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithConfigValidators = &Resource{}
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -26,6 +30,17 @@ type Resource struct {
 type ResourceModel struct {
 	Id     types.String `tfsdk:"id"`
 	FtdUid types.String `tfsdk:"ftd_uid"`
+	Ssh    *SshModel    `tfsdk:"ssh"`
+}
+
+type SshModel struct {
+	Host     types.String `tfsdk:"host"`
+	Port     types.Int64  `tfsdk:"port"`
+	Username types.String `tfsdk:"username"`
+	Password types.String `tfsdk:"password"`
+	HostKey  types.String `tfsdk:"host_key"`
+
+	InsecureSkipHostKeyVerification types.Bool `tfsdk:"insecure_skip_host_key_verification"`
 }
 
 func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -39,6 +54,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			"This resource waits for you to finish pasting the registration command, and onboards the FTD to CDO. " +
 			"If you are spinning up an FTDv using Terraform, you can pass the output of the `cdo_ftd_device` through to the FTDv. " +
 			"If you are using a manually deployed FTDv or a physical FTD, you cannot add this resource to your Terraform code until after you have applied the `cdo_ftd_device` resource, retrieved the `generated_command` from the resource, and pasted it into the FTD device's CLI. " +
+			"If the FTD is reachable from where Terraform runs, you can instead set `ssh`, and this resource will paste the registration command into the FTD CLI over SSH for you before it starts polling. " +
+			"Changing `ssh` once the FTD is onboarded, e.g. to rotate its password, does not run the registration command again. " +
 			"This resource will time out if the registration command is not applied on the FTD CLI within 3 minutes of it starting to poll.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -55,10 +72,53 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"ssh": schema.SingleNestedAttribute{
+				MarkdownDescription: "The SSH connection to the FTD used to run the generated registration command in its CLI. If not specified, the registration command must be run on the FTD CLI manually.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"host": schema.StringAttribute{
+						MarkdownDescription: "The hostname or IP address of the management interface of the FTD.",
+						Required:            true,
+					},
+					"port": schema.Int64Attribute{
+						MarkdownDescription: "The SSH port of the FTD.",
+						Optional:            true,
+						Computed:            true,
+						Default:             int64default.StaticInt64(22),
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "The username to log in to the FTD CLI with.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("admin"),
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "The password to log in to the FTD CLI with.",
+						Required:            true,
+						Sensitive:           true,
+					},
+					"host_key": schema.StringAttribute{
+						MarkdownDescription: "The SSH public key of the FTD in authorized keys format, e.g. `ssh-rsa AAAA...`. The FTD is verified to have this host key before the password is sent to it. This is required unless `insecure_skip_host_key_verification` is set.",
+						Optional:            true,
+					},
+					"insecure_skip_host_key_verification": schema.BoolAttribute{
+						MarkdownDescription: "Set this attribute to true to send the password to the FTD without verifying its host key. This is insecure, as the password can be intercepted by anyone able to impersonate the FTD on the network; prefer setting `host_key`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
 		},
 	}
 }
 
+func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		hostKeyConfigValidator{},
+	}
+}
+
 func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
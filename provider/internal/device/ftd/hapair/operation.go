@@ -0,0 +1,159 @@
+package hapair
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudfmc/fmchapair"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
+	modelHaPair "github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/cloudfmc/hapair"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	haPair, err := resource.client.ReadFmcFtdHaPair(ctx, fmchapair.NewReadInput(fmcInfo.Hostname, fmcInfo.DomainUid, stateData.Id.ValueString()))
+	if err != nil {
+		return err
+	}
+
+	setHaPair(stateData, haPair)
+
+	return nil
+}
+
+func Create(ctx context.Context, resource *Resource, planData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, planData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	// the FMC pairs the device records of the FTDs
+	primaryRecord, err := resource.client.ReadCloudFtdDeviceRecord(ctx, cloudftd.NewReadDeviceRecordInput(fmcInfo.Hostname, fmcInfo.DomainUid, planData.PrimaryFtdId.ValueString()))
+	if err != nil {
+		return fmt.Errorf("failed to read primary FTD on cdFMC: %w", err)
+	}
+	secondaryRecord, err := resource.client.ReadCloudFtdDeviceRecord(ctx, cloudftd.NewReadDeviceRecordInput(fmcInfo.Hostname, fmcInfo.DomainUid, planData.SecondaryFtdId.ValueString()))
+	if err != nil {
+		return fmt.Errorf("failed to read secondary FTD on cdFMC: %w", err)
+	}
+
+	failoverLink, err := linkFromObject(ctx, planData.FailoverLink)
+	if err != nil {
+		return err
+	}
+	var stateLink *fmchapair.Link
+	if !planData.StateLink.IsNull() {
+		link, err := linkFromObject(ctx, planData.StateLink)
+		if err != nil {
+			return err
+		}
+		stateLink = &link
+	}
+
+	haPair, err := resource.client.CreateFmcFtdHaPair(
+		ctx,
+		fmchapair.NewCreateInputBuilder().
+			FmcHostname(fmcInfo.Hostname).
+			FmcDomainUid(fmcInfo.DomainUid).
+			Name(planData.Name.ValueString()).
+			PrimaryDeviceUid(primaryRecord.Id).
+			SecondaryDeviceUid(secondaryRecord.Id).
+			FailoverLink(failoverLink).
+			StateLink(stateLink).
+			SharedKey(planData.SharedKey.ValueString()).
+			Build(),
+	)
+	if err != nil {
+		return err
+	}
+
+	wantedActiveFtdId := planData.ActiveFtdId
+	planData.Id = types.StringValue(haPair.Id)
+	planData.ActiveFtdId = planData.PrimaryFtdId // the primary is active when the pair is formed
+	setHaPair(planData, haPair)
+
+	if !wantedActiveFtdId.IsUnknown() && !wantedActiveFtdId.IsNull() && !wantedActiveFtdId.Equal(planData.ActiveFtdId) {
+		haPair, err = resource.client.SwitchFmcFtdHaPairActive(ctx, fmchapair.NewSwitchActiveInput(fmcInfo.Hostname, fmcInfo.DomainUid, haPair.Id))
+		if err != nil {
+			return err
+		}
+		planData.ActiveFtdId = wantedActiveFtdId
+		setHaPair(planData, haPair)
+	}
+
+	return nil
+}
+
+// Update switches the active FTD of the pair, the other attributes require replacement.
+func Update(ctx context.Context, resource *Resource, planData *ResourceModel, stateData *ResourceModel) error {
+
+	if !planData.ActiveFtdId.IsUnknown() && !planData.ActiveFtdId.Equal(stateData.ActiveFtdId) {
+		fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+		if err != nil {
+			return err
+		}
+		haPair, err := resource.client.SwitchFmcFtdHaPairActive(ctx, fmchapair.NewSwitchActiveInput(fmcInfo.Hostname, fmcInfo.DomainUid, stateData.Id.ValueString()))
+		if err != nil {
+			return err
+		}
+		stateData.ActiveFtdId = planData.ActiveFtdId
+		setHaPair(stateData, haPair)
+	}
+
+	stateData.ForceBreak = planData.ForceBreak
+
+	return nil
+}
+
+func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
+
+	fmcInfo, err := cdfmc.ReadFmcInfoInDomain(ctx, resource.client, stateData.Domain.ValueString())
+	if err != nil {
+		return err
+	}
+
+	_, err = resource.client.BreakFmcFtdHaPair(ctx, fmchapair.NewBreakInput(fmcInfo.Hostname, fmcInfo.DomainUid, stateData.Id.ValueString(), stateData.ForceBreak.ValueBool()))
+	if err != nil && util.Is404Error(err) {
+		// already broken
+		return nil
+	}
+	return err
+}
+
+// setHaPair maps the HA pair to the model, the active FTD is only updated when the failover status of the pair is known.
+func setHaPair(data *ResourceModel, haPair *modelHaPair.HaPair) {
+	data.Name = types.StringValue(haPair.Name)
+	switch haPair.ActiveDeviceUid() {
+	case "":
+		// status unknown, keep the current value
+	case haPair.Primary.Id:
+		data.ActiveFtdId = data.PrimaryFtdId
+	case haPair.Secondary.Id:
+		data.ActiveFtdId = data.SecondaryFtdId
+	}
+}
+
+func linkFromObject(ctx context.Context, object types.Object) (fmchapair.Link, error) {
+	var linkModel LinkModel
+	diags := object.As(ctx, &linkModel, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return fmchapair.Link{}, fmt.Errorf("%s", util.DiagSummary(diags))
+	}
+	return fmchapair.NewLink(
+		linkModel.InterfaceName.ValueString(),
+		linkModel.LogicalName.ValueString(),
+		linkModel.ActiveIp.ValueString(),
+		linkModel.StandbyIp.ValueString(),
+		linkModel.SubnetMask.ValueString(),
+	), nil
+}
@@ -0,0 +1,282 @@
+package hapair
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithModifyPlan = &Resource{}
+
+func NewResource() resource.Resource {
+	return &Resource{}
+}
+
+type Resource struct {
+	client *cdoClient.Client
+}
+
+type ResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Domain         types.String `tfsdk:"domain"`
+	Name           types.String `tfsdk:"name"`
+	PrimaryFtdId   types.String `tfsdk:"primary_ftd_id"`
+	SecondaryFtdId types.String `tfsdk:"secondary_ftd_id"`
+	FailoverLink   types.Object `tfsdk:"failover_link"`
+	StateLink      types.Object `tfsdk:"state_link"`
+	SharedKey      types.String `tfsdk:"shared_key"`
+	ActiveFtdId    types.String `tfsdk:"active_ftd_id"`
+	ForceBreak     types.Bool   `tfsdk:"force_break"`
+}
+
+type LinkModel struct {
+	InterfaceName types.String `tfsdk:"interface_name"`
+	LogicalName   types.String `tfsdk:"logical_name"`
+	ActiveIp      types.String `tfsdk:"active_ip"`
+	StandbyIp     types.String `tfsdk:"standby_ip"`
+	SubnetMask    types.String `tfsdk:"subnet_mask"`
+}
+
+func linkAttributes(linkName string) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"interface_name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The name of the physical interface used for the %s on both FTDs, e.g. `GigabitEthernet0/2`. The interface must not have a logical name configured.", linkName),
+			Required:            true,
+		},
+		"logical_name": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The logical name given to the interface of the %s.", linkName),
+			Required:            true,
+		},
+		"active_ip": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The IPv4 address of the %s on the active FTD.", linkName),
+			Required:            true,
+		},
+		"standby_ip": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The IPv4 address of the %s on the standby FTD, in the same subnet as `active_ip`.", linkName),
+			Required:            true,
+		},
+		"subnet_mask": schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("The subnet mask of the %s, e.g. `255.255.255.0`.", linkName),
+			Required:            true,
+		},
+	}
+}
+
+func (r *Resource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = request.ProviderTypeName + "_ftd_ha_pair"
+}
+
+func (r *Resource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		MarkdownDescription: "Provides a resource to form an active/standby high-availability pair from two FTD devices managed by the cloud-delivered FMC. " +
+			"Both FTDs must be onboarded and have the same model, software version and licenses. " +
+			"Destroying this resource breaks the pair, the FTDs remain onboarded as standalone devices.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The unique identifier of the HA pair on the cdFMC.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name or UUID of the cdFMC domain the FTDs are registered in, e.g. `Global/Customer1`. If not specified, the default domain of the cdFMC is used.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the HA pair.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"primary_ftd_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the FTD device that is the primary of the pair, i.e. the `id` of a `cdo_ftd_device` resource. Its configuration is copied to the secondary when the pair is formed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"secondary_ftd_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the FTD device that is the secondary of the pair, i.e. the `id` of a `cdo_ftd_device` resource.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"failover_link": schema.SingleNestedAttribute{
+				MarkdownDescription: "The link the FTDs of the pair use to monitor each other.",
+				Required:            true,
+				Attributes:          linkAttributes("failover link"),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"state_link": schema.SingleNestedAttribute{
+				MarkdownDescription: "The link the active FTD uses to pass connection state to the standby FTD. If not specified, the failover link is used.",
+				Optional:            true,
+				Attributes:          linkAttributes("state link"),
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.RequiresReplace(),
+				},
+			},
+			"shared_key": schema.StringAttribute{
+				MarkdownDescription: "The key used to encrypt the traffic on the failover link. If not specified, the failover traffic is not encrypted.",
+				Optional:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"active_ftd_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the FTD device that is active in the pair, either `primary_ftd_id` or `secondary_ftd_id`. Changing it switches the active FTD. If not specified, the primary FTD is active when the pair is formed, and failovers are not reverted.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"force_break": schema.BoolAttribute{
+				MarkdownDescription: "Whether to break the pair on destroy even if the standby FTD cannot be reached. Defaults to false.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if request.ProviderData == nil {
+		return
+	}
+
+	client, ok := request.ProviderData.(*cdoClient.Client)
+
+	if !ok {
+		response.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *cdoClient.Client, got: %T. Please report this issue to the provider developers.", request.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *Resource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	tflog.Trace(ctx, "create FTD HA pair resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Create(ctx, r, &planData); err != nil {
+		response.Diagnostics.AddError("failed to create FTD HA pair", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
+}
+
+func (r *Resource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	tflog.Trace(ctx, "read FTD HA pair resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Read(ctx, r, &stateData); err != nil {
+		if util.Is404Error(err) {
+			response.State.RemoveResource(ctx)
+			return
+		}
+		response.Diagnostics.AddError("failed to read FTD HA pair", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Trace(ctx, "update FTD HA pair resource")
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Update(ctx, r, &planData, &stateData); err != nil {
+		response.Diagnostics.AddError("failed to update FTD HA pair", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	tflog.Trace(ctx, "delete FTD HA pair resource")
+
+	var stateData ResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := Delete(ctx, r, &stateData); err != nil {
+		response.Diagnostics.AddError("failed to break FTD HA pair", err.Error())
+	}
+}
+
+// ModifyPlan ensures the active FTD is one of the FTDs of the pair.
+func (r *Resource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		// destroying, ignore
+		return
+	}
+
+	var planData ResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if planData.ActiveFtdId.IsUnknown() || planData.ActiveFtdId.IsNull() || planData.PrimaryFtdId.IsUnknown() || planData.SecondaryFtdId.IsUnknown() {
+		return
+	}
+	if !planData.ActiveFtdId.Equal(planData.PrimaryFtdId) && !planData.ActiveFtdId.Equal(planData.SecondaryFtdId) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("active_ftd_id"),
+			"Active FTD is not in the HA pair",
+			"active_ftd_id must be either primary_ftd_id or secondary_ftd_id.",
+		)
+	}
+}
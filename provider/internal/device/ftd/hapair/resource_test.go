@@ -0,0 +1,81 @@
+package hapair_test
+
+import (
+	"testing"
+
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+var testHaPairResource = struct {
+	Name              string
+	PrimaryName       string
+	SecondaryName     string
+	InterfaceName     string
+	ActiveFtdResource string
+}{
+	Name:              "test-ftd-ha-pair",
+	PrimaryName:       acctest.Env.FtdHaPairResourcePrimaryName(),
+	SecondaryName:     acctest.Env.FtdHaPairResourceSecondaryName(),
+	InterfaceName:     acctest.Env.FtdHaPairResourceFailoverInterfaceName(),
+	ActiveFtdResource: "primary",
+}
+
+const testHaPairResourceTemplate = `
+data "cdo_ftd_device" "primary" {
+	name = "{{.PrimaryName}}"
+}
+
+data "cdo_ftd_device" "secondary" {
+	name = "{{.SecondaryName}}"
+}
+
+resource "cdo_ftd_ha_pair" "test" {
+	name             = "{{.Name}}"
+	primary_ftd_id   = data.cdo_ftd_device.primary.id
+	secondary_ftd_id = data.cdo_ftd_device.secondary.id
+	active_ftd_id    = data.cdo_ftd_device.{{.ActiveFtdResource}}.id
+	failover_link = {
+		interface_name = "{{.InterfaceName}}"
+		logical_name   = "failover-link"
+		active_ip      = "192.168.254.1"
+		standby_ip     = "192.168.254.2"
+		subnet_mask    = "255.255.255.0"
+	}
+}`
+
+var testHaPairResourceConfig = acctest.MustParseTemplate(testHaPairResourceTemplate, testHaPairResource)
+
+var testHaPairResource_SwitchActive = acctest.MustOverrideFields(testHaPairResource, map[string]any{
+	"ActiveFtdResource": "secondary",
+})
+var testHaPairResourceConfig_SwitchActive = acctest.MustParseTemplate(testHaPairResourceTemplate, testHaPairResource_SwitchActive)
+
+func TestAccFtdHaPairResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 acctest.PreCheckFunc(t),
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: acctest.ProviderConfig() + testHaPairResourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("cdo_ftd_ha_pair.test", "id"),
+					resource.TestCheckResourceAttr("cdo_ftd_ha_pair.test", "name", testHaPairResource.Name),
+					resource.TestCheckResourceAttrPair("cdo_ftd_ha_pair.test", "primary_ftd_id", "data.cdo_ftd_device.primary", "id"),
+					resource.TestCheckResourceAttrPair("cdo_ftd_ha_pair.test", "secondary_ftd_id", "data.cdo_ftd_device.secondary", "id"),
+					resource.TestCheckResourceAttrPair("cdo_ftd_ha_pair.test", "active_ftd_id", "data.cdo_ftd_device.primary", "id"),
+					resource.TestCheckResourceAttr("cdo_ftd_ha_pair.test", "failover_link.interface_name", testHaPairResource.InterfaceName),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: acctest.ProviderConfig() + testHaPairResourceConfig_SwitchActive,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("cdo_ftd_ha_pair.test", "active_ftd_id", "data.cdo_ftd_device.secondary", "id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -44,9 +44,14 @@ func ReadDataSource(ctx context.Context, resource *DataSource, stateData *DataSo
 
 func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
 
-	// do read
-	inp := cloudftd.NewReadByNameInput(stateData.Name.ValueString())
-	res, err := resource.client.ReadCloudFtdByName(ctx, inp)
+	// do read, by uid if known, as only the uid is present after import
+	var res *cloudftd.ReadOutput
+	var err error
+	if stateData.ID.ValueString() != "" {
+		res, err = resource.client.ReadCloudFtdByUid(ctx, cloudftd.NewReadByUidInput(stateData.ID.ValueString()))
+	} else {
+		res, err = resource.client.ReadCloudFtdByName(ctx, cloudftd.NewReadByNameInput(stateData.Name.ValueString()))
+	}
 	if err != nil {
 		return err
 	}
@@ -108,6 +113,7 @@ func Create(ctx context.Context, resource *Resource, planData *ResourceModel) er
 		planData.Virtual.ValueBool(),
 		&licenses,
 		planTags,
+		planData.Domain.ValueString(),
 	)
 	res, err := resource.client.CreateCloudFtd(ctx, createInp)
 	if err != nil {
@@ -155,11 +161,23 @@ func Update(ctx context.Context, resource *Resource, planData *ResourceModel, st
 		return err
 	}
 
+	// performance tier only applies to virtual FTD
+	var performanceTier *tier.Type = nil
+	if planData.Virtual.ValueBool() && planData.PerformanceTier.ValueString() != "" {
+		t, err := tier.Parse(planData.PerformanceTier.ValueString())
+		if err != nil {
+			return err
+		}
+		performanceTier = &t
+	}
+
 	inp := cloudftd.NewUpdateInput(
 		planData.ID.ValueString(),
 		planData.Name.ValueString(),
 		planTags,
 		licenses,
+		performanceTier,
+		stateData.Domain.ValueString(),
 	)
 	res, err := resource.client.UpdateCloudFtd(ctx, inp)
 	if err != nil {
@@ -175,6 +193,11 @@ func Update(ctx context.Context, resource *Resource, planData *ResourceModel, st
 	stateData.Labels = planData.Labels
 	stateData.GroupedLabels = planData.GroupedLabels
 	stateData.Licenses = util.GoStringSliceToTFStringSet(licensesStrings)
+	if res.Metadata.PerformanceTier != nil { // nil means physical cloud ftd
+		stateData.PerformanceTier = types.StringValue(string(*res.Metadata.PerformanceTier))
+	} else {
+		stateData.PerformanceTier = planData.PerformanceTier
+	}
 
 	return nil
 }
@@ -182,7 +205,7 @@ func Update(ctx context.Context, resource *Resource, planData *ResourceModel, st
 func Delete(ctx context.Context, resource *Resource, stateData *ResourceModel) error {
 
 	// do delete
-	inp := cloudftd.NewDeleteInput(stateData.ID.ValueString())
+	inp := cloudftd.NewDeleteInput(stateData.ID.ValueString(), stateData.Domain.ValueString())
 	_, err := resource.client.DeleteCloudFtd(ctx, inp)
 
 	return err
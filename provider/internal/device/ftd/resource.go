@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/cloudftd"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/license"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/ftd/tier"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
@@ -42,6 +43,7 @@ type ResourceModel struct {
 	ID               types.String `tfsdk:"id"`
 	Name             types.String `tfsdk:"name"`
 	AccessPolicyName types.String `tfsdk:"access_policy_name"`
+	Domain           types.String `tfsdk:"domain"`
 	PerformanceTier  types.String `tfsdk:"performance_tier"`
 	Virtual          types.Bool   `tfsdk:"virtual"`
 	Licenses         types.Set    `tfsdk:"licenses"`
@@ -85,12 +87,16 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"performance_tier": schema.StringAttribute{
-				MarkdownDescription: "The performance tier of the virtual FTD, if virtual is set to false, this field is ignored as performance tiers are not applicable to physical FTD devices. Allowed values are: [\"FTDv5\", \"FTDv10\", \"FTDv20\", \"FTDv30\", \"FTDv50\", \"FTDv100\", \"FTDv\"].",
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The name or UUID of the Cloud-Delivered FMC (cdFMC) domain the FTD will be registered in, e.g. `Global/Customer1`. The access policy is looked up in this domain. If not specified, the default domain of the cdFMC is used.",
 				Optional:            true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
 				},
+			},
+			"performance_tier": schema.StringAttribute{
+				MarkdownDescription: "The performance tier of the virtual FTD, if virtual is set to false, this field is ignored as performance tiers are not applicable to physical FTD devices. Changing the performance tier of a virtual FTD updates it in place. Allowed values are: [\"FTDv5\", \"FTDv10\", \"FTDv20\", \"FTDv30\", \"FTDv50\", \"FTDv100\", \"FTDv\"].",
+				Optional:            true,
 				Validators: []validator.String{
 					stringvalidator.OneOf(tier.AllAsString...),
 				},
@@ -104,7 +110,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 			},
 			"licenses": schema.SetAttribute{
 				ElementType:         types.StringType,
-				MarkdownDescription: "Comma-separated list of licenses to apply to this FTD. You must enable at least the \"BASE\" license. Allowed values are: [\"BASE\", \"CARRIER\", \"THREAT\", \"MALWARE\", \"URLFilter\",].",
+				MarkdownDescription: "Comma-separated list of licenses to apply to this FTD. You must enable at least the \"BASE\" license. Changing the licenses also updates the device licenses of the cdFMC, which are shared by all the FTD devices it manages, so do not change them when the device licenses are managed by a `cdo_cdfmc_device_licenses` resource. Allowed values are: [\"BASE\", \"CARRIER\", \"THREAT\", \"MALWARE\", \"URLFilter\",].",
 				Required:            true,
 				Validators: []validator.Set{
 					setvalidator.SizeAtLeast(1),
@@ -275,7 +281,17 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadCloudFtdByName(ctx, cloudftd.NewReadByNameInput(uidOrName))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import FTD device", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
 }
 
 func (r *Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
@@ -307,5 +323,22 @@ func (r *Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReques
 
 	planData.Licenses = util.GoStringSliceToTFStringSet(licenseStrings)
 
+	if !req.State.Raw.IsNull() {
+		// updating, performance tier can only be changed in place for virtual FTD
+		var stateData ResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &stateData)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !planData.Virtual.ValueBool() && !planData.PerformanceTier.IsUnknown() && !planData.PerformanceTier.IsNull() && !planData.PerformanceTier.Equal(stateData.PerformanceTier) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("performance_tier"),
+				"Performance Tier cannot be changed for physical FTD",
+				"Performance tiers are only applicable to virtual FTDs, please set virtual to true or remove the performance tier.",
+			)
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.Plan.Set(ctx, &planData)...)
 }
@@ -7,8 +7,10 @@ import (
 	"strconv"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/connector"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/connectivity"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/publicapilabels"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/device/tags"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/statemachine/state"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device/ios"
@@ -38,9 +40,24 @@ func Read(ctx context.Context, resource *IosDeviceResource, stateData *IosDevice
 	stateData.Ipv4 = types.StringValue(readOutp.SocketAddress)
 	stateData.Host = types.StringValue(readOutp.Host)
 	stateData.IgnoreCertificate = types.BoolValue(readOutp.IgnoreCertificate)
+	stateData.SoftwareVersion = types.StringValue(readOutp.SoftwareVersion)
 	stateData.Labels = util.GoStringSliceToTFStringSet(readOutp.Tags.UngroupedTags())
 	stateData.GroupedLabels = util.GoMapToStringSetTFMap(readOutp.Tags.GroupedTags())
 
+	readSpecificOutp, err := resource.client.ReadSpecificIos(ctx, *ios.NewReadSpecificInput(readOutp.Uid))
+	if err != nil {
+		return err
+	}
+	setHealth(stateData, readOutp, readSpecificOutp)
+
+	// look up the connector name using the connector uid of the device, so that moving the device
+	// to another SDC outside of terraform shows up as drift. It is also not known after import.
+	readSdcOutp, err := resource.client.ReadConnectorByUid(ctx, *connector.NewReadByUidInput(readOutp.ConnectorUid))
+	if err != nil {
+		return err
+	}
+	stateData.ConnectorName = types.StringValue(readSdcOutp.Name)
+
 	return nil
 }
 
@@ -71,11 +88,20 @@ func Create(ctx context.Context, resource *IosDeviceResource, planData *IosDevic
 		planData.IgnoreCertificate.ValueBool(),
 		planTags,
 	)
+	if !planData.SoftwareVersion.IsUnknown() {
+		createInp.SoftwareVersion = planData.SoftwareVersion.ValueString()
+	}
 
-	createOutp, err := resource.client.CreateIos(ctx, *createInp)
-	tflog.Debug(ctx, fmt.Sprintf("Creation error: %v", err))
-	if err != nil {
-		return err
+	createOutp, createErr := resource.client.CreateIos(ctx, *createInp)
+	if createErr != nil {
+		tflog.Debug(ctx, fmt.Sprintf("Creation error: %v", createErr))
+		// the device was onboarded but is not the one planned, delete it so that it does not linger without a state
+		if createErr.CreatedResourceId != nil {
+			if _, err := resource.client.DeleteIos(ctx, *ios.NewDeleteInput(*createErr.CreatedResourceId)); err != nil {
+				return fmt.Errorf("%w, and failed to delete the IOS device, cause=%s", createErr, err.Error())
+			}
+		}
+		return createErr
 	}
 
 	planData.ID = types.StringValue(createOutp.Uid)
@@ -90,6 +116,13 @@ func Create(ctx context.Context, resource *IosDeviceResource, planData *IosDevic
 	planData.Port = types.Int64Value(port)
 	planData.Labels = util.GoStringSliceToTFStringSet(createOutp.Tags.UngroupedTags())
 	planData.GroupedLabels = util.GoMapToStringSetTFMap(createOutp.Tags.GroupedTags())
+	planData.SoftwareVersion = types.StringValue(createOutp.SoftwareVersion)
+
+	readSpecificOutp, err := resource.client.ReadSpecificIos(ctx, *ios.NewReadSpecificInput(createOutp.Uid))
+	if err != nil {
+		return err
+	}
+	setHealth(planData, createOutp, readSpecificOutp)
 
 	return nil
 }
@@ -107,13 +140,44 @@ func Update(ctx context.Context, resource *IosDeviceResource, planData *IosDevic
 		planData.Name.ValueString(),
 		planTags,
 	)
+	if planData.ConnectorName.ValueString() != stateData.ConnectorName.ValueString() {
+		readSdcOutp, err := resource.client.ReadConnectorByName(ctx, *connector.NewReadByNameInput(planData.ConnectorName.ValueString()))
+		if err != nil {
+			return err
+		}
+		updateInp.ConnectorUid = readSdcOutp.Uid
+		// the credentials are encrypted with the public key of the connector, so they need to be sent again
+		updateInp.Username = planData.Username.ValueString()
+		updateInp.Password = planData.Password.ValueString()
+	}
+	if isSoftwareVersionUpdated(planData, stateData) {
+		updateInp.SoftwareVersion = planData.SoftwareVersion.ValueString()
+		tflog.Debug(ctx, fmt.Sprintf("Updating software version to %s", updateInp.SoftwareVersion))
+	}
+
 	updateOutp, err := resource.client.UpdateIos(ctx, updateInp)
 	if err != nil {
 		return err
 	}
 	stateData.Name = types.StringValue(updateOutp.Name)
+	stateData.ConnectorName = planData.ConnectorName
 	stateData.Labels = planData.Labels
 	stateData.GroupedLabels = planData.GroupedLabels
+	stateData.SoftwareVersion = planData.SoftwareVersion
+	// credentials are not known after import, take them from the plan
+	stateData.Username = planData.Username
+	stateData.Password = planData.Password
+
+	// read the device back, as moving it to another connector or changing its credentials changes its health
+	readOutp, err := resource.client.ReadIos(ctx, ios.ReadInput{Uid: updateOutp.Uid})
+	if err != nil {
+		return err
+	}
+	readSpecificOutp, err := resource.client.ReadSpecificIos(ctx, *ios.NewReadSpecificInput(updateOutp.Uid))
+	if err != nil {
+		return err
+	}
+	setHealth(stateData, readOutp, readSpecificOutp)
 
 	return nil
 }
@@ -124,6 +188,12 @@ func Delete(ctx context.Context, resource *IosDeviceResource, stateData *IosDevi
 	return err
 }
 
+// setHealth sets the computed attributes that show whether CDO can still reach and log in to the device.
+func setHealth(resourceModel *IosDeviceResourceModel, readOutp *ios.ReadOutput, readSpecificOutp *ios.ReadSpecificOutput) {
+	resourceModel.ConnectivityState = types.StringValue(connectivity.State(readOutp.ConnectivityState).String())
+	resourceModel.CredentialsValid = types.BoolValue(!state.IsBadCredentials(readOutp.State) && !state.IsBadCredentials(readSpecificOutp.State))
+}
+
 func ungroupedAndGroupedLabelsFromIosDeviceResourceModel(ctx context.Context, resourceModel *IosDeviceResourceModel) ([]string, map[string][]string, error) {
 	if resourceModel == nil {
 		return nil, nil, errors.New("resource model cannot be nil")
@@ -12,11 +12,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 
+	"github.com/CiscoDevnet/terraform-provider-cdo/planmodifiers"
 	"github.com/CiscoDevnet/terraform-provider-cdo/validators"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -27,6 +30,7 @@ import (
 
 var _ resource.Resource = &IosDeviceResource{}
 var _ resource.ResourceWithImportState = &IosDeviceResource{}
+var _ resource.ResourceWithModifyPlan = &IosDeviceResource{}
 
 func NewIosDeviceResource() resource.Resource {
 	return &IosDeviceResource{}
@@ -49,7 +53,11 @@ type IosDeviceResourceModel struct {
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
 
-	IgnoreCertificate types.Bool `tfsdk:"ignore_certificate"`
+	IgnoreCertificate types.Bool   `tfsdk:"ignore_certificate"`
+	SoftwareVersion   types.String `tfsdk:"software_version"`
+
+	ConnectivityState types.String `tfsdk:"connectivity_state"`
+	CredentialsValid  types.Bool   `tfsdk:"credentials_valid"`
 }
 
 func (r *IosDeviceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,11 +82,8 @@ func (r *IosDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:            true,
 			},
 			"connector_name": schema.StringAttribute{
-				MarkdownDescription: "The name of the Secure Device Connector (SDC) that will be used to communicate with the device. This value is not required if the connector type selected is Cloud Device Gateway (CDG).",
+				MarkdownDescription: "The name of the Secure Device Connector (SDC) that will be used to communicate with the device. This value is not required if the connector type selected is Cloud Device Gateway (CDG). Changing the connector moves the device to the new connector without onboarding it again.",
 				Required:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"socket_address": schema.StringAttribute{
 				MarkdownDescription: "The address of the device to onboard, specified in the format `host:port`.",
@@ -108,7 +113,7 @@ func (r *IosDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "The username used to authenticate with the device.",
 				Required:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.RequiresReplaceUnlessImported(),
 				},
 			},
 			"password": schema.StringAttribute{
@@ -116,7 +121,7 @@ func (r *IosDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Required:            true,
 				Sensitive:           true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					planmodifiers.RequiresReplaceUnlessImported(),
 				},
 			},
 			"ignore_certificate": schema.BoolAttribute{
@@ -139,6 +144,19 @@ func (r *IosDeviceResource) Schema(ctx context.Context, req resource.SchemaReque
 				Computed: true,
 				Default:  mapdefault.StaticValue(types.MapValueMust(types.SetType{ElemType: types.StringType}, map[string]attr.Value{})), // default to empty list
 			},
+			"software_version": schema.StringAttribute{
+				MarkdownDescription: "The software version of the iOS device. If this attribute is set during resource creation and the version of the device is not the same as that specified, resource creation will fail and the onboarded device is deleted from CDO. If this attribute is updated following the creation of a resource, the CDO terraform provider will stage the image of the specified version on the device if needed, and upgrade the device to it; the device reloads during the upgrade. The version is validated against the images available for the device when planning.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"connectivity_state": schema.StringAttribute{
+				MarkdownDescription: "The connectivity state of the device as reported by CDO (Possible values: [ONLINE, OFFLINE, UNKNOWN]). A device that CDO cannot reach, for example because it was moved or its connector is down, is reported as `OFFLINE`.",
+				Computed:            true,
+			},
+			"credentials_valid": schema.BoolAttribute{
+				MarkdownDescription: "Whether CDO can log in to the device with its current credentials. This is `false` when the credentials were changed on the device, or in the CDO UI, without being updated here.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -251,7 +269,20 @@ func (r *IosDeviceResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *IosDeviceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadDeviceByName(ctx, device.NewReadByNameAndTypeInput(uidOrName, devicetype.Ios))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import IOS device", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("username"), types.StringNull())...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("password"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&res.Diagnostics, "username", "password")
 }
 
 func (r *IosDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, res *resource.ModifyPlanResponse) {
@@ -275,6 +306,20 @@ func (r *IosDeviceResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 			planData.Port = stateData.Port
 		}
 
+		if planData != nil && stateData != nil {
+			if planData.SoftwareVersion.IsUnknown() || planData.SoftwareVersion.ValueString() == "" || !isSoftwareVersionUpdated(planData, stateData) {
+				tflog.Debug(ctx, "There is no change in the IOS software version; remove diffs")
+				planData.SoftwareVersion = stateData.SoftwareVersion
+			} else if _, err := r.client.ValidateIosSoftwareVersion(ctx, stateData.ID.ValueString(), planData.SoftwareVersion.ValueString()); err != nil {
+				res.Diagnostics.AddAttributeError(path.Root("software_version"), "invalid IOS software version", err.Error())
+				return
+			}
+		}
+
 		res.Diagnostics.Append(res.Plan.Set(ctx, &planData)...)
 	}
 }
+
+func isSoftwareVersionUpdated(planData, stateData *IosDeviceResourceModel) bool {
+	return strings.TrimSpace(planData.SoftwareVersion.ValueString()) != strings.TrimSpace(stateData.SoftwareVersion.ValueString())
+}
@@ -92,6 +92,9 @@ func TestAccIosDeviceResource_SDC(t *testing.T) {
 					resource.TestCheckResourceAttr("cdo_ios_device.test", "port", strconv.FormatInt(testIosResource.Port, 10)),
 					resource.TestCheckResourceAttr("cdo_ios_device.test", "username", testIosResource.Username),
 					resource.TestCheckResourceAttr("cdo_ios_device.test", "password", testIosResource.Password),
+					resource.TestCheckResourceAttr("cdo_ios_device.test", "connectivity_state", "ONLINE"),
+					resource.TestCheckResourceAttr("cdo_ios_device.test", "credentials_valid", "true"),
+					resource.TestCheckResourceAttrSet("cdo_ios_device.test", "software_version"),
 					resource.TestCheckResourceAttr("cdo_ios_device.test", "labels.#", strconv.Itoa(len(labels))),
 					resource.TestCheckTypeSetElemAttr("cdo_ios_device.test", "labels.*", labels[0]),
 					resource.TestCheckTypeSetElemAttr("cdo_ios_device.test", "labels.*", labels[1]),
@@ -113,6 +116,8 @@ func TestAccIosDeviceResource_SDC(t *testing.T) {
 				Config: acctest.ProviderConfig() + testIosResourceConfig_NewName,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("cdo_ios_device.test", "name", testIosResource_NewName.Name),
+					resource.TestCheckResourceAttr("cdo_ios_device.test", "connectivity_state", "ONLINE"),
+					resource.TestCheckResourceAttr("cdo_ios_device.test", "credentials_valid", "true"),
 				),
 			},
 
@@ -0,0 +1,31 @@
+package msp_tenant
+
+import (
+	"context"
+	"fmt"
+
+	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/tenants"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+)
+
+// TenantUidFromImportId returns the uid of the MSP managed tenant referred to by the import id,
+// which is either the uid of the tenant or its name prefixed with "name:".
+func TenantUidFromImportId(ctx context.Context, client *cdoClient.Client, importId string) (string, error) {
+	uidOrName, isName := util.ParseImportId(importId)
+	if !isName {
+		return uidOrName, nil
+	}
+
+	mspManagedTenants, err := client.FindMspManagedTenantByName(ctx, tenants.ReadByNameInput{
+		Name: uidOrName,
+	})
+	if err != nil {
+		return "", err
+	}
+	if mspManagedTenants.Count != 1 {
+		return "", fmt.Errorf("cannot find MSP managed tenant by name %s, found %d tenants", uidOrName, mspManagedTenants.Count)
+	}
+
+	return mspManagedTenants.Items[0].Uid, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/tenants"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
 	"github.com/CiscoDevnet/terraform-provider-cdo/validators"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -15,6 +16,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+var _ resource.ResourceWithImportState = &TenantResource{}
+
 func NewTenantResource() resource.Resource { return &TenantResource{} }
 
 type TenantResource struct {
@@ -160,14 +163,32 @@ func (t *TenantResource) Read(ctx context.Context, request resource.ReadRequest,
 	}
 
 	stateData.Id = types.StringValue(tenantReadOutp.Uid)
-	stateData.Name = types.StringValue(stateData.Name.ValueString())
+	// name is not known after import, use the name of the tenant on CDO
+	if stateData.Name.IsNull() {
+		stateData.Name = types.StringValue(tenantReadOutp.Name)
+	} else {
+		stateData.Name = types.StringValue(stateData.Name.ValueString())
+	}
 	stateData.GeneratedName = types.StringValue(tenantReadOutp.Name)
 	stateData.DisplayName = types.StringValue(tenantReadOutp.DisplayName)
 	stateData.Region = types.StringValue(tenantReadOutp.Region)
 
+	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
 	tflog.Debug(ctx, "CDO tenant read")
 }
 
+func (t *TenantResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tenantUid, err := TenantUidFromImportId(ctx, t.client, request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("failed to import tenant", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("id"), tenantUid)...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("api_token"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&response.Diagnostics, "api_token")
+}
+
 func (t *TenantResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
 	response.Diagnostics.AddError("Cannot update a created tenant", "Please reach out to CDO TAC if you want to change the display name of your tenant.")
 }
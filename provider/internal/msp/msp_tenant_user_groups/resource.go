@@ -5,12 +5,14 @@ import (
 	"fmt"
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/usergroups"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/msp/msp_tenant"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
@@ -18,6 +20,8 @@ import (
 	"sort"
 )
 
+var _ resource.ResourceWithImportState = &MspManagedTenantUserGroupsResource{}
+
 func NewMspManagedTenantUserGroupsResource() resource.Resource {
 	return &MspManagedTenantUserGroupsResource{}
 }
@@ -45,11 +49,16 @@ func (resource *MspManagedTenantUserGroupsResource) Metadata(ctx context.Context
 
 func (resource *MspManagedTenantUserGroupsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		MarkdownDescription: "Provides a resource to add user groups to an MSP managed tenant.",
+		MarkdownDescription: "Provides a resource to add user groups to an MSP managed tenant. " +
+			"User groups removed from the list are deleted from the tenant, and user groups that change are deleted and added again. " +
+			"Importing the resource adopts all the user groups of the tenant, so list all of them in the configuration, otherwise the user groups not in it are deleted on the next apply.",
 		Attributes: map[string]schema.Attribute{
 			"tenant_uid": schema.StringAttribute{
 				MarkdownDescription: "Universally unique identifier of the tenant to which the user group should be added.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"user_groups": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
@@ -80,9 +89,6 @@ func (resource *MspManagedTenantUserGroupsResource) Schema(ctx context.Context,
 							Validators:          []validator.String{stringvalidator.OneOf("ROLE_READ_ONLY", "ROLE_ADMIN", "ROLE_SUPER_ADMIN", "ROLE_DEPLOY_ONLY", "ROLE_EDIT_ONLY", "ROLE_VPN_SESSIONS_MANAGER")},
 						},
 					},
-					PlanModifiers: []planmodifier.Object{
-						objectplanmodifier.RequiresReplace(),
-					},
 				},
 				MarkdownDescription: "The list of user groups to be added to the tenant. You can add a maximum of 50 user groups at a time.",
 				Required:            true,
@@ -117,7 +123,14 @@ func (resource *MspManagedTenantUserGroupsResource) Read(ctx context.Context, re
 	tflog.Debug(ctx, "Reading user groups from MSP-managed CDO tenant")
 	var stateData MspManagedTenantUserGroupsResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
-	userGroupDetails, err := resource.client.ReadUserGroupsInMspManagedTenant(ctx, stateData.TenantUid.ValueString(), resource.buildMspUserGroupInput(&stateData))
+	var userGroupDetails *[]usergroups.MspManagedUserGroup
+	var err error
+	if stateData.UserGroups == nil {
+		// user groups are not known after import, read all user groups in the tenant
+		userGroupDetails, err = resource.client.ReadAllUserGroupsInMspManagedTenant(ctx, stateData.TenantUid.ValueString())
+	} else {
+		userGroupDetails, err = resource.client.ReadUserGroupsInMspManagedTenant(ctx, stateData.TenantUid.ValueString(), resource.buildMspUserGroupInput(&stateData))
+	}
 	if err != nil {
 		response.Diagnostics.AddError("failed to read users in MSP-managed tenant", err.Error())
 		return
@@ -126,7 +139,69 @@ func (resource *MspManagedTenantUserGroupsResource) Read(ctx context.Context, re
 	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
 }
 
+func (resource *MspManagedTenantUserGroupsResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tenantUid, err := msp_tenant.TenantUidFromImportId(ctx, resource.client, request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("failed to import user groups in MSP-managed tenant", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_uid"), tenantUid)...)
+}
+
 func (resource *MspManagedTenantUserGroupsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating user groups in MSP-managed CDO tenant")
+	var planData MspManagedTenantUserGroupsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	var stateData MspManagedTenantUserGroupsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// user groups cannot be changed in place, so the user groups that changed are deleted and added again
+	stateUserGroups := make(map[string]UserGroup)
+	for _, userGroup := range stateData.UserGroups {
+		stateUserGroups[userGroup.GroupIdentifier.ValueString()] = userGroup
+	}
+	planUserGroups := make(map[string]UserGroup)
+	for _, userGroup := range planData.UserGroups {
+		planUserGroups[userGroup.GroupIdentifier.ValueString()] = userGroup
+	}
+	var userGroupsToDelete, unchangedUserGroups, userGroupsToAdd []UserGroup
+	for _, userGroup := range stateData.UserGroups {
+		if planUserGroup, ok := planUserGroups[userGroup.GroupIdentifier.ValueString()]; !ok || !sameUserGroup(userGroup, planUserGroup) {
+			userGroupsToDelete = append(userGroupsToDelete, userGroup)
+		}
+	}
+	for _, userGroup := range planData.UserGroups {
+		if stateUserGroup, ok := stateUserGroups[userGroup.GroupIdentifier.ValueString()]; ok && sameUserGroup(stateUserGroup, userGroup) {
+			unchangedUserGroups = append(unchangedUserGroups, stateUserGroup)
+		} else {
+			userGroupsToAdd = append(userGroupsToAdd, userGroup)
+		}
+	}
+
+	if len(userGroupsToDelete) > 0 {
+		_, err := resource.deleteAllUserGroupsInState(ctx, &MspManagedTenantUserGroupsResourceModel{TenantUid: stateData.TenantUid, UserGroups: userGroupsToDelete})
+		if err != nil {
+			response.Diagnostics.AddError("failed to delete user groups in MSP-managed tenant", err.Error())
+			return
+		}
+	}
+
+	updatedUserGroups := unchangedUserGroups
+	if len(userGroupsToAdd) > 0 {
+		createdUserGroups, err := resource.client.CreateUserGroupsInMspManagedTenant(ctx, planData.TenantUid.ValueString(), resource.buildMspUserGroupInput(&MspManagedTenantUserGroupsResourceModel{TenantUid: planData.TenantUid, UserGroups: userGroupsToAdd}))
+		if err != nil {
+			response.Diagnostics.AddError("failed to create user groups in MSP-managed tenant", err.Error())
+			return
+		}
+		updatedUserGroups = append(updatedUserGroups, *resource.transformApiResponseToPlan(createdUserGroups)...)
+	}
+
+	planData.UserGroups = *sortUserGroupsToOrderInPlanData(updatedUserGroups, &planData)
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
 }
 
 func (resource *MspManagedTenantUserGroupsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -164,6 +239,13 @@ func (resource *MspManagedTenantUserGroupsResource) Configure(ctx context.Contex
 	resource.client = client
 }
 
+func sameUserGroup(userGroup UserGroup, otherUserGroup UserGroup) bool {
+	return userGroup.IssuerUrl.Equal(otherUserGroup.IssuerUrl) &&
+		userGroup.Name.Equal(otherUserGroup.Name) &&
+		userGroup.Role.Equal(otherUserGroup.Role) &&
+		userGroup.Notes.Equal(otherUserGroup.Notes)
+}
+
 func (resource *MspManagedTenantUserGroupsResource) buildMspUserGroupInput(planData *MspManagedTenantUserGroupsResourceModel) *[]usergroups.MspManagedUserGroupInput {
 	var userGroupCreateOrUpdateInput []usergroups.MspManagedUserGroupInput
 	for _, userGroup := range planData.UserGroups {
@@ -5,19 +5,23 @@ import (
 	"fmt"
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/msp/users"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/msp/msp_tenant"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"sort"
 )
 
+var _ resource.ResourceWithImportState = &MspManagedTenantUsersResource{}
+
 func NewMspManagedTenantUsersResource() resource.Resource { return &MspManagedTenantUsersResource{} }
 
 type MspManagedTenantUsersResource struct {
@@ -39,11 +43,16 @@ func sortUsersToOrderInPlanData(users []User, planData *MspManagedTenantUsersRes
 
 func (resource *MspManagedTenantUsersResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
 	response.Schema = schema.Schema{
-		MarkdownDescription: "Provides a resource to add users to an MSP managed tenant.",
+		MarkdownDescription: "Provides a resource to add users to an MSP managed tenant. " +
+			"Users removed from the list are deleted from the tenant, and users whose roles change are deleted and added again. " +
+			"Importing the resource adopts all the users of the tenant, so list all of them in the configuration, otherwise the users not in it are deleted on the next apply.",
 		Attributes: map[string]schema.Attribute{
 			"tenant_uid": schema.StringAttribute{
 				MarkdownDescription: "Universally unique identifier of the tenant to which the users should be added.",
 				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"users": schema.ListNestedAttribute{
 				NestedObject: schema.NestedAttributeObject{
@@ -72,9 +81,6 @@ func (resource *MspManagedTenantUsersResource) Schema(ctx context.Context, reque
 							MarkdownDescription: "Whether the user is an API-only user",
 						},
 					},
-					PlanModifiers: []planmodifier.Object{
-						objectplanmodifier.RequiresReplace(), // TODO stop destroying and re-adding once read endpoint added
-					},
 				},
 				MarkdownDescription: "The list of users to be added to the tenant. You can add a maximum of 50 users at a time.",
 				Required:            true,
@@ -112,7 +118,14 @@ func (resource *MspManagedTenantUsersResource) Read(ctx context.Context, request
 	var stateData MspManagedTenantUsersResourceModel
 	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
 
-	userDetails, err := resource.client.ReadUsersInMspManagedTenant(ctx, *resource.buildMspUsersInput(&stateData))
+	var userDetails *[]users.UserDetails
+	var err error
+	if stateData.Users == nil {
+		// users are not known after import, read all users in the tenant
+		userDetails, err = resource.client.ReadAllUsersInMspManagedTenant(ctx, users.MspReadAllUsersInput{TenantUid: stateData.TenantUid.ValueString()})
+	} else {
+		userDetails, err = resource.client.ReadUsersInMspManagedTenant(ctx, *resource.buildMspUsersInput(&stateData))
+	}
 	if err != nil {
 		response.Diagnostics.AddError("failed to read users in MSP-managed tenant", err.Error())
 		return
@@ -122,7 +135,69 @@ func (resource *MspManagedTenantUsersResource) Read(ctx context.Context, request
 	response.Diagnostics.Append(response.State.Set(ctx, &stateData)...)
 }
 
+func (resource *MspManagedTenantUsersResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	tenantUid, err := msp_tenant.TenantUidFromImportId(ctx, resource.client, request.ID)
+	if err != nil {
+		response.Diagnostics.AddError("failed to import users in MSP-managed tenant", err.Error())
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("tenant_uid"), tenantUid)...)
+}
+
 func (resource *MspManagedTenantUsersResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	tflog.Debug(ctx, "Updating users in MSP-managed CDO tenant")
+	var planData MspManagedTenantUsersResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &planData)...)
+	var stateData MspManagedTenantUsersResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &stateData)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// users cannot be changed in place, so the users that changed are deleted and added again
+	stateUsers := make(map[string]User)
+	for _, user := range stateData.Users {
+		stateUsers[user.Username.ValueString()] = user
+	}
+	planUsers := make(map[string]User)
+	for _, user := range planData.Users {
+		planUsers[user.Username.ValueString()] = user
+	}
+	var usersToDelete, unchangedUsers, usersToAdd []User
+	for _, user := range stateData.Users {
+		if planUser, ok := planUsers[user.Username.ValueString()]; !ok || !sameUser(user, planUser) {
+			usersToDelete = append(usersToDelete, user)
+		}
+	}
+	for _, user := range planData.Users {
+		if stateUser, ok := stateUsers[user.Username.ValueString()]; ok && sameUser(stateUser, user) {
+			unchangedUsers = append(unchangedUsers, stateUser)
+		} else {
+			usersToAdd = append(usersToAdd, user)
+		}
+	}
+
+	if len(usersToDelete) > 0 {
+		_, err := resource.deleteAllUsersInState(ctx, &MspManagedTenantUsersResourceModel{TenantUid: stateData.TenantUid, Users: usersToDelete})
+		if err != nil {
+			response.Diagnostics.AddError("failed to delete users in MSP-managed tenant", err.Error())
+			return
+		}
+	}
+
+	updatedUsers := unchangedUsers
+	if len(usersToAdd) > 0 {
+		createdUserDetails, err := resource.client.CreateUsersInMspManagedTenant(ctx, *resource.buildMspUsersInput(&MspManagedTenantUsersResourceModel{TenantUid: planData.TenantUid, Users: usersToAdd}))
+		if err != nil {
+			response.Diagnostics.AddError("failed to create users in MSP-managed tenant", err.Error())
+			return
+		}
+		updatedUsers = append(updatedUsers, *resource.transformApiResponseToPlan(createdUserDetails)...)
+	}
+
+	planData.Users = *sortUsersToOrderInPlanData(updatedUsers, &planData)
+	response.Diagnostics.Append(response.State.Set(ctx, &planData)...)
 }
 
 func (resource *MspManagedTenantUsersResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
@@ -176,6 +251,10 @@ func (resource *MspManagedTenantUsersResource) deleteAllUsersInState(ctx context
 	return resource.client.DeleteUsersInMspManagedTenant(ctx, deleteInput)
 }
 
+func sameUser(user User, otherUser User) bool {
+	return user.Roles.Equal(otherUser.Roles) && user.ApiOnlyUser.Equal(otherUser.ApiOnlyUser)
+}
+
 func (resource *MspManagedTenantUsersResource) buildMspUsersInput(planData *MspManagedTenantUsersResourceModel) *users.MspUsersInput {
 	var nativeUsers []users.UserDetails
 
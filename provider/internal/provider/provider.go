@@ -6,6 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/backup"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/cli"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/conflict"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/credentials"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/deployment"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/ftd/ftdversion"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/msp/msp_tenant"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/msp/msp_tenant_user_api_token"
@@ -21,7 +26,23 @@ import (
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/tenantsettings"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/accesspolicy"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/accessrule"
+	cdfmcdeployment "github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/deployment"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/devicelicenses"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/domains"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/ftdinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/ftdsubinterface"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/networkgroup"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/networkobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/portobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/securityzone"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/smartlicense"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/staticroute"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/cdfmc/urlobject"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/ftd/day0config"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/ftd/ftdonboarding"
+	ftdhapair "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/ftd/hapair"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/ftd"
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/tenant"
@@ -43,6 +64,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa"
+	asaaccessgroup "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/accessgroup"
+	asaaccesslist "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/accesslist"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/asaonboarding"
+	asanetworkobject "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/networkobject"
+	asaobjectgroup "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/objectgroup"
+	asasecuritycontext "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/securitycontext"
+	asaserviceobject "github.com/CiscoDevnet/terraform-provider-cdo/internal/device/asa/serviceobject"
 )
 
 var _ provider.Provider = &CdoProvider{}
@@ -166,6 +194,7 @@ func (p *CdoProvider) Resources(ctx context.Context) []func() resource.Resource
 	return []func() resource.Resource{
 		connector.NewResource,
 		asa.NewAsaDeviceResource,
+		asaonboarding.NewResource,
 		ios.NewIosDeviceResource,
 		ftd.NewResource,
 		user.NewResource,
@@ -182,6 +211,30 @@ func (p *CdoProvider) Resources(ctx context.Context) []func() resource.Resource
 		msp_tenant_user_api_token.NewMspManagedTenantUserApiTokenResource,
 		msp_tenant_user_groups.NewMspManagedTenantUserGroupsResource,
 		ftdversion.NewResource,
+		deployment.NewResource,
+		cli.NewCommandResource,
+		backup.NewScheduleResource,
+		conflict.NewResource,
+		credentials.NewResource,
+		accesspolicy.NewResource,
+		accessrule.NewResource,
+		networkobject.NewResource,
+		networkgroup.NewResource,
+		portobject.NewResource,
+		urlobject.NewResource,
+		securityzone.NewResource,
+		ftdinterface.NewResource,
+		ftdsubinterface.NewResource,
+		staticroute.NewResource,
+		cdfmcdeployment.NewResource,
+		devicelicenses.NewResource,
+		ftdhapair.NewResource,
+		asanetworkobject.NewResource,
+		asaserviceobject.NewResource,
+		asaobjectgroup.NewResource,
+		asaaccesslist.NewResource,
+		asaaccessgroup.NewResource,
+		asasecuritycontext.NewResource,
 	}
 }
 
@@ -189,11 +242,17 @@ func (p *CdoProvider) DataSources(ctx context.Context) []func() datasource.DataS
 	return []func() datasource.DataSource{
 		connector.NewDataSource,
 		asa.NewAsaDataSource,
+		asasecuritycontext.NewDataSource,
 		ios.NewIosDataSource,
 		ftd.NewDataSource,
+		day0config.NewDataSource,
+		cli.NewRunningConfigDataSource,
+		backup.NewDataSource,
 		user.NewDataSource,
 		tenant.NewDataSource,
 		cdfmc.NewDataSource,
+		smartlicense.NewDataSource,
+		domains.NewDataSource,
 		tenantsettings.NewTenantSettingsDataSource,
 		msp_tenant.NewTenantDataSource,
 	}
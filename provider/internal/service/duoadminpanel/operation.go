@@ -23,6 +23,10 @@ func Read(ctx context.Context, resource *Resource, stateData *ResourceModel) err
 	stateData.Labels = util.GoStringSliceToTFStringSet(output.Tags.UngroupedTags())
 	stateData.GroupedLabels = util.GoMapToStringSetTFMap(output.Tags.GroupedTags())
 	stateData.Name = types.StringValue(output.Name)
+	// host is not known after import, take it from CDO
+	if stateData.Host.IsNull() {
+		stateData.Host = types.StringValue(output.Host)
+	}
 
 	return nil
 }
@@ -73,6 +77,13 @@ func Update(ctx context.Context, resource *Resource, planData *ResourceModel, st
 	stateData.Labels = util.GoStringSliceToTFStringSet(output.Tags.UngroupedTags())
 	stateData.GroupedLabels = util.GoMapToStringSetTFMap(output.Tags.GroupedTags())
 	stateData.Name = types.StringValue(output.Name)
+	// keys are not known after import, take them from the plan
+	if stateData.IntegrationKey.IsNull() {
+		stateData.IntegrationKey = planData.IntegrationKey
+	}
+	if stateData.SecretKey.IsNull() {
+		stateData.SecretKey = planData.SecretKey
+	}
 
 	return nil
 }
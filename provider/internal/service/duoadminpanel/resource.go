@@ -11,6 +11,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/device"
+	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/model/devicetype"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +22,7 @@ import (
 )
 
 var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
 
 func NewResource() resource.Resource {
 	return &Resource{}
@@ -199,3 +203,20 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 		res.Diagnostics.AddError("failed to delete Duo Admin Panel resource", err.Error())
 	}
 }
+
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadDeviceByName(ctx, device.NewReadByNameAndTypeInput(uidOrName, devicetype.DuoAdminPanel))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import Duo Admin Panel resource", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("integration_key"), types.StringNull())...)
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("secret_key"), types.StringNull())...)
+	util.AddImportedSecretsWarning(&res.Diagnostics, "integration_key", "secret_key")
+}
@@ -7,12 +7,15 @@ import (
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
 	"github.com/CiscoDevnet/terraform-provider-cdo/validators"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
+var _ resource.ResourceWithImportState = &TenantSettingsResource{}
+
 func NewTenantSettingsResource() resource.Resource {
 	return &TenantSettingsResource{}
 }
@@ -146,3 +149,8 @@ func handleUpdate(ctx context.Context, diagnostics *diag.Diagnostics, plan *tfsd
 
 	diagnostics.Append(state.Set(ctx, tenantSettingsDataSourceModelFrom(*settings))...)
 }
+
+func (*TenantSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
+	// tenant settings are a singleton, read ignores the id, which is set to the uid of the tenant on read
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+}
@@ -3,9 +3,11 @@ package user
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	cdoClient "github.com/CiscoDevnet/terraform-provider-cdo/go-client"
 	"github.com/CiscoDevnet/terraform-provider-cdo/go-client/user"
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -183,7 +185,17 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, res *
 }
 
 func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, res *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, res)
+	uidOrName, isName := util.ParseImportId(req.ID)
+	if isName {
+		readOutp, err := r.client.ReadUserByUsername(ctx, *user.NewReadByUsernameInput(uidOrName))
+		if err != nil {
+			res.Diagnostics.AddError("failed to import user resource", err.Error())
+			return
+		}
+		uidOrName = readOutp.Uid
+	}
+
+	res.Diagnostics.Append(res.State.SetAttribute(ctx, path.Root("id"), uidOrName)...)
 }
 
 func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -206,8 +218,23 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	stateData.GeneratedUsername = types.StringValue(readOutp.Name)
 	stateData.UserRole = types.StringValue(readOutp.UserRoles[0]) // while our API technically allows multiple roles, our UI does not support multiple roles
 	stateData.ApiOnlyUser = types.BoolValue(readOutp.ApiOnlyUser)
+	// name is not known after import, derive it from the generated username
+	if stateData.Name.IsNull() {
+		stateData.Name = types.StringValue(nameFromGeneratedUsername(readOutp.Name, readOutp.ApiOnlyUser))
+	}
 
 	// 3. save data into terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &stateData)...)
 	tflog.Trace(ctx, "read user resource done")
 }
+
+// nameFromGeneratedUsername strips the `@CDO_<tenant>` suffix that CDO appends to the name of API-only users.
+func nameFromGeneratedUsername(generatedUsername string, apiOnlyUser bool) string {
+	if !apiOnlyUser {
+		return generatedUsername
+	}
+	if i := strings.LastIndex(generatedUsername, "@"); i >= 0 {
+		return generatedUsername[:i]
+	}
+	return generatedUsername
+}
@@ -0,0 +1,32 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const importIdNamePrefix = "name:"
+
+// ParseImportId parses the ID given to `terraform import`. The ID is either the UID of the
+// resource, or the name of the resource prefixed with "name:", e.g. "name:my-asa".
+// It returns the UID or name, and whether the ID refers to a name.
+func ParseImportId(id string) (string, bool) {
+	if strings.HasPrefix(id, importIdNamePrefix) {
+		return strings.TrimPrefix(id, importIdNamePrefix), true
+	}
+	return id, false
+}
+
+// AddImportedSecretsWarning adds a warning that the given secret attributes cannot be read back from CDO,
+// and have been set to null in the imported state.
+func AddImportedSecretsWarning(diagnostics *diag.Diagnostics, attributes ...string) {
+	for _, attribute := range attributes {
+		diagnostics.AddAttributeWarning(
+			path.Root(attribute),
+			"Secret not imported",
+			"The value of "+attribute+" cannot be read from CDO, so it has been set to null in the imported state.",
+		)
+	}
+}
@@ -0,0 +1,51 @@
+package util_test
+
+import (
+	"github.com/CiscoDevnet/terraform-provider-cdo/internal/util"
+	"testing"
+)
+
+func TestParseImportId(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		in        string
+		expValue  string
+		expIsName bool
+	}
+
+	testCases := map[string]testCase{
+		"uid": {
+			in:        "b5e1c3a6-4d5e-4a0f-9a8c-0c5a0c1a2b3c",
+			expValue:  "b5e1c3a6-4d5e-4a0f-9a8c-0c5a0c1a2b3c",
+			expIsName: false,
+		},
+		"name": {
+			in:        "name:my-asa",
+			expValue:  "my-asa",
+			expIsName: true,
+		},
+		"name-containing-prefix": {
+			in:        "name:name:my-asa",
+			expValue:  "name:my-asa",
+			expIsName: true,
+		},
+		"empty-name": {
+			in:        "name:",
+			expValue:  "",
+			expIsName: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		name, tc := name, tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			value, isName := util.ParseImportId(tc.in)
+			if value != tc.expValue || isName != tc.expIsName {
+				t.Errorf("expected (%q, %t), got (%q, %t)", tc.expValue, tc.expIsName, value, isName)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package planmodifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// RequiresReplaceUnlessImported behaves like stringplanmodifier.RequiresReplace, except when the state value is null.
+// It is meant for secrets that cannot be read back from CDO: they are null after `terraform import`, and
+// setting them in the configuration afterward should not destroy and re-create the resource.
+func RequiresReplaceUnlessImported() planmodifier.String {
+	return stringplanmodifier.RequiresReplaceIf(
+		func(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+			resp.RequiresReplace = !req.StateValue.IsNull()
+		},
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the resource was imported and the value is not yet known.",
+		"If the value of this attribute changes, Terraform will destroy and recreate the resource, unless the resource was imported and the value is not yet known.",
+	)
+}